@@ -0,0 +1,40 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// adminPermissionsClientId is the client Keycloak creates in a realm to hold its fine-grained admin permissions
+const adminPermissionsClientId = "admin-permissions"
+
+// GetAdminPermissionsResourceServerId returns the id of the client whose authorization settings hold the fine-grained admin
+// permissions of the realm. Keycloak only creates this client when admin permissions are enabled, and removes it again
+// along with the permissions when they're disabled, so a missing client is reported as a missing resource.
+func (keycloakClient *KeycloakClient) GetAdminPermissionsResourceServerId(ctx context.Context, realmId string) (string, error) {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_26_2)
+	if err != nil {
+		return "", err
+	}
+	if !versionOk {
+		return "", fmt.Errorf("validation error: admin permissions require Keycloak 26.2 or later")
+	}
+
+	var clients []OpenidClient
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients", realmId), &clients, map[string]string{
+		"clientId": adminPermissionsClientId,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(clients) == 0 {
+		return "", &ApiError{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("admin permissions are not enabled in realm %s, they can be enabled with admin_permissions_enabled", realmId),
+		}
+	}
+
+	return clients[0].Id, nil
+}
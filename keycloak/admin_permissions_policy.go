@@ -0,0 +1,63 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AdminPermissionsPolicy is a policy of the admin-permissions client. Only the users, clients, groups or roles matching
+// the type of the policy are sent to Keycloak.
+type AdminPermissionsPolicy struct {
+	Id               string                           `json:"id,omitempty"`
+	RealmId          string                           `json:"-"`
+	ResourceServerId string                           `json:"-"`
+	Name             string                           `json:"name"`
+	Description      string                           `json:"description"`
+	DecisionStrategy string                           `json:"decisionStrategy"`
+	Logic            string                           `json:"logic"`
+	Type             string                           `json:"type"`
+	Users            []string                         `json:"users,omitempty"`
+	Clients          []string                         `json:"clients,omitempty"`
+	Groups           []OpenidClientAuthorizationGroup `json:"groups,omitempty"`
+	Roles            []OpenidClientAuthorizationRole  `json:"roles,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) NewAdminPermissionsPolicy(ctx context.Context, policy *AdminPermissionsPolicy) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s", policy.RealmId, policy.ResourceServerId, policy.Type), policy)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, &policy)
+}
+
+// GetAdminPermissionsPolicy returns the policy along with its users, clients, groups or roles. Those are only returned when
+// asking for a policy of a given type, so the type of the policy is read first.
+func (keycloakClient *KeycloakClient) GetAdminPermissionsPolicy(ctx context.Context, realmId, resourceServerId, id string) (*AdminPermissionsPolicy, error) {
+	policy := AdminPermissionsPolicy{
+		Id:               id,
+		RealmId:          realmId,
+		ResourceServerId: resourceServerId,
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s", realmId, resourceServerId, id), &policy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s/%s", realmId, resourceServerId, policy.Type, id), &policy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAdminPermissionsPolicy(ctx context.Context, policy *AdminPermissionsPolicy) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s/%s", policy.RealmId, policy.ResourceServerId, policy.Type, policy.Id), policy)
+}
+
+func (keycloakClient *KeycloakClient) DeleteAdminPermissionsPolicy(ctx context.Context, realmId, resourceServerId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s", realmId, resourceServerId, id), nil)
+}
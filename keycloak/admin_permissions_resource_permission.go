@@ -0,0 +1,94 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AdminPermissionsResourcePermission grants the scopes of a resource type, such as Users, to the admins matching its
+// policies. Resources are the ids of the users, groups, clients or roles the permission applies to, the permission applies
+// to all of them when there are none.
+type AdminPermissionsResourcePermission struct {
+	Id               string   `json:"id,omitempty"`
+	RealmId          string   `json:"-"`
+	ResourceServerId string   `json:"-"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	DecisionStrategy string   `json:"decisionStrategy"`
+	ResourceType     string   `json:"resourceType"`
+	Resources        []string `json:"resources"`
+	Scopes           []string `json:"scopes"`
+	Policies         []string `json:"policies"`
+}
+
+func (keycloakClient *KeycloakClient) NewAdminPermissionsResourcePermission(ctx context.Context, permission *AdminPermissionsResourcePermission) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope", permission.RealmId, permission.ResourceServerId), permission)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, &permission)
+}
+
+// GetAdminPermissionsResourcePermission returns the permission with the names of its scopes and resources. Keycloak names
+// the resources of admin permissions after the id of the user, group, client or role they stand for, and keeps a resource
+// named after the resource type for permissions applying to all of them, which isn't returned.
+func (keycloakClient *KeycloakClient) GetAdminPermissionsResourcePermission(ctx context.Context, realmId, resourceServerId, id string) (*AdminPermissionsResourcePermission, error) {
+	permission := AdminPermissionsResourcePermission{
+		Id:               id,
+		RealmId:          realmId,
+		ResourceServerId: resourceServerId,
+	}
+
+	var policies []OpenidClientAuthorizationPolicy
+	var resources []OpenidClientAuthorizationResource
+	var scopes []OpenidClientAuthorizationScope
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", realmId, resourceServerId, id), &permission, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s/associatedPolicies", realmId, resourceServerId, id), &policies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/resources", realmId, resourceServerId, id), &resources, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/scopes", realmId, resourceServerId, id), &scopes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	permission.Policies = []string{}
+	for _, policy := range policies {
+		permission.Policies = append(permission.Policies, policy.Id)
+	}
+
+	permission.Resources = []string{}
+	for _, resource := range resources {
+		if resource.Name != permission.ResourceType {
+			permission.Resources = append(permission.Resources, resource.Name)
+		}
+	}
+
+	permission.Scopes = []string{}
+	for _, scope := range scopes {
+		permission.Scopes = append(permission.Scopes, scope.Name)
+	}
+
+	return &permission, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAdminPermissionsResourcePermission(ctx context.Context, permission *AdminPermissionsResourcePermission) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", permission.RealmId, permission.ResourceServerId, permission.Id), permission)
+}
+
+func (keycloakClient *KeycloakClient) DeleteAdminPermissionsResourcePermission(ctx context.Context, realmId, resourceServerId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s", realmId, resourceServerId, id), nil)
+}
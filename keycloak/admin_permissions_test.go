@@ -0,0 +1,86 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// returns a client which sends its requests to a stub server for a realm with admin permissions, whose client holds a
+// permission for a single user and the resource Keycloak keeps for the Users resource type
+func newAdminPermissionsTestClient(t *testing.T, adminPermissionsEnabled bool) *KeycloakClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/realms/test/clients":
+			if r.URL.Query().Get("clientId") != "admin-permissions" {
+				t.Errorf("unexpected client id %s", r.URL.Query().Get("clientId"))
+			}
+
+			clients := []*OpenidClient{}
+			if adminPermissionsEnabled {
+				clients = append(clients, &OpenidClient{Id: "admin-permissions-id", ClientId: "admin-permissions"})
+			}
+			json.NewEncoder(w).Encode(clients)
+		case "/admin/realms/test/clients/admin-permissions-id/authz/resource-server/permission/scope/permission-id":
+			json.NewEncoder(w).Encode(&AdminPermissionsResourcePermission{Id: "permission-id", Name: "helpdesk", ResourceType: "Users"})
+		case "/admin/realms/test/clients/admin-permissions-id/authz/resource-server/policy/permission-id/associatedPolicies":
+			json.NewEncoder(w).Encode([]*OpenidClientAuthorizationPolicy{{Id: "policy-id"}})
+		case "/admin/realms/test/clients/admin-permissions-id/authz/resource-server/permission/permission-id/resources":
+			json.NewEncoder(w).Encode([]*OpenidClientAuthorizationResource{{Id: "resource-id", Name: "user-id"}, {Id: "type-id", Name: "Users"}})
+		case "/admin/realms/test/clients/admin-permissions-id/authz/resource-server/permission/permission-id/scopes":
+			json.NewEncoder(w).Encode([]*OpenidClientAuthorizationScope{{Id: "scope-id", Name: "view"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.version = Version_26_2.AsVersion()
+
+	return keycloakClient
+}
+
+func TestGetAdminPermissionsResourceServerId(t *testing.T) {
+	keycloakClient := newAdminPermissionsTestClient(t, true)
+
+	resourceServerId, err := keycloakClient.GetAdminPermissionsResourceServerId(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resourceServerId != "admin-permissions-id" {
+		t.Fatalf("expected the id of the admin-permissions client, got %s", resourceServerId)
+	}
+}
+
+func TestGetAdminPermissionsResourceServerId_disabled(t *testing.T) {
+	keycloakClient := newAdminPermissionsTestClient(t, false)
+
+	_, err := keycloakClient.GetAdminPermissionsResourceServerId(context.Background(), "test")
+	if !ErrorIs404(err) {
+		t.Fatalf("expected a missing admin-permissions client to be reported as not found, got %v", err)
+	}
+}
+
+func TestGetAdminPermissionsResourcePermission(t *testing.T) {
+	keycloakClient := newAdminPermissionsTestClient(t, true)
+
+	permission, err := keycloakClient.GetAdminPermissionsResourcePermission(context.Background(), "test", "admin-permissions-id", "permission-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the resource standing for all users isn't one of the resources of the permission
+	if !reflect.DeepEqual(permission.Resources, []string{"user-id"}) {
+		t.Fatalf("expected the permission to apply to user-id only, got %v", permission.Resources)
+	}
+
+	if !reflect.DeepEqual(permission.Scopes, []string{"view"}) || !reflect.DeepEqual(permission.Policies, []string{"policy-id"}) {
+		t.Fatalf("expected scope view and policy policy-id, got %v and %v", permission.Scopes, permission.Policies)
+	}
+}
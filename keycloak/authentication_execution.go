@@ -3,6 +3,9 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"sort"
 	"time"
 )
 
@@ -41,6 +44,7 @@ type AuthenticationExecutionInfo struct {
 	RealmId              string `json:"-"`
 	ParentFlowAlias      string `json:"-"`
 	Alias                string `json:"alias"`
+	DisplayName          string `json:"displayName"`
 	AuthenticationConfig string `json:"authenticationConfig"`
 	AuthenticationFlow   bool   `json:"authenticationFlow"`
 	Configurable         bool   `json:"configurable"`
@@ -69,7 +73,7 @@ func (list AuthenticationExecutionList) Swap(i, j int) {
 func (keycloakClient *KeycloakClient) ListAuthenticationExecutions(ctx context.Context, realmId, parentFlowAlias string) (AuthenticationExecutionList, error) {
 	var authenticationExecutions []*AuthenticationExecutionInfo
 
-	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, url.PathEscape(parentFlowAlias)), &authenticationExecutions, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +85,7 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProvider
 	var authenticationExecutions []*AuthenticationExecutionInfo
 	var authenticationExecution AuthenticationExecutionInfo
 
-	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, url.PathEscape(parentFlowAlias)), &authenticationExecutions, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +93,7 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProvider
 	// Retry 3 more times if not found, sometimes it took split milliseconds the Authentication Executions to populate
 	if len(authenticationExecutions) == 0 {
 		for i := 0; i < 3; i++ {
-			err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, parentFlowAlias), &authenticationExecutions, nil)
+			err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", realmId, url.PathEscape(parentFlowAlias)), &authenticationExecutions, nil)
 
 			if len(authenticationExecutions) > 0 {
 				break
@@ -121,14 +125,25 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecutionInfoFromProvider
 }
 
 func (keycloakClient *KeycloakClient) NewAuthenticationExecution(ctx context.Context, execution *AuthenticationExecution) error {
-	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions/execution", execution.RealmId, execution.ParentFlowAlias), &authenticationExecutionCreate{Provider: execution.Authenticator})
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions/execution", execution.RealmId, url.PathEscape(execution.ParentFlowAlias)), &authenticationExecutionCreate{Provider: execution.Authenticator})
 	if err != nil {
 		return err
 	}
 
 	execution.Id = getIdFromLocationHeader(location)
 
-	err = keycloakClient.UpdateAuthenticationExecution(ctx, execution)
+	// Keycloak adds the execution last, and the update has to keep the priority it was given there, otherwise the execution
+	// would move in front of its siblings
+	update := *execution
+	if update.Priority == 0 {
+		createdExecution, err := keycloakClient.GetAuthenticationExecution(ctx, execution.RealmId, execution.ParentFlowAlias, execution.Id)
+		if err != nil {
+			return err
+		}
+		update.Priority = createdExecution.Priority
+	}
+
+	err = keycloakClient.UpdateAuthenticationExecution(ctx, &update)
 	if err != nil {
 		return err
 	}
@@ -162,14 +177,19 @@ func (keycloakClient *KeycloakClient) UpdateAuthenticationExecution(ctx context.
 }
 
 func (keycloakClient *KeycloakClient) UpdateAuthenticationExecutionRequirement(ctx context.Context, executionRequirementUpdate *authenticationExecutionRequirementUpdate) error {
-	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", executionRequirementUpdate.RealmId, executionRequirementUpdate.ParentFlowAlias), executionRequirementUpdate)
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions", executionRequirementUpdate.RealmId, url.PathEscape(executionRequirementUpdate.ParentFlowAlias)), executionRequirementUpdate)
 }
 
 func (keycloakClient *KeycloakClient) DeleteAuthenticationExecution(ctx context.Context, realmId, id string) error {
 	err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
-	if err != nil {
+	if err != nil && !ErrorIs404(err) {
 		// For whatever reason, this fails sometimes with a 500 during acceptance tests. try again
-		return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
+		err = keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s", realmId, id), nil)
+	}
+
+	// executions are removed along with their subflow or parent flow, so they may already be gone
+	if err != nil && !ErrorIs404(err) {
+		return err
 	}
 
 	return nil
@@ -190,3 +210,156 @@ func (keycloakClient *KeycloakClient) LowerAuthenticationExecutionPriority(ctx c
 	}
 	return nil
 }
+
+// ReorderAuthenticationExecutions moves the direct executions of the parent flow into the given order with the minimal
+// number of priority changes. Keycloak swaps the priorities of neighbouring executions when raising or lowering one, which
+// does nothing for executions sharing a priority, so the executions of the order are given distinct priorities first. The
+// executions are read again after each operation, as Keycloak may renumber them. Executions which aren't part of the order
+// stay where they are relative to each other, but an execution which is raised past them swaps priorities with them, so
+// unmanaged neighbours of the managed executions may have their priority changed.
+func (keycloakClient *KeycloakClient) ReorderAuthenticationExecutions(ctx context.Context, realmId, parentFlowAlias string, desiredOrder []string) error {
+	executions, err := keycloakClient.listDirectAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range desiredOrder {
+		if indexOfAuthenticationExecution(executions, id) == -1 {
+			return fmt.Errorf("no authentication execution with id %s found in flow %s", id, parentFlowAlias)
+		}
+	}
+
+	if err = keycloakClient.separateAuthenticationExecutionPriorities(ctx, realmId, parentFlowAlias, executions, desiredOrder); err != nil {
+		return err
+	}
+
+	for {
+		executions, err = keycloakClient.listDirectAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+		if err != nil {
+			return err
+		}
+
+		id := nextAuthenticationExecutionToRaise(executions, desiredOrder)
+		if id == "" {
+			return nil
+		}
+
+		index := indexOfAuthenticationExecution(executions, id)
+		if err = keycloakClient.RaiseAuthenticationExecutionPriority(ctx, realmId, id); err != nil {
+			return err
+		}
+
+		executions, err = keycloakClient.listDirectAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+		if err != nil {
+			return err
+		}
+
+		if indexOfAuthenticationExecution(executions, id) >= index {
+			return fmt.Errorf("raising the priority of authentication execution %s in flow %s didn't move it", id, parentFlowAlias)
+		}
+	}
+}
+
+// ListAuthenticationExecutionsSharingPriority returns the other direct executions of the parent flow which have the same
+// priority as the given one. Keycloak orders executions sharing a priority arbitrarily, so their order isn't stable.
+func (keycloakClient *KeycloakClient) ListAuthenticationExecutionsSharingPriority(ctx context.Context, realmId, parentFlowAlias, id string) (AuthenticationExecutionList, error) {
+	executions, err := keycloakClient.listDirectAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	index := indexOfAuthenticationExecution(executions, id)
+	if index == -1 {
+		return nil, fmt.Errorf("no authentication execution with id %s found in flow %s", id, parentFlowAlias)
+	}
+
+	var sharingPriority AuthenticationExecutionList
+	for _, execution := range executions {
+		if execution.Id != id && execution.Priority == executions[index].Priority {
+			sharingPriority = append(sharingPriority, execution)
+		}
+	}
+
+	return sharingPriority, nil
+}
+
+// listDirectAuthenticationExecutions returns the executions of the parent flow without the ones of its subflows, as
+// ordered by Keycloak.
+func (keycloakClient *KeycloakClient) listDirectAuthenticationExecutions(ctx context.Context, realmId, parentFlowAlias string) (AuthenticationExecutionList, error) {
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	var directExecutions AuthenticationExecutionList
+	for _, execution := range executions {
+		if execution.Level == 0 {
+			directExecutions = append(directExecutions, execution)
+		}
+	}
+	sort.Stable(directExecutions)
+
+	return directExecutions, nil
+}
+
+// separateAuthenticationExecutionPriorities raises the priority of each execution of the desired order which doesn't come
+// after the previous execution, so that the order of the executions stays the same but none of them shares a priority with
+// the one before it. Only the priorities of the executions of the desired order are changed.
+func (keycloakClient *KeycloakClient) separateAuthenticationExecutionPriorities(ctx context.Context, realmId, parentFlowAlias string, executions AuthenticationExecutionList, desiredOrder []string) error {
+	for i := 1; i < len(executions); i++ {
+		if executions[i].Priority > executions[i-1].Priority || !slices.Contains(desiredOrder, executions[i].Id) {
+			continue
+		}
+
+		priority := executions[i-1].Priority + 1
+		err := keycloakClient.UpdateAuthenticationExecutionRequirement(ctx, &authenticationExecutionRequirementUpdate{
+			RealmId:         realmId,
+			ParentFlowAlias: parentFlowAlias,
+			Id:              executions[i].Id,
+			Requirement:     executions[i].Requirement,
+			Priority:        priority,
+		})
+		if err != nil {
+			return err
+		}
+
+		executions[i].Priority = priority
+	}
+
+	return nil
+}
+
+// nextAuthenticationExecutionToRaise returns the first execution of the desired order which comes too late, or an empty
+// string once the executions are in order. Raising it until it's in place takes as many operations as executions it
+// wrongly comes after, so no shorter sequence of raise and lower operations exists.
+func nextAuthenticationExecutionToRaise(executions AuthenticationExecutionList, desiredOrder []string) string {
+	desired := make(map[string]bool, len(desiredOrder))
+	for _, id := range desiredOrder {
+		desired[id] = true
+	}
+
+	var currentOrder []string
+	for _, execution := range executions {
+		if desired[execution.Id] {
+			currentOrder = append(currentOrder, execution.Id)
+		}
+	}
+
+	for i, id := range desiredOrder {
+		if i >= len(currentOrder) || currentOrder[i] != id {
+			return id
+		}
+	}
+
+	return ""
+}
+
+func indexOfAuthenticationExecution(executions AuthenticationExecutionList, id string) int {
+	for i, execution := range executions {
+		if execution.Id == id {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/http"
 )
 
 // AuthenticationExecutionConfig https://www.keycloak.org/docs-api/latest/rest-api/index.html#AuthenticatorConfigRepresentation
@@ -14,6 +15,33 @@ type AuthenticationExecutionConfig struct {
 	Config      map[string]string `json:"config"`
 }
 
+// AuthenticatorConfigDescription https://www.keycloak.org/docs-api/latest/rest-api/index.html#AuthenticatorConfigInfoRepresentation
+type AuthenticatorConfigDescription struct {
+	Name       string                              `json:"name"`
+	ProviderId string                              `json:"providerId"`
+	HelpText   string                              `json:"helpText"`
+	Properties []AuthenticatorConfigPropertyDetail `json:"properties"`
+}
+
+type AuthenticatorConfigPropertyDetail struct {
+	Name         string      `json:"name"`
+	Label        string      `json:"label"`
+	Type         string      `json:"type"`
+	DefaultValue interface{} `json:"defaultValue"`
+}
+
+// GetAuthenticatorConfigDescription https://www.keycloak.org/docs-api/latest/rest-api/index.html#_get_adminrealmsrealmauthenticationconfig_descriptionproviderid
+func (keycloakClient *KeycloakClient) GetAuthenticatorConfigDescription(ctx context.Context, realmId, providerId string) (*AuthenticatorConfigDescription, error) {
+	var description AuthenticatorConfigDescription
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/config-description/%s", realmId, providerId), &description, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &description, nil
+}
+
 // NewAuthenticationExecutionConfig creates a new AuthenticationExecutionConfig
 func (keycloakClient *KeycloakClient) NewAuthenticationExecutionConfig(ctx context.Context, config *AuthenticationExecutionConfig) (string, error) {
 	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/executions/%s/config", config.RealmId, config.ExecutionId), config)
@@ -28,6 +56,35 @@ func (keycloakClient *KeycloakClient) GetAuthenticationExecutionConfig(ctx conte
 	return keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/config/%s", config.RealmId, config.Id), config, nil)
 }
 
+// GetAuthenticationExecutionConfigForExecution looks up the config attached to an execution or subflow, which Keycloak
+// only references by id from the execution.
+func (keycloakClient *KeycloakClient) GetAuthenticationExecutionConfigForExecution(ctx context.Context, realmId, executionId string) (*AuthenticationExecutionConfig, error) {
+	execution, err := keycloakClient.GetAuthenticationExecution(ctx, realmId, "", executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if execution.AuthenticationConfig == "" {
+		return nil, &ApiError{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("authentication execution %s in realm %s has no config", executionId, realmId),
+		}
+	}
+
+	config := &AuthenticationExecutionConfig{
+		RealmId:     realmId,
+		ExecutionId: executionId,
+		Id:          execution.AuthenticationConfig,
+	}
+
+	err = keycloakClient.GetAuthenticationExecutionConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
 // UpdateAuthenticationExecutionConfig https://www.keycloak.org/docs-api/latest/rest-api/index.html#_put_adminrealmsrealmauthenticationconfigid
 func (keycloakClient *KeycloakClient) UpdateAuthenticationExecutionConfig(ctx context.Context, config *AuthenticationExecutionConfig) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/config/%s", config.RealmId, config.Id), config)
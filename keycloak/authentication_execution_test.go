@@ -0,0 +1,210 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type authenticationExecutionStub struct {
+	id       string
+	priority int
+}
+
+// returns a client which sends its requests to a stub server keeping the executions of a single flow, which raises and
+// lowers executions the way Keycloak does by swapping their priority with the one of their neighbour
+func newAuthenticationExecutionTestClient(t *testing.T, executions []*authenticationExecutionStub) (*KeycloakClient, *int) {
+	var operations int
+
+	sorted := func() []*authenticationExecutionStub {
+		sort.SliceStable(executions, func(i, j int) bool {
+			return executions[i].priority < executions[j].priority
+		})
+		return executions
+	}
+
+	move := func(id string, offset int) {
+		sorted := sorted()
+		for i, execution := range sorted {
+			if execution.id == id && i+offset >= 0 && i+offset < len(sorted) {
+				neighbour := sorted[i+offset]
+				execution.priority, neighbour.priority = neighbour.priority, execution.priority
+				return
+			}
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/realms/test/authentication/flows/flow/executions":
+			var response []*AuthenticationExecutionInfo
+			for i, execution := range sorted() {
+				response = append(response, &AuthenticationExecutionInfo{
+					Id:          execution.id,
+					Requirement: "REQUIRED",
+					Priority:    execution.priority,
+					Index:       i,
+				})
+			}
+			json.NewEncoder(w).Encode(response)
+		case r.Method == http.MethodPut && r.URL.Path == "/admin/realms/test/authentication/flows/flow/executions":
+			var update authenticationExecutionRequirementUpdate
+			json.NewDecoder(r.Body).Decode(&update)
+			for _, execution := range executions {
+				if execution.id == update.Id {
+					execution.priority = update.Priority
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/raise-priority"):
+			operations++
+			move(strings.Split(r.URL.Path, "/")[6], -1)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/lower-priority"):
+			operations++
+			move(strings.Split(r.URL.Path, "/")[6], 1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return newTestKeycloakClient(server), &operations
+}
+
+func testReorderAuthenticationExecutions(t *testing.T, executions []*authenticationExecutionStub, desiredOrder []string, expectedOperations int) {
+	keycloakClient, operations := newAuthenticationExecutionTestClient(t, executions)
+
+	if err := keycloakClient.ReorderAuthenticationExecutions(context.Background(), "test", "flow", desiredOrder); err != nil {
+		t.Fatalf("expected executions to be reordered, got %s", err)
+	}
+
+	executionList, err := keycloakClient.listDirectAuthenticationExecutions(context.Background(), "test", "flow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var order []string
+	for _, execution := range executionList {
+		order = append(order, execution.Id)
+	}
+
+	if !reflect.DeepEqual(order, desiredOrder) {
+		t.Fatalf("expected executions to be ordered %v, got %v", desiredOrder, order)
+	}
+
+	if *operations != expectedOperations {
+		t.Fatalf("expected %d raise or lower operations, got %d", expectedOperations, *operations)
+	}
+}
+
+func TestReorderAuthenticationExecutions_identicalPriorities(t *testing.T) {
+	testReorderAuthenticationExecutions(t, []*authenticationExecutionStub{
+		{id: "a", priority: 10},
+		{id: "b", priority: 10},
+		{id: "c", priority: 10},
+	}, []string{"b", "a", "c"}, 1)
+}
+
+func TestReorderAuthenticationExecutions_reversed(t *testing.T) {
+	testReorderAuthenticationExecutions(t, []*authenticationExecutionStub{
+		{id: "a", priority: 10},
+		{id: "b", priority: 20},
+		{id: "c", priority: 30},
+		{id: "d", priority: 40},
+	}, []string{"d", "c", "b", "a"}, 6)
+}
+
+func TestReorderAuthenticationExecutions_lastMovedToStart(t *testing.T) {
+	testReorderAuthenticationExecutions(t, []*authenticationExecutionStub{
+		{id: "a", priority: 10},
+		{id: "b", priority: 20},
+		{id: "c", priority: 30},
+		{id: "d", priority: 40},
+	}, []string{"d", "a", "b", "c"}, 3)
+}
+
+func TestReorderAuthenticationExecutions_alreadyOrdered(t *testing.T) {
+	testReorderAuthenticationExecutions(t, []*authenticationExecutionStub{
+		{id: "a", priority: 10},
+		{id: "b", priority: 20},
+	}, []string{"a", "b"}, 0)
+}
+
+func TestReorderAuthenticationExecutions_keepsPrioritiesOutsideOfOrder(t *testing.T) {
+	executions := []*authenticationExecutionStub{
+		{id: "x", priority: 10},
+		{id: "a", priority: 10},
+		{id: "b", priority: 10},
+	}
+	keycloakClient, operations := newAuthenticationExecutionTestClient(t, executions)
+
+	if err := keycloakClient.ReorderAuthenticationExecutions(context.Background(), "test", "flow", []string{"b", "a"}); err != nil {
+		t.Fatalf("expected executions to be reordered, got %s", err)
+	}
+
+	executionList, err := keycloakClient.listDirectAuthenticationExecutions(context.Background(), "test", "flow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var order []string
+	for _, execution := range executionList {
+		order = append(order, execution.Id)
+		if execution.Id == "x" && execution.Priority != 10 {
+			t.Fatalf("expected the priority of execution x to be kept, got %d", execution.Priority)
+		}
+	}
+
+	if !reflect.DeepEqual(order, []string{"x", "b", "a"}) {
+		t.Fatalf("expected executions to be ordered [x b a], got %v", order)
+	}
+
+	if *operations != 1 {
+		t.Fatalf("expected 1 raise or lower operation, got %d", *operations)
+	}
+}
+
+func TestReorderAuthenticationExecutions_unknownExecution(t *testing.T) {
+	keycloakClient, _ := newAuthenticationExecutionTestClient(t, []*authenticationExecutionStub{
+		{id: "a", priority: 10},
+	})
+
+	err := keycloakClient.ReorderAuthenticationExecutions(context.Background(), "test", "flow", []string{"b", "a"})
+	if err == nil || !strings.Contains(err.Error(), "no authentication execution with id b found") {
+		t.Fatalf("expected an error for the unknown execution, got %v", err)
+	}
+}
+
+func TestListAuthenticationExecutionsSharingPriority(t *testing.T) {
+	keycloakClient, _ := newAuthenticationExecutionTestClient(t, []*authenticationExecutionStub{
+		{id: "a", priority: 10},
+		{id: "b", priority: 20},
+		{id: "c", priority: 10},
+	})
+
+	executions, err := keycloakClient.ListAuthenticationExecutionsSharingPriority(context.Background(), "test", "flow", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(executions) != 1 || executions[0].Id != "c" {
+		t.Fatalf("expected only execution c to share the priority of a, got %v", executions)
+	}
+
+	executions, err = keycloakClient.ListAuthenticationExecutionsSharingPriority(context.Background(), "test", "flow", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(executions) != 0 {
+		t.Fatalf("expected no execution to share the priority of b, got %v", executions)
+	}
+}
@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -16,6 +17,11 @@ type AuthenticationFlow struct {
 	BuiltIn     bool   `json:"builtIn"`
 }
 
+// authenticationFlowCopy is used for POST /realms/${realmId}/authentication/flows/${flowAlias}/copy
+type authenticationFlowCopy struct {
+	NewName string `json:"newName"`
+}
+
 func (keycloakClient *KeycloakClient) ListAuthenticationFlows(ctx context.Context, realmId string) ([]*AuthenticationFlow, error) {
 	var authenticationFlows []*AuthenticationFlow
 
@@ -44,6 +50,38 @@ func (keycloakClient *KeycloakClient) NewAuthenticationFlow(ctx context.Context,
 	return nil
 }
 
+// CopyAuthenticationFlow copies a flow, including its executions, subflows and their configs, to a new top level flow.
+// Keycloak responds with 409 when the alias is taken, so the flows are checked first to explain why the copy fails.
+func (keycloakClient *KeycloakClient) CopyAuthenticationFlow(ctx context.Context, realmId, sourceAlias, newAlias string) (*AuthenticationFlow, error) {
+	authenticationFlows, err := keycloakClient.ListAuthenticationFlows(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceExists := false
+	for _, authenticationFlow := range authenticationFlows {
+		if authenticationFlow.Alias == newAlias {
+			return nil, fmt.Errorf("can't copy authentication flow %s to %s, a flow with alias %s already exists in realm %s", sourceAlias, newAlias, newAlias, realmId)
+		}
+		if authenticationFlow.Alias == sourceAlias {
+			sourceExists = true
+		}
+	}
+	if !sourceExists {
+		return nil, fmt.Errorf("can't copy authentication flow %s to %s, no authentication flow found for alias %s in realm %s", sourceAlias, newAlias, sourceAlias, realmId)
+	}
+
+	_, _, err = keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/copy", realmId, url.PathEscape(sourceAlias)), &authenticationFlowCopy{
+		NewName: newAlias,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// older versions of Keycloak don't return the location of the copy
+	return keycloakClient.GetAuthenticationFlowFromAlias(ctx, realmId, newAlias)
+}
+
 func (keycloakClient *KeycloakClient) GetAuthenticationFlow(ctx context.Context, realmId, id string) (*AuthenticationFlow, error) {
 	var authenticationFlow AuthenticationFlow
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), &authenticationFlow, nil)
@@ -81,7 +119,7 @@ func (keycloakClient *KeycloakClient) GetAuthenticationFlowFromAlias(ctx context
 		}
 
 		if len(authenticationFlows) == 0 {
-			return nil, fmt.Errorf("no authentication flow found for alias %s", alias)
+			return nil, fmt.Errorf("no authentication flow found for alias %s in realm %s", alias, realmId)
 		}
 	}
 
@@ -92,7 +130,7 @@ func (keycloakClient *KeycloakClient) GetAuthenticationFlowFromAlias(ctx context
 	}
 
 	if authenticationFlow == nil {
-		return nil, fmt.Errorf("no authentication flow found for alias %s", alias)
+		return nil, fmt.Errorf("no authentication flow found for alias %s in realm %s", alias, realmId)
 	}
 	authenticationFlow.RealmId = realmId
 
@@ -0,0 +1,177 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// AuthenticationFlowExport is the portable structure of a flow. Unlike the executions Keycloak lists for a flow, it doesn't
+// reference anything by id, so it can be used to recreate the flow in another realm.
+type AuthenticationFlowExport struct {
+	ProviderId  string                               `json:"providerId"`
+	Description string                               `json:"description,omitempty"`
+	Executions  []*AuthenticationFlowExportExecution `json:"executions"`
+}
+
+// AuthenticationFlowExportExecution is either an execution of an authenticator or a subflow.
+type AuthenticationFlowExportExecution struct {
+	Authenticator string                           `json:"authenticator,omitempty"`
+	Requirement   string                           `json:"requirement"`
+	Config        *AuthenticationFlowExportConfig  `json:"config,omitempty"`
+	SubFlow       *AuthenticationFlowExportSubFlow `json:"subFlow,omitempty"`
+}
+
+type AuthenticationFlowExportSubFlow struct {
+	Alias       string                               `json:"alias"`
+	ProviderId  string                               `json:"providerId"`
+	Description string                               `json:"description,omitempty"`
+	Executions  []*AuthenticationFlowExportExecution `json:"executions"`
+}
+
+type AuthenticationFlowExportConfig struct {
+	Alias  string            `json:"alias"`
+	Config map[string]string `json:"config"`
+}
+
+// ExportAuthenticationFlow reads the executions, subflows and configs of a flow. Keycloak lists the executions of a flow
+// and all of its subflows as one list, where the executions of each subflow follow the subflow one level deeper.
+func (keycloakClient *KeycloakClient) ExportAuthenticationFlow(ctx context.Context, realmId, alias string) (*AuthenticationFlowExport, error) {
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, realmId, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &AuthenticationFlowExport{
+		ProviderId:  authenticationFlow.ProviderId,
+		Description: authenticationFlow.Description,
+		Executions:  []*AuthenticationFlowExportExecution{},
+	}
+
+	// the executions of each level are added to the last subflow seen on the level above
+	levels := []*[]*AuthenticationFlowExportExecution{&export.Executions}
+	for _, execution := range executions {
+		if execution.Level >= len(levels) {
+			return nil, fmt.Errorf("unexpected level %d of authentication execution %s in flow %s", execution.Level, execution.Id, alias)
+		}
+
+		exportExecution := &AuthenticationFlowExportExecution{
+			Authenticator: execution.ProviderId,
+			Requirement:   execution.Requirement,
+		}
+
+		if execution.AuthenticationFlow {
+			subFlow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, execution.FlowId)
+			if err != nil {
+				return nil, err
+			}
+
+			// the authenticator of a form subflow, ex. registration-page-form, is only part of its execution
+			subFlowExecution, err := keycloakClient.GetAuthenticationExecution(ctx, realmId, alias, execution.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			exportExecution.Authenticator = subFlowExecution.Authenticator
+			exportExecution.SubFlow = &AuthenticationFlowExportSubFlow{
+				Alias:       subFlow.Alias,
+				ProviderId:  subFlow.ProviderId,
+				Description: subFlow.Description,
+				Executions:  []*AuthenticationFlowExportExecution{},
+			}
+		}
+
+		if execution.AuthenticationConfig != "" {
+			config := &AuthenticationExecutionConfig{
+				RealmId: realmId,
+				Id:      execution.AuthenticationConfig,
+			}
+
+			err = keycloakClient.GetAuthenticationExecutionConfig(ctx, config)
+			if err != nil {
+				return nil, err
+			}
+
+			exportExecution.Config = &AuthenticationFlowExportConfig{
+				Alias:  config.Alias,
+				Config: config.Config,
+			}
+		}
+
+		levels = levels[:execution.Level+1]
+		*levels[execution.Level] = append(*levels[execution.Level], exportExecution)
+
+		if exportExecution.SubFlow != nil {
+			levels = append(levels, &exportExecution.SubFlow.Executions)
+		}
+	}
+
+	return export, nil
+}
+
+// ImportAuthenticationFlowExecutions creates the executions, subflows and configs of an export within an existing flow.
+// Keycloak adds each execution after the existing ones, so they end up in the order of the export.
+func (keycloakClient *KeycloakClient) ImportAuthenticationFlowExecutions(ctx context.Context, realmId, parentFlowAlias string, executions []*AuthenticationFlowExportExecution) error {
+	for _, execution := range executions {
+		var executionId string
+
+		if execution.SubFlow != nil {
+			subFlow := &AuthenticationSubFlow{
+				RealmId:         realmId,
+				ParentFlowAlias: parentFlowAlias,
+				Alias:           execution.SubFlow.Alias,
+				ProviderId:      execution.SubFlow.ProviderId,
+				Description:     execution.SubFlow.Description,
+				Authenticator:   execution.Authenticator,
+				Requirement:     execution.Requirement,
+			}
+
+			err := keycloakClient.NewAuthenticationSubFlow(ctx, subFlow)
+			if err != nil {
+				return fmt.Errorf("error importing subflow %s into flow %s: %s", subFlow.Alias, parentFlowAlias, err)
+			}
+
+			executionId, err = keycloakClient.getExecutionId(ctx, subFlow)
+			if err != nil {
+				return err
+			}
+
+			err = keycloakClient.ImportAuthenticationFlowExecutions(ctx, realmId, subFlow.Alias, execution.SubFlow.Executions)
+			if err != nil {
+				return err
+			}
+		} else {
+			authenticationExecution := &AuthenticationExecution{
+				RealmId:         realmId,
+				ParentFlowAlias: parentFlowAlias,
+				Authenticator:   execution.Authenticator,
+				Requirement:     execution.Requirement,
+			}
+
+			err := keycloakClient.NewAuthenticationExecution(ctx, authenticationExecution)
+			if err != nil {
+				return fmt.Errorf("error importing execution %s into flow %s: %s", execution.Authenticator, parentFlowAlias, err)
+			}
+
+			executionId = authenticationExecution.Id
+		}
+
+		if execution.Config != nil {
+			_, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, &AuthenticationExecutionConfig{
+				RealmId:     realmId,
+				ExecutionId: executionId,
+				Alias:       execution.Config.Alias,
+				Config:      execution.Config.Config,
+			})
+			if err != nil {
+				return fmt.Errorf("error importing config %s into flow %s: %s", execution.Config.Alias, parentFlowAlias, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// authenticationFlowTreeNode is a direct execution or subflow of a flow, along with what's needed to tell whether it
+// matches an execution of the desired tree
+type authenticationFlowTreeNode struct {
+	execution     *AuthenticationExecutionInfo
+	authenticator string
+	subFlow       *AuthenticationFlow
+}
+
+// ReconcileAuthenticationFlowExecutions applies a tree of executions and subflows to an existing flow in one pass. Existing
+// executions are kept when they match the desired ones, so that their ids and configs don't change, the others are removed
+// before the missing ones are created. Requirements and configs are then updated where they differ, and each level is
+// brought into the desired order.
+func (keycloakClient *KeycloakClient) ReconcileAuthenticationFlowExecutions(ctx context.Context, realmId, parentFlowAlias string, executions []*AuthenticationFlowExportExecution) error {
+	nodes, err := keycloakClient.listAuthenticationFlowTreeNodes(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return err
+	}
+
+	matchedNodes, unmatchedNodes := matchAuthenticationFlowTreeNodes(nodes, executions)
+
+	// unmatched nodes are removed before anything is created, as a new subflow may reuse the alias of one which changed its type
+	for _, node := range unmatchedNodes {
+		if node.subFlow != nil {
+			err = keycloakClient.DeleteAuthenticationSubFlow(ctx, realmId, parentFlowAlias, node.subFlow.Id)
+		} else {
+			err = keycloakClient.DeleteAuthenticationExecution(ctx, realmId, node.execution.Id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var order []string
+	for i, execution := range executions {
+		executionId, err := keycloakClient.reconcileAuthenticationFlowTreeNode(ctx, realmId, parentFlowAlias, matchedNodes[i], execution)
+		if err != nil {
+			return err
+		}
+
+		if execution.SubFlow != nil {
+			err = keycloakClient.ReconcileAuthenticationFlowExecutions(ctx, realmId, execution.SubFlow.Alias, execution.SubFlow.Executions)
+			if err != nil {
+				return err
+			}
+		}
+
+		order = append(order, executionId)
+	}
+
+	return keycloakClient.ReorderAuthenticationExecutions(ctx, realmId, parentFlowAlias, order)
+}
+
+// reconcileAuthenticationFlowTreeNode creates the execution or subflow when no existing one matched, or updates the matching
+// one, and returns the id of its execution
+func (keycloakClient *KeycloakClient) reconcileAuthenticationFlowTreeNode(ctx context.Context, realmId, parentFlowAlias string, node *authenticationFlowTreeNode, execution *AuthenticationFlowExportExecution) (string, error) {
+	var executionId, configId string
+
+	switch {
+	case node == nil && execution.SubFlow != nil:
+		subFlow := &AuthenticationSubFlow{
+			RealmId:         realmId,
+			ParentFlowAlias: parentFlowAlias,
+			Alias:           execution.SubFlow.Alias,
+			ProviderId:      execution.SubFlow.ProviderId,
+			Description:     execution.SubFlow.Description,
+			Authenticator:   execution.Authenticator,
+			Requirement:     execution.Requirement,
+		}
+
+		err := keycloakClient.NewAuthenticationSubFlow(ctx, subFlow)
+		if err != nil {
+			return "", fmt.Errorf("error creating subflow %s in flow %s: %s", subFlow.Alias, parentFlowAlias, err)
+		}
+
+		executionId, err = keycloakClient.getExecutionId(ctx, subFlow)
+		if err != nil {
+			return "", err
+		}
+	case node == nil:
+		authenticationExecution := &AuthenticationExecution{
+			RealmId:         realmId,
+			ParentFlowAlias: parentFlowAlias,
+			Authenticator:   execution.Authenticator,
+			Requirement:     execution.Requirement,
+		}
+
+		err := keycloakClient.NewAuthenticationExecution(ctx, authenticationExecution)
+		if err != nil {
+			return "", fmt.Errorf("error creating execution %s in flow %s: %s", execution.Authenticator, parentFlowAlias, err)
+		}
+
+		executionId = authenticationExecution.Id
+	case execution.SubFlow != nil:
+		executionId = node.execution.Id
+		configId = node.execution.AuthenticationConfig
+
+		if node.execution.Requirement != execution.Requirement || node.subFlow.Description != execution.SubFlow.Description {
+			err := keycloakClient.UpdateAuthenticationSubFlow(ctx, &AuthenticationSubFlow{
+				Id:              node.subFlow.Id,
+				RealmId:         realmId,
+				ParentFlowAlias: parentFlowAlias,
+				Alias:           node.subFlow.Alias,
+				ProviderId:      node.subFlow.ProviderId,
+				Description:     execution.SubFlow.Description,
+				Authenticator:   node.authenticator,
+				Requirement:     execution.Requirement,
+				Priority:        node.execution.Priority,
+			})
+			if err != nil {
+				return "", err
+			}
+		}
+	default:
+		executionId = node.execution.Id
+		configId = node.execution.AuthenticationConfig
+
+		if node.execution.Requirement != execution.Requirement {
+			err := keycloakClient.UpdateAuthenticationExecutionRequirement(ctx, &authenticationExecutionRequirementUpdate{
+				RealmId:         realmId,
+				ParentFlowAlias: parentFlowAlias,
+				Id:              executionId,
+				Requirement:     execution.Requirement,
+				Priority:        node.execution.Priority,
+			})
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return executionId, keycloakClient.reconcileAuthenticationFlowTreeConfig(ctx, realmId, executionId, configId, execution.Config)
+}
+
+func (keycloakClient *KeycloakClient) reconcileAuthenticationFlowTreeConfig(ctx context.Context, realmId, executionId, configId string, desiredConfig *AuthenticationFlowExportConfig) error {
+	if configId == "" {
+		if desiredConfig == nil {
+			return nil
+		}
+
+		_, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, &AuthenticationExecutionConfig{
+			RealmId:     realmId,
+			ExecutionId: executionId,
+			Alias:       desiredConfig.Alias,
+			Config:      desiredConfig.Config,
+		})
+
+		return err
+	}
+
+	config := &AuthenticationExecutionConfig{
+		RealmId:     realmId,
+		ExecutionId: executionId,
+		Id:          configId,
+	}
+
+	if desiredConfig == nil {
+		err := keycloakClient.DeleteAuthenticationExecutionConfig(ctx, config)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	err := keycloakClient.GetAuthenticationExecutionConfig(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	if config.Alias == desiredConfig.Alias && (len(config.Config) == 0 && len(desiredConfig.Config) == 0 || reflect.DeepEqual(config.Config, desiredConfig.Config)) {
+		return nil
+	}
+
+	config.Alias = desiredConfig.Alias
+	config.Config = desiredConfig.Config
+
+	return keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
+}
+
+func (keycloakClient *KeycloakClient) listAuthenticationFlowTreeNodes(ctx context.Context, realmId, parentFlowAlias string) ([]*authenticationFlowTreeNode, error) {
+	executions, err := keycloakClient.listDirectAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*authenticationFlowTreeNode
+	for _, execution := range executions {
+		node := &authenticationFlowTreeNode{
+			execution:     execution,
+			authenticator: execution.ProviderId,
+		}
+
+		if execution.AuthenticationFlow {
+			node.subFlow, err = keycloakClient.GetAuthenticationFlow(ctx, realmId, execution.FlowId)
+			if err != nil {
+				return nil, err
+			}
+
+			// the authenticator of a form subflow, ex. registration-page-form, is only part of its execution
+			subFlowExecution, err := keycloakClient.GetAuthenticationExecution(ctx, realmId, parentFlowAlias, execution.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			node.authenticator = subFlowExecution.Authenticator
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
+
+// matchAuthenticationFlowTreeNodes pairs each desired execution with the first existing one of the same authenticator, or
+// the existing subflow of the same alias and type, which hasn't been paired yet. The matched nodes are returned in the order
+// of the desired executions, with nil for the ones which have to be created, along with the nodes which have to be removed.
+func matchAuthenticationFlowTreeNodes(nodes []*authenticationFlowTreeNode, executions []*AuthenticationFlowExportExecution) ([]*authenticationFlowTreeNode, []*authenticationFlowTreeNode) {
+	matched := make([]*authenticationFlowTreeNode, len(executions))
+	used := make(map[*authenticationFlowTreeNode]bool, len(nodes))
+
+	for i, execution := range executions {
+		for _, node := range nodes {
+			if !used[node] && authenticationFlowTreeNodeMatches(node, execution) {
+				matched[i] = node
+				used[node] = true
+				break
+			}
+		}
+	}
+
+	var unmatched []*authenticationFlowTreeNode
+	for _, node := range nodes {
+		if !used[node] {
+			unmatched = append(unmatched, node)
+		}
+	}
+
+	return matched, unmatched
+}
+
+// the alias, type and authenticator of a subflow can't be changed in place
+func authenticationFlowTreeNodeMatches(node *authenticationFlowTreeNode, execution *AuthenticationFlowExportExecution) bool {
+	if execution.SubFlow == nil {
+		return node.subFlow == nil && node.authenticator == execution.Authenticator
+	}
+
+	return node.subFlow != nil &&
+		node.subFlow.Alias == execution.SubFlow.Alias &&
+		node.subFlow.ProviderId == execution.SubFlow.ProviderId &&
+		node.authenticator == execution.Authenticator
+}
@@ -0,0 +1,57 @@
+package keycloak
+
+import (
+	"testing"
+)
+
+func testAuthenticationFlowTreeNodes() []*authenticationFlowTreeNode {
+	return []*authenticationFlowTreeNode{
+		{execution: &AuthenticationExecutionInfo{Id: "cookie"}, authenticator: "auth-cookie"},
+		{execution: &AuthenticationExecutionInfo{Id: "forms", AuthenticationFlow: true}, subFlow: &AuthenticationFlow{Alias: "forms", ProviderId: "basic-flow"}},
+		{execution: &AuthenticationExecutionInfo{Id: "script-1"}, authenticator: "auth-script-based"},
+		{execution: &AuthenticationExecutionInfo{Id: "script-2"}, authenticator: "auth-script-based"},
+	}
+}
+
+func assertAuthenticationFlowTreeNodeIds(t *testing.T, nodes []*authenticationFlowTreeNode, ids ...string) {
+	t.Helper()
+
+	if len(nodes) != len(ids) {
+		t.Fatalf("expected %d nodes, got %d", len(ids), len(nodes))
+	}
+
+	for i, node := range nodes {
+		id := ""
+		if node != nil {
+			id = node.execution.Id
+		}
+
+		if id != ids[i] {
+			t.Fatalf("expected node %d to be %q, got %q", i, ids[i], id)
+		}
+	}
+}
+
+func TestMatchAuthenticationFlowTreeNodes_keepsMatchingNodes(t *testing.T) {
+	matched, unmatched := matchAuthenticationFlowTreeNodes(testAuthenticationFlowTreeNodes(), []*AuthenticationFlowExportExecution{
+		{Authenticator: "auth-script-based"},
+		{SubFlow: &AuthenticationFlowExportSubFlow{Alias: "forms", ProviderId: "basic-flow"}},
+		{Authenticator: "auth-cookie"},
+		{Authenticator: "auth-script-based"},
+		{Authenticator: "auth-script-based"},
+	})
+
+	// executions of the same authenticator are matched in their current order, the extra one is created
+	assertAuthenticationFlowTreeNodeIds(t, matched, "script-1", "forms", "cookie", "script-2", "")
+	assertAuthenticationFlowTreeNodeIds(t, unmatched)
+}
+
+func TestMatchAuthenticationFlowTreeNodes_replacesChangedSubFlows(t *testing.T) {
+	matched, unmatched := matchAuthenticationFlowTreeNodes(testAuthenticationFlowTreeNodes(), []*AuthenticationFlowExportExecution{
+		{Authenticator: "auth-cookie"},
+		{SubFlow: &AuthenticationFlowExportSubFlow{Alias: "forms", ProviderId: "form-flow"}, Authenticator: "registration-page-form"},
+	})
+
+	assertAuthenticationFlowTreeNodeIds(t, matched, "cookie", "")
+	assertAuthenticationFlowTreeNodeIds(t, unmatched, "forms", "script-1", "script-2")
+}
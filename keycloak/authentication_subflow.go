@@ -2,8 +2,9 @@ package keycloak
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 )
 
 type AuthenticationSubFlow struct {
@@ -16,9 +17,11 @@ type AuthenticationSubFlow struct {
 	BuiltIn         bool   `json:"builtIn"`    // this controls whether this flow can be edited from the console. it can be updated, but this provider will only set it to `true`
 	Description     string `json:"description"`
 	//execution part
-	Authenticator string `json:"-"` //can be any authenticator see /auth/admin/master/console/#/server-info/providers (not limited to the authenticator spi section) for example could also be part of the form-action spi
-	Priority      int    `json:"-"`
-	Requirement   string `json:"-"`
+	Authenticator        string `json:"-"` //can be any authenticator see /auth/admin/master/console/#/server-info/providers (not limited to the authenticator spi section) for example could also be part of the form-action spi
+	Priority             int    `json:"-"`
+	Requirement          string `json:"-"`
+	ExecutionId          string `json:"-"`
+	AuthenticationConfig string `json:"-"`
 }
 
 // each subflow creates a flow and an execution under the covers
@@ -39,13 +42,30 @@ func (keycloakClient *KeycloakClient) NewAuthenticationSubFlow(ctx context.Conte
 		Description: authenticationSubFlow.Description,
 	}
 
-	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions/flow", authenticationSubFlow.RealmId, authenticationSubFlow.ParentFlowAlias), authenticationSubFlowCreate)
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/executions/flow", authenticationSubFlow.RealmId, url.PathEscape(authenticationSubFlow.ParentFlowAlias)), authenticationSubFlowCreate)
 	if err != nil {
 		return err
 	}
 	authenticationSubFlow.Id = getIdFromLocationHeader(location)
 
-	return keycloakClient.UpdateAuthenticationSubFlow(ctx, authenticationSubFlow)
+	// Keycloak adds the subflow last, and the update has to keep the priority it was given there, otherwise the subflow
+	// would move in front of its siblings
+	update := *authenticationSubFlow
+	if update.Priority == 0 {
+		createdSubFlow, err := keycloakClient.GetAuthenticationSubFlow(ctx, authenticationSubFlow.RealmId, authenticationSubFlow.ParentFlowAlias, authenticationSubFlow.Id)
+		if err != nil {
+			return err
+		}
+		update.Priority = createdSubFlow.Priority
+	}
+
+	err = keycloakClient.UpdateAuthenticationSubFlow(ctx, &update)
+	if err != nil {
+		return err
+	}
+	authenticationSubFlow.ExecutionId = update.ExecutionId
+
+	return nil
 }
 
 func (keycloakClient *KeycloakClient) GetAuthenticationSubFlow(ctx context.Context, realmId, parentFlowAlias, id string) (*AuthenticationSubFlow, error) {
@@ -69,6 +89,8 @@ func (keycloakClient *KeycloakClient) GetAuthenticationSubFlow(ctx context.Conte
 	authenticationSubFlow.Authenticator = subFlowExecution.Authenticator
 	authenticationSubFlow.Requirement = subFlowExecution.Requirement
 	authenticationSubFlow.Priority = subFlowExecution.Priority
+	authenticationSubFlow.ExecutionId = executionId
+	authenticationSubFlow.AuthenticationConfig = subFlowExecution.AuthenticationConfig
 	return &authenticationSubFlow, nil
 }
 
@@ -79,11 +101,15 @@ func (keycloakClient *KeycloakClient) getExecutionId(ctx context.Context, authen
 	}
 
 	for _, ex := range list {
-		if ex.FlowId == authenticationSubFlow.Id {
+		if ex.AuthenticationFlow && ex.FlowId == authenticationSubFlow.Id {
 			return ex.Id, nil
 		}
 	}
-	return "", errors.New("no execution id found for subflow")
+
+	return "", &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("no execution id found for subflow %s in flow %s", authenticationSubFlow.Id, authenticationSubFlow.ParentFlowAlias),
+	}
 }
 
 func (keycloakClient *KeycloakClient) UpdateAuthenticationSubFlow(ctx context.Context, authenticationSubFlow *AuthenticationSubFlow) error {
@@ -100,6 +126,7 @@ func (keycloakClient *KeycloakClient) UpdateAuthenticationSubFlow(ctx context.Co
 	if err != nil {
 		return err
 	}
+	authenticationSubFlow.ExecutionId = executionId
 
 	//update requirement
 	authenticationExecutionUpdateRequirement := &authenticationExecutionRequirementUpdate{
@@ -113,18 +140,68 @@ func (keycloakClient *KeycloakClient) UpdateAuthenticationSubFlow(ctx context.Co
 
 }
 
+// DeleteAuthenticationSubFlow removes the subflow along with its execution within the parent flow.
+// A subflow which no longer exists, for example because its parent flow was deleted first, is treated as deleted.
 func (keycloakClient *KeycloakClient) DeleteAuthenticationSubFlow(ctx context.Context, realmId, parentFlowAlias, id string) error {
-	authenticationSubFlow := AuthenticationSubFlow{
-		Id:              id,
-		ParentFlowAlias: parentFlowAlias,
-		RealmId:         realmId,
+	var authenticationSubFlow AuthenticationSubFlow
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), &authenticationSubFlow, nil)
+	if err != nil {
+		if ErrorIs404(err) {
+			return nil
+		}
+
+		return err
 	}
+	authenticationSubFlow.RealmId = realmId
+	authenticationSubFlow.ParentFlowAlias = parentFlowAlias
+
+	// older versions of Keycloak don't remove nested executions along with the subflow, which would leave them orphaned
+	err = keycloakClient.deleteAuthenticationSubFlowExecutions(ctx, realmId, authenticationSubFlow.Alias)
+	if err != nil {
+		return err
+	}
+
 	executionId, err := keycloakClient.getExecutionId(ctx, &authenticationSubFlow)
+	if err != nil {
+		if !ErrorIs404(err) {
+			return err
+		}
+
+		// the subflow isn't part of its parent flow anymore, so the flow itself is the only thing left to remove
+		err = keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), nil)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	return keycloakClient.DeleteAuthenticationExecution(ctx, realmId, executionId)
+}
+
+func (keycloakClient *KeycloakClient) deleteAuthenticationSubFlowExecutions(ctx context.Context, realmId, subFlowAlias string) error {
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, subFlowAlias)
 	if err != nil {
 		return err
 	}
 
-	return keycloakClient.DeleteAuthenticationExecution(ctx, authenticationSubFlow.RealmId, executionId)
+	for _, execution := range executions {
+		// deeper levels belong to nested subflows, which remove their own executions
+		if execution.Level != 0 {
+			continue
+		}
+
+		if execution.AuthenticationFlow {
+			err = keycloakClient.DeleteAuthenticationSubFlow(ctx, realmId, subFlowAlias, execution.FlowId)
+		} else {
+			err = keycloakClient.DeleteAuthenticationExecution(ctx, realmId, execution.Id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (keycloakClient *KeycloakClient) RaiseAuthenticationSubFlowPriority(ctx context.Context, realmId, parentFlowAlias, id string) error {
@@ -0,0 +1,67 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newClientUpdateTestClient(t *testing.T) (*KeycloakClient, *map[string]interface{}) {
+	var body map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/admin/realms/test/clients/client" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("unable to parse request body %s: %s", data, err)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	return newTestKeycloakClient(server), &body
+}
+
+func checkClearedAuthenticationFlowBindingOverrides(t *testing.T, body map[string]interface{}) {
+	overrides, ok := body["authenticationFlowBindingOverrides"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected authentication flow binding overrides to be sent, got %v", body["authenticationFlowBindingOverrides"])
+	}
+
+	for _, binding := range []string{"browser", "direct_grant"} {
+		if value, ok := overrides[binding]; !ok || value != "" {
+			t.Errorf("expected %s override to be sent as an empty string to remove it, got %v", binding, value)
+		}
+	}
+}
+
+func TestUpdateOpenidClientClearsAuthenticationFlowBindingOverrides(t *testing.T) {
+	keycloakClient, body := newClientUpdateTestClient(t)
+
+	err := keycloakClient.UpdateOpenidClient(context.Background(), &OpenidClient{Id: "client", RealmId: "test", ClientId: "client"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkClearedAuthenticationFlowBindingOverrides(t, *body)
+}
+
+func TestUpdateSamlClientClearsAuthenticationFlowBindingOverrides(t *testing.T) {
+	keycloakClient, body := newClientUpdateTestClient(t)
+
+	err := keycloakClient.UpdateSamlClient(context.Background(), &SamlClient{Id: "client", RealmId: "test", ClientId: "client"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkClearedAuthenticationFlowBindingOverrides(t, *body)
+}
@@ -0,0 +1,55 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ClientInitialAccessToken struct {
+	Id             string `json:"id,omitempty"`
+	RealmId        string `json:"-"`
+	Token          string `json:"token,omitempty"`
+	Timestamp      int    `json:"timestamp,omitempty"`
+	Expiration     int    `json:"expiration"`
+	Count          int    `json:"count"`
+	RemainingCount int    `json:"remainingCount,omitempty"`
+}
+
+// NewClientInitialAccessToken creates the token and sets its id and value, the value is only returned by this request.
+func (keycloakClient *KeycloakClient) NewClientInitialAccessToken(ctx context.Context, token *ClientInitialAccessToken) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients-initial-access", token.RealmId), token)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, token)
+}
+
+// GetClientInitialAccessToken looks the token up in the tokens of the realm, as Keycloak can't get a single token. Keycloak
+// removes tokens which expired or which registered as many clients as they allowed.
+func (keycloakClient *KeycloakClient) GetClientInitialAccessToken(ctx context.Context, realmId, id string) (*ClientInitialAccessToken, error) {
+	var tokens []*ClientInitialAccessToken
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients-initial-access", realmId), &tokens, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		if token.Id == id {
+			token.RealmId = realmId
+			return token, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("client initial access token %s doesn't exist in realm %s", id, realmId),
+	}
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientInitialAccessToken(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients-initial-access/%s", realmId, id), nil)
+}
@@ -0,0 +1,88 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const clientRegistrationPolicyProviderType = "org.keycloak.services.clientregistration.policy.ClientRegistrationPolicy"
+
+// ClientRegistrationPolicy is any policy of the client registration service, such as trusted-hosts or max-clients. The
+// anonymous and authenticated sub types apply to registration requests without and with an initial access token or
+// bearer token.
+type ClientRegistrationPolicy struct {
+	Id         string
+	Name       string
+	RealmId    string
+	ProviderId string
+	SubType    string
+	Config     map[string][]string
+}
+
+func convertFromClientRegistrationPolicyToComponent(policy *ClientRegistrationPolicy) *component {
+	return &component{
+		Id:           policy.Id,
+		Name:         policy.Name,
+		ParentId:     policy.RealmId,
+		ProviderId:   policy.ProviderId,
+		ProviderType: clientRegistrationPolicyProviderType,
+		SubType:      policy.SubType,
+		Config:       policy.Config,
+	}
+}
+
+func convertFromComponentToClientRegistrationPolicy(component *component, realmId string) *ClientRegistrationPolicy {
+	config := component.Config
+	if config == nil {
+		config = map[string][]string{}
+	}
+
+	return &ClientRegistrationPolicy{
+		Id:         component.Id,
+		Name:       component.Name,
+		RealmId:    realmId,
+		ProviderId: component.ProviderId,
+		SubType:    component.SubType,
+		Config:     config,
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewClientRegistrationPolicy(ctx context.Context, policy *ClientRegistrationPolicy) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", policy.RealmId), convertFromClientRegistrationPolicyToComponent(policy))
+	if err != nil {
+		return err
+	}
+
+	policy.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+// GetClientRegistrationPolicy returns the client registration policy with the given id. Other realm components, like
+// keystores, can't be read as a client registration policy, they're reported as missing instead.
+func (keycloakClient *KeycloakClient) GetClientRegistrationPolicy(ctx context.Context, realmId, id string) (*ClientRegistrationPolicy, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if component.ProviderType != clientRegistrationPolicyProviderType {
+		return nil, &ApiError{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("component %s of realm %s is not a client registration policy", id, realmId),
+		}
+	}
+
+	return convertFromComponentToClientRegistrationPolicy(component, realmId), nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateClientRegistrationPolicy(ctx context.Context, policy *ClientRegistrationPolicy) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", policy.RealmId, policy.Id), convertFromClientRegistrationPolicyToComponent(policy))
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientRegistrationPolicy(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
@@ -0,0 +1,59 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newClientRegistrationPolicyTestClient(t *testing.T) *KeycloakClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/realms/test/components/policy-id":
+			json.NewEncoder(w).Encode(&component{
+				Id:           "policy-id",
+				Name:         "Trusted Hosts",
+				ProviderId:   "trusted-hosts",
+				ProviderType: clientRegistrationPolicyProviderType,
+				SubType:      "anonymous",
+				Config:       map[string][]string{"trusted-hosts": {"example.com", "example.org"}},
+			})
+		case "/admin/realms/test/components/keystore-id":
+			json.NewEncoder(w).Encode(&component{
+				Id:           "keystore-id",
+				ProviderId:   "rsa-generated",
+				ProviderType: "org.keycloak.keys.KeyProvider",
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return newTestKeycloakClient(server)
+}
+
+func TestGetClientRegistrationPolicy(t *testing.T) {
+	keycloakClient := newClientRegistrationPolicyTestClient(t)
+
+	policy, err := keycloakClient.GetClientRegistrationPolicy(context.Background(), "test", "policy-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if policy.ProviderId != "trusted-hosts" || policy.SubType != "anonymous" || len(policy.Config["trusted-hosts"]) != 2 {
+		t.Fatalf("expected the anonymous trusted-hosts policy with two hosts, got %+v", policy)
+	}
+}
+
+func TestGetClientRegistrationPolicy_otherComponent(t *testing.T) {
+	keycloakClient := newClientRegistrationPolicyTestClient(t)
+
+	_, err := keycloakClient.GetClientRegistrationPolicy(context.Background(), "test", "keystore-id")
+	if !ErrorIs404(err) {
+		t.Fatalf("expected a keystore to be reported as a missing client registration policy, got %v", err)
+	}
+}
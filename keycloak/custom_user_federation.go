@@ -16,7 +16,7 @@ type CustomUserFederation struct {
 	Enabled  bool
 	Priority int
 
-	CachePolicy string
+	UserFederationCache
 
 	FullSyncPeriod    int
 	ChangedSyncPeriod int
@@ -28,7 +28,7 @@ var (
 	userStorageProviderType = "org.keycloak.storage.UserStorageProvider"
 )
 
-func convertFromCustomUserFederationToComponent(custom *CustomUserFederation) *component {
+func convertFromCustomUserFederationToComponent(custom *CustomUserFederation) (*component, error) {
 	componentConfig := make(map[string][]string)
 
 	if custom.Config != nil {
@@ -36,11 +36,16 @@ func convertFromCustomUserFederationToComponent(custom *CustomUserFederation) *c
 			componentConfig[k] = append(componentConfig[k], j[0])
 		}
 	}
-	componentConfig["cachePolicy"] = append(componentConfig["cachePolicy"], custom.CachePolicy)
 	componentConfig["enabled"] = append(componentConfig["enabled"], strconv.FormatBool(custom.Enabled))
 	componentConfig["priority"] = append(componentConfig["priority"], strconv.Itoa(custom.Priority))
 	componentConfig["fullSyncPeriod"] = append(componentConfig["fullSyncPeriod"], strconv.Itoa(custom.FullSyncPeriod))
 	componentConfig["changedSyncPeriod"] = append(componentConfig["changedSyncPeriod"], strconv.Itoa(custom.ChangedSyncPeriod))
+
+	err := custom.UserFederationCache.setComponentConfig(componentConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	parentId := custom.RealmId
 	if custom.ParentId != "" {
 		parentId = custom.ParentId
@@ -52,7 +57,7 @@ func convertFromCustomUserFederationToComponent(custom *CustomUserFederation) *c
 		ProviderType: userStorageProviderType,
 		ParentId:     parentId,
 		Config:       componentConfig,
-	}
+	}, nil
 }
 
 func convertFromComponentToCustomUserFederation(component *component, realmName string) (*CustomUserFederation, error) {
@@ -79,6 +84,10 @@ func convertFromComponentToCustomUserFederation(component *component, realmName
 		"enabled":           true,
 		"priority":          true,
 		"cachePolicy":       true,
+		"maxLifespan":       true,
+		"evictionDay":       true,
+		"evictionHour":      true,
+		"evictionMinute":    true,
 		"fullSyncPeriod":    true,
 		"changedSyncPeriod": true,
 	}
@@ -99,14 +108,17 @@ func convertFromComponentToCustomUserFederation(component *component, realmName
 		Enabled:  enabled,
 		Priority: priority,
 
-		CachePolicy: component.getConfig("cachePolicy"),
-
 		FullSyncPeriod:    fullSyncPeriod,
 		ChangedSyncPeriod: changedSyncPeriod,
 
 		Config: config,
 	}
 
+	err = custom.UserFederationCache.readComponentConfig(component)
+	if err != nil {
+		return nil, err
+	}
+
 	return custom, nil
 }
 
@@ -125,7 +137,12 @@ func (keycloakClient *KeycloakClient) ValidateCustomUserFederation(ctx context.C
 }
 
 func (keycloakClient *KeycloakClient) NewCustomUserFederation(ctx context.Context, realmId string, customUserFederation *CustomUserFederation) error {
-	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", realmId), convertFromCustomUserFederationToComponent(customUserFederation))
+	component, err := convertFromCustomUserFederationToComponent(customUserFederation)
+	if err != nil {
+		return err
+	}
+
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", realmId), component)
 	if err != nil {
 		return err
 	}
@@ -167,7 +184,12 @@ func (keycloakClient *KeycloakClient) GetCustomUserFederations(ctx context.Conte
 }
 
 func (keycloakClient *KeycloakClient) UpdateCustomUserFederation(ctx context.Context, realmId string, customUserFederation *CustomUserFederation) error {
-	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, customUserFederation.Id), convertFromCustomUserFederationToComponent(customUserFederation))
+	component, err := convertFromCustomUserFederationToComponent(customUserFederation)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, customUserFederation.Id), component)
 }
 
 func (keycloakClient *KeycloakClient) DeleteCustomUserFederation(ctx context.Context, realmName, id string) error {
@@ -20,3 +20,18 @@ func (keycloakClient *KeycloakClient) GetDefaultRoles(ctx context.Context, realm
 
 	return composites, nil
 }
+
+func (keycloakClient *KeycloakClient) GetDefaultClientRoles(ctx context.Context, realmId, id, clientId string) ([]*Role, error) {
+	var composites []*Role
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/roles-by-id/%s/composites/clients/%s", realmId, id, clientId), &composites, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, composite := range composites {
+		composite.RealmId = realmId
+		composite.ClientId = clientId
+	}
+
+	return composites, nil
+}
@@ -2,6 +2,7 @@ package keycloak
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
 	"reflect"
 	"strconv"
@@ -22,23 +23,40 @@ func unmarshalExtraConfig(data []byte, reflectValue reflect.Value, extraConfig *
 			if ok {
 				field := reflectValue.FieldByName(structField.Name)
 				if field.IsValid() && field.CanSet() {
-					if field.Kind() == reflect.String {
-						field.SetString(configValue.(string))
+					// keycloak returns the attributes it stores as strings, but attributes set through the API can be null, numbers or booleans
+					configString, isString := configValue.(string)
+					if field.Kind() == reflect.Ptr {
+						// attributes which aren't sent to every version are pointers, which stay nil when keycloak doesn't return them
+						if elem := field.Type().Elem(); elem.Kind() == reflect.String && isString {
+							value := reflect.New(elem)
+							value.Elem().SetString(configString)
+							field.Set(value)
+						} else if boolVal, err := strconv.ParseBool(fmt.Sprintf("%v", configValue)); elem.Kind() == reflect.Bool && configValue != nil && err == nil {
+							value := reflect.New(elem)
+							value.Elem().SetBool(boolVal)
+							field.Set(value)
+						}
+					} else if field.Kind() == reflect.String {
+						if isString {
+							field.SetString(configString)
+						} else if configValue != nil {
+							field.SetString(fmt.Sprintf("%v", configValue))
+						}
 					} else if field.Kind() == reflect.Bool {
-						boolVal, err := strconv.ParseBool(configValue.(string))
-						if err == nil {
+						if boolVal, ok := configValue.(bool); ok {
+							field.Set(reflect.ValueOf(types.KeycloakBoolQuoted(boolVal)))
+						} else if boolVal, err := strconv.ParseBool(configString); isString && err == nil {
 							field.Set(reflect.ValueOf(types.KeycloakBoolQuoted(boolVal)))
 						}
-					} else if field.Kind() == reflect.TypeOf([]string{}).Kind() {
+					} else if field.Kind() == reflect.TypeOf([]string{}).Kind() && isString {
 						var sliceQuoted types.KeycloakSliceQuoted
 						var sliceHashDelimited types.KeycloakSliceHashDelimited
 
-						if err = json.Unmarshal([]byte(configValue.(string)), &sliceQuoted); err == nil {
+						if err = json.Unmarshal([]byte(configString), &sliceQuoted); err == nil {
 							field.Set(reflect.ValueOf(sliceQuoted))
-						} else if err = sliceHashDelimited.UnmarshalJSON([]byte(configValue.(string))); err == nil {
+						} else if err = sliceHashDelimited.UnmarshalJSON([]byte(configString)); err == nil {
 							field.Set(reflect.ValueOf(sliceHashDelimited))
 						}
-
 					}
 
 					delete(*extraConfig, jsonKey)
@@ -62,7 +80,16 @@ func marshalExtraConfig(reflectValue reflect.Value, extraConfig map[string]inter
 		if jsonKey != "-" {
 			field := reflectValue.Field(i)
 			if field.IsValid() && field.CanSet() {
-				if field.Kind() == reflect.String {
+				if field.Kind() == reflect.Ptr {
+					if field.IsNil() {
+						continue
+					}
+					if field.Elem().Kind() == reflect.String {
+						out[jsonKey] = field.Elem().String()
+					} else if field.Elem().Kind() == reflect.Bool {
+						out[jsonKey] = types.KeycloakBoolQuoted(field.Elem().Bool())
+					}
+				} else if field.Kind() == reflect.String {
 					out[jsonKey] = field.String()
 				} else if field.Kind() == reflect.Bool {
 					out[jsonKey] = types.KeycloakBoolQuoted(field.Bool())
@@ -0,0 +1,102 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUnmarshalExtraConfigWithNonStringAttributes(t *testing.T) {
+	data := []byte(`{
+		"id": "client",
+		"clientId": "client",
+		"attributes": {
+			"backchannel.logout.url": null,
+			"use.refresh.tokens": true,
+			"access.token.lifespan": 300,
+			"post.logout.redirect.uris": null,
+			"custom.attribute": "value"
+		}
+	}`)
+
+	var client OpenidClient
+	err := json.Unmarshal(data, &client)
+	if err != nil {
+		t.Fatalf("expected client with null and boolean attributes to be unmarshalled, got %s", err)
+	}
+
+	if client.Attributes.BackchannelLogoutUrl != "" {
+		t.Errorf("expected a null backchannel logout url to be empty, got %s", client.Attributes.BackchannelLogoutUrl)
+	}
+
+	if !client.Attributes.UseRefreshTokens {
+		t.Errorf("expected use refresh tokens to be true")
+	}
+
+	if client.Attributes.AccessTokenLifespan != "300" {
+		t.Errorf("expected access token lifespan to be 300, got %s", client.Attributes.AccessTokenLifespan)
+	}
+
+	if len(client.Attributes.PostLogoutRedirectUris) != 0 {
+		t.Errorf("expected null post logout redirect uris to be empty, got %v", client.Attributes.PostLogoutRedirectUris)
+	}
+
+	if client.Attributes.ExtraConfig["custom.attribute"] != "value" {
+		t.Errorf("expected custom attribute to be kept in extra config, got %v", client.Attributes.ExtraConfig["custom.attribute"])
+	}
+}
+
+func TestMarshalExtraConfigOmitsUnsetPointerAttributes(t *testing.T) {
+	data, err := json.Marshal(&OpenidClientAttributes{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var attributes map[string]interface{}
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"standard.token.exchange.enabled", "standard.token.exchange.enableRefreshRequestedTokenType"} {
+		if _, ok := attributes[key]; ok {
+			t.Errorf("expected unset attribute %s not to be sent", key)
+		}
+	}
+}
+
+func TestUnmarshalExtraConfigWithPointerAttributes(t *testing.T) {
+	data := []byte(`{
+		"standard.token.exchange.enabled": "true",
+		"standard.token.exchange.enableRefreshRequestedTokenType": "SAME_SESSION"
+	}`)
+
+	var attributes OpenidClientAttributes
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		t.Fatal(err)
+	}
+
+	if attributes.StandardTokenExchangeEnabled == nil || !*attributes.StandardTokenExchangeEnabled {
+		t.Errorf("expected standard token exchange to be enabled, got %v", attributes.StandardTokenExchangeEnabled)
+	}
+
+	if attributes.StandardTokenExchangeRefresh == nil || *attributes.StandardTokenExchangeRefresh != "SAME_SESSION" {
+		t.Errorf("expected standard token exchange refresh to be SAME_SESSION, got %v", attributes.StandardTokenExchangeRefresh)
+	}
+
+	if len(attributes.ExtraConfig) != 0 {
+		t.Errorf("expected pointer attributes not to be kept in extra config, got %v", attributes.ExtraConfig)
+	}
+
+	data, err := json.Marshal(&attributes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var marshalled map[string]interface{}
+	if err := json.Unmarshal(data, &marshalled); err != nil {
+		t.Fatal(err)
+	}
+
+	if marshalled["standard.token.exchange.enabled"] != "true" || marshalled["standard.token.exchange.enableRefreshRequestedTokenType"] != "SAME_SESSION" {
+		t.Errorf("expected pointer attributes to be sent, got %v", marshalled)
+	}
+}
@@ -0,0 +1,75 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// GenericComponent can represent any component, in case the provider doesn't have a dedicated resource for it yet.
+type GenericComponent struct {
+	Id           string
+	RealmId      string
+	Name         string
+	ProviderId   string
+	ProviderType string
+	ParentId     string
+	Config       map[string][]string
+}
+
+func convertFromGenericComponentToComponent(genericComponent *GenericComponent) *component {
+	config := make(map[string][]string)
+	for k, v := range genericComponent.Config {
+		config[k] = v
+	}
+
+	return &component{
+		Id:           genericComponent.Id,
+		Name:         genericComponent.Name,
+		ProviderId:   genericComponent.ProviderId,
+		ProviderType: genericComponent.ProviderType,
+		ParentId:     genericComponent.ParentId,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToGenericComponent(component *component, realmId string) *GenericComponent {
+	return &GenericComponent{
+		Id:           component.Id,
+		RealmId:      realmId,
+		Name:         component.Name,
+		ProviderId:   component.ProviderId,
+		ProviderType: component.ProviderType,
+		ParentId:     component.ParentId,
+		Config:       component.Config,
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewGenericComponent(ctx context.Context, genericComponent *GenericComponent) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", genericComponent.RealmId), convertFromGenericComponentToComponent(genericComponent))
+	if err != nil {
+		return err
+	}
+
+	genericComponent.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetGenericComponent(ctx context.Context, realmId, id string) (*GenericComponent, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToGenericComponent(component, realmId), nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateGenericComponent(ctx context.Context, genericComponent *GenericComponent) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", genericComponent.RealmId, genericComponent.Id), convertFromGenericComponentToComponent(genericComponent))
+}
+
+func (keycloakClient *KeycloakClient) DeleteGenericComponent(ctx context.Context, realmId, id string) error {
+	return keycloakClient.DeleteComponent(ctx, realmId, id)
+}
@@ -3,19 +3,21 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
 type Group struct {
-	Id          string              `json:"id,omitempty"`
-	RealmId     string              `json:"-"`
-	ParentId    string              `json:"-"`
-	Name        string              `json:"name"`
-	Path        string              `json:"path,omitempty"`
-	SubGroups   []*Group            `json:"subGroups,omitempty"`
-	RealmRoles  []string            `json:"realmRoles,omitempty"`
-	ClientRoles map[string][]string `json:"clientRoles,omitempty"`
-	Attributes  map[string][]string `json:"attributes"`
+	Id            string              `json:"id,omitempty"`
+	RealmId       string              `json:"-"`
+	ParentId      string              `json:"-"`
+	Name          string              `json:"name"`
+	Path          string              `json:"path,omitempty"`
+	SubGroupCount int                 `json:"subGroupCount,omitempty"`
+	SubGroups     []*Group            `json:"subGroups,omitempty"`
+	RealmRoles    []string            `json:"realmRoles,omitempty"`
+	ClientRoles   map[string][]string `json:"clientRoles,omitempty"`
+	Attributes    map[string][]string `json:"attributes"`
 }
 
 /*
@@ -227,6 +229,32 @@ func (keycloakClient *KeycloakClient) GetGroupMembers(ctx context.Context, realm
 	return users, nil
 }
 
+// GetGroupMembersCount counts the members of a group. Keycloak doesn't expose a count endpoint for group members,
+// so the brief representation is paged through instead.
+func (keycloakClient *KeycloakClient) GetGroupMembersCount(ctx context.Context, realmId, groupId string) (int, error) {
+	var count, first, pagination = 0, 0, 100
+
+	for {
+		var iterationUsers []*User
+		params := map[string]string{
+			"briefRepresentation": "true",
+			"first":               strconv.Itoa(first),
+			"max":                 strconv.Itoa(pagination),
+		}
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/groups/%s/members", realmId, groupId), &iterationUsers, params)
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(iterationUsers)
+		if len(iterationUsers) < pagination {
+			return count, nil
+		}
+		first += pagination
+	}
+}
+
 func defaultGroupURL(realmName, groupId string) string {
 	return fmt.Sprintf("/realms/%s/default-groups/%s", realmName, groupId)
 }
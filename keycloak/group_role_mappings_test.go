@@ -0,0 +1,115 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type roleMappingTestRequest struct {
+	method string
+	path   string
+	roles  []*Role
+}
+
+// returns a client which sends its requests to a stub server recording the roles sent with each request
+func newRoleMappingTestClient(t *testing.T) (*KeycloakClient, func() []roleMappingTestRequest) {
+	var mutex sync.Mutex
+	var requests []roleMappingTestRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var roles []*Role
+		if err := json.NewDecoder(r.Body).Decode(&roles); err != nil {
+			t.Errorf("expected a list of roles, got %s", err)
+		}
+
+		mutex.Lock()
+		requests = append(requests, roleMappingTestRequest{method: r.Method, path: r.URL.Path, roles: roles})
+		mutex.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+
+	return keycloakClient, func() []roleMappingTestRequest {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return append([]roleMappingTestRequest(nil), requests...)
+	}
+}
+
+func testRoleMappingRoles() []*Role {
+	return []*Role{
+		{Id: "role-1", Name: "one"},
+		{Id: "role-2", Name: "two"},
+		{Id: "role-3", Name: "three"},
+	}
+}
+
+func assertSingleRoleMappingRequest(t *testing.T, requests []roleMappingTestRequest, method, path string) {
+	t.Helper()
+
+	if len(requests) != 1 {
+		t.Fatalf("expected a single request, got %d", len(requests))
+	}
+
+	if requests[0].method != method || requests[0].path != path {
+		t.Fatalf("expected %s %s, got %s %s", method, path, requests[0].method, requests[0].path)
+	}
+
+	if len(requests[0].roles) != 3 {
+		t.Fatalf("expected the request to contain 3 roles, got %d", len(requests[0].roles))
+	}
+
+	for i, role := range testRoleMappingRoles() {
+		if requests[0].roles[i].Id != role.Id {
+			t.Fatalf("expected role %d to be %s, got %s", i, role.Id, requests[0].roles[i].Id)
+		}
+	}
+}
+
+func TestGroupRoleMappings_addRealmRolesInSingleRequest(t *testing.T) {
+	keycloakClient, requests := newRoleMappingTestClient(t)
+
+	if err := keycloakClient.AddRealmRolesToGroup(context.Background(), "test", "group", testRoleMappingRoles()); err != nil {
+		t.Fatalf("expected roles to be added, got %s", err)
+	}
+
+	assertSingleRoleMappingRequest(t, requests(), http.MethodPost, "/admin/realms/test/groups/group/role-mappings/realm")
+}
+
+func TestGroupRoleMappings_removeClientRolesInSingleRequest(t *testing.T) {
+	keycloakClient, requests := newRoleMappingTestClient(t)
+
+	if err := keycloakClient.RemoveClientRolesFromGroup(context.Background(), "test", "group", "client", testRoleMappingRoles()); err != nil {
+		t.Fatalf("expected roles to be removed, got %s", err)
+	}
+
+	assertSingleRoleMappingRequest(t, requests(), http.MethodDelete, "/admin/realms/test/groups/group/role-mappings/clients/client")
+}
+
+func TestUserRoleMappings_addClientRolesInSingleRequest(t *testing.T) {
+	keycloakClient, requests := newRoleMappingTestClient(t)
+
+	if err := keycloakClient.AddClientRolesToUser(context.Background(), "test", "user", "client", testRoleMappingRoles()); err != nil {
+		t.Fatalf("expected roles to be added, got %s", err)
+	}
+
+	assertSingleRoleMappingRequest(t, requests(), http.MethodPost, "/admin/realms/test/users/user/role-mappings/clients/client")
+}
+
+func TestUserRoleMappings_removeRealmRolesInSingleRequest(t *testing.T) {
+	keycloakClient, requests := newRoleMappingTestClient(t)
+
+	if err := keycloakClient.RemoveRealmRolesFromUser(context.Background(), "test", "user", testRoleMappingRoles()); err != nil {
+		t.Fatalf("expected roles to be removed, got %s", err)
+	}
+
+	assertSingleRoleMappingRequest(t, requests(), http.MethodDelete, "/admin/realms/test/users/user/role-mappings/realm")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+	"net/url"
 	"reflect"
 )
 
@@ -101,6 +102,12 @@ func (keycloakClient *KeycloakClient) DeleteIdentityProvider(ctx context.Context
 	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", realm, alias), nil)
 }
 
+// GetIdentityProviderAccountLinkingUrl returns the url of the client initiated account linking endpoint of an identity provider.
+// It's built from the url the provider talks to Keycloak with, which might differ from the url users reach Keycloak at.
+func (keycloakClient *KeycloakClient) GetIdentityProviderAccountLinkingUrl(realm, alias string) string {
+	return fmt.Sprintf("%s/realms/%s/broker/%s/link", keycloakClient.baseUrl, url.PathEscape(realm), url.PathEscape(alias))
+}
+
 func (f *IdentityProviderConfig) UnmarshalJSON(data []byte) error {
 	return unmarshalExtraConfig(data, reflect.ValueOf(f).Elem(), &f.ExtraConfig)
 }
@@ -1,14 +1,15 @@
 package keycloak
 
 import (
-	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"io"
+	"net"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -20,8 +21,6 @@ import (
 	"github.com/hashicorp/go-version"
 
 	"golang.org/x/net/publicsuffix"
-
-	"github.com/hashicorp/go-retryablehttp"
 )
 
 type KeycloakClient struct {
@@ -35,6 +34,9 @@ type KeycloakClient struct {
 	additionalHeaders map[string]string
 	debug             bool
 	redHatSSO         bool
+	retryPolicy       RetryPolicy
+	rateLimiter       *rateLimiter
+	requestTimeout    time.Duration
 }
 
 type ClientCredentials struct {
@@ -53,6 +55,10 @@ const (
 	tokenUrl = "%s/realms/%s/protocol/openid-connect/token"
 )
 
+// DefaultRequestTimeout bounds a whole call to the admin API, including retries and refreshing the credentials. Each attempt
+// is also bounded by the timeout of the http client.
+const DefaultRequestTimeout = time.Minute * 5
+
 // https://access.redhat.com/articles/2342881
 var redHatSSO7VersionMap = map[int]string{
 	6: "18.0.0",
@@ -60,7 +66,7 @@ var redHatSSO7VersionMap = map[int]string{
 	4: "9.0.17",
 }
 
-func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout int, caCert string, tlsInsecureSkipVerify bool, userAgent string, redHatSSO bool, additionalHeaders map[string]string) (*KeycloakClient, error) {
+func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecret, realm, username, password string, initialLogin bool, clientTimeout int, caCert string, tlsInsecureSkipVerify bool, userAgent string, redHatSSO bool, additionalHeaders map[string]string, retryPolicy RetryPolicy, rateLimit float64, requestTimeout time.Duration) (*KeycloakClient, error) {
 	clientCredentials := &ClientCredentials{
 		ClientId:     clientId,
 		ClientSecret: clientSecret,
@@ -93,6 +99,9 @@ func NewKeycloakClient(ctx context.Context, url, basePath, clientId, clientSecre
 		userAgent:         userAgent,
 		redHatSSO:         redHatSSO,
 		additionalHeaders: additionalHeaders,
+		retryPolicy:       retryPolicy,
+		rateLimiter:       newRateLimiter(rateLimit),
+		requestTimeout:    requestTimeout,
 	}
 
 	if keycloakClient.initialLogin {
@@ -134,7 +143,7 @@ func (keycloakClient *KeycloakClient) login(ctx context.Context) error {
 		accessTokenRequest.Header.Set("User-Agent", keycloakClient.userAgent)
 	}
 
-	accessTokenResponse, err := keycloakClient.httpClient.Do(accessTokenRequest)
+	accessTokenResponse, err := keycloakClient.doWithRetry(ctx, accessTokenRequest, nil)
 	if err != nil {
 		return err
 	}
@@ -225,7 +234,7 @@ func (keycloakClient *KeycloakClient) Refresh(ctx context.Context) error {
 		refreshTokenRequest.Header.Set("User-Agent", keycloakClient.userAgent)
 	}
 
-	refreshTokenResponse, err := keycloakClient.httpClient.Do(refreshTokenRequest)
+	refreshTokenResponse, err := keycloakClient.doWithRetry(ctx, refreshTokenRequest, nil)
 	if err != nil {
 		return err
 	}
@@ -303,11 +312,19 @@ func (keycloakClient *KeycloakClient) addRequestHeaders(request *http.Request) {
 Sends an HTTP request and refreshes credentials on 403 or 401 errors
 */
 func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *http.Request, body []byte) ([]byte, string, error) {
+	if keycloakClient.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, keycloakClient.requestTimeout, errRequestTimeout)
+		defer cancel()
+
+		request = request.WithContext(ctx)
+	}
+
 	if !keycloakClient.initialLogin {
 		keycloakClient.initialLogin = true
 		err := keycloakClient.login(ctx)
 		if err != nil {
-			return nil, "", fmt.Errorf("error logging in: %s", err)
+			return nil, "", fmt.Errorf("error logging in: %s", keycloakClient.timeoutError(ctx, request, err))
 		}
 	}
 
@@ -320,7 +337,6 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 	}
 
 	if body != nil {
-		request.Body = io.NopCloser(bytes.NewReader(body))
 		requestLogArgs["body"] = string(body)
 	}
 
@@ -328,9 +344,9 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 
 	keycloakClient.addRequestHeaders(request)
 
-	response, err := keycloakClient.httpClient.Do(request)
+	response, err := keycloakClient.doWithRetry(ctx, request, body)
 	if err != nil {
-		return nil, "", fmt.Errorf("error sending request: %v", err)
+		return nil, "", fmt.Errorf("error sending request: %w", keycloakClient.timeoutError(ctx, request, err))
 	}
 
 	// Unauthorized: Token could have expired
@@ -342,17 +358,14 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 
 		err := keycloakClient.Refresh(ctx)
 		if err != nil {
-			return nil, "", fmt.Errorf("error refreshing credentials: %s", err)
+			return nil, "", fmt.Errorf("error refreshing credentials: %w", keycloakClient.timeoutError(ctx, request, err))
 		}
 
 		keycloakClient.addRequestHeaders(request)
 
-		if body != nil {
-			request.Body = io.NopCloser(bytes.NewReader(body))
-		}
-		response, err = keycloakClient.httpClient.Do(request)
+		response, err = keycloakClient.doWithRetry(ctx, request, body)
 		if err != nil {
-			return nil, "", fmt.Errorf("error sending request after refresh: %v", err)
+			return nil, "", fmt.Errorf("error sending request after refresh: %w", keycloakClient.timeoutError(ctx, request, err))
 		}
 	}
 
@@ -360,7 +373,7 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 
 	responseBody, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, "", err
+		return nil, "", keycloakClient.timeoutError(ctx, request, err)
 	}
 
 	responseLogArgs := map[string]interface{}{
@@ -389,6 +402,29 @@ func (keycloakClient *KeycloakClient) sendRequest(ctx context.Context, request *
 	return responseBody, response.Header.Get("Location"), nil
 }
 
+// errRequestTimeout is the cause of the context of a request which ran into the request timeout of the client.
+var errRequestTimeout = errors.New("request timeout exceeded")
+
+// timeoutError tells which timeout a request ran into, as the errors of the http client only mention the context deadline.
+// Other errors, including deadlines of the caller, are returned unchanged.
+func (keycloakClient *KeycloakClient) timeoutError(ctx context.Context, request *http.Request, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// the http client reports the cause of the deadline, which only means something to this client
+	if errors.Is(context.Cause(ctx), errRequestTimeout) {
+		return fmt.Errorf("%s request to %s timed out after %s, the timeout can be raised with the request_timeout provider argument: %w", request.Method, request.URL.Path, keycloakClient.requestTimeout, ctx.Err())
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() && keycloakClient.httpClient.Timeout > 0 && ctx.Err() == nil {
+		return fmt.Errorf("%s request to %s timed out after %s, the timeout can be raised with the client_timeout provider argument: %w", request.Method, request.URL.Path, keycloakClient.httpClient.Timeout, err)
+	}
+
+	return err
+}
+
 func (keycloakClient *KeycloakClient) get(ctx context.Context, path string, resource interface{}, params map[string]string) error {
 	body, err := keycloakClient.getRaw(ctx, path, params)
 	if err != nil {
@@ -518,15 +554,12 @@ func newHttpClient(tlsInsecureSkipVerify bool, clientTimeout int, caCert string)
 		transport.TLSClientConfig.RootCAs = caCertPool
 	}
 
-	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = 1
-	retryClient.RetryWaitMin = time.Second * 1
-	retryClient.RetryWaitMax = time.Second * 3
-
-	httpClient := retryClient.StandardClient()
-	httpClient.Timeout = time.Second * time.Duration(clientTimeout)
-	httpClient.Transport = transport
-	httpClient.Jar = cookieJar
+	// retries are handled by doWithRetry, each attempt gets its own timeout
+	httpClient := &http.Client{
+		Timeout:   time.Second * time.Duration(clientTimeout),
+		Transport: transport,
+		Jar:       cookieJar,
+	}
 
 	return httpClient, nil
 }
@@ -0,0 +1,116 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// returns a client which is limited to the given rate, sending its requests to a stub server recording when it received them
+func newRateLimitTestClient(t *testing.T, requestsPerSecond float64) (*KeycloakClient, func() []time.Time) {
+	var mutex sync.Mutex
+	var requests []time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests = append(requests, time.Now())
+		mutex.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.rateLimiter = newRateLimiter(requestsPerSecond)
+
+	return keycloakClient, func() []time.Time {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return append([]time.Time(nil), requests...)
+	}
+}
+
+func TestKeycloakClientRateLimit_spacesRequests(t *testing.T) {
+	keycloakClient, requests := newRateLimitTestClient(t, 20)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var result map[string]interface{}
+			if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err != nil {
+				t.Errorf("expected request to succeed, got %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	received := requests()
+	if len(received) != 5 {
+		t.Fatalf("expected 5 requests, got %d", len(received))
+	}
+
+	// 5 requests at 20 per second take at least 4 intervals of 50ms, allowing for some imprecision of the timers
+	if elapsed := received[4].Sub(received[0]); elapsed < time.Millisecond*190 {
+		t.Fatalf("expected requests to be spaced by the rate limit, 5 requests took %s", elapsed)
+	}
+}
+
+func TestKeycloakClientRateLimit_disabled(t *testing.T) {
+	if limiter := newRateLimiter(0); limiter != nil {
+		t.Fatalf("expected a rate limit of zero to not create a limiter")
+	}
+
+	keycloakClient, requests := newRateLimitTestClient(t, 0)
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		var result map[string]interface{}
+		if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err != nil {
+			t.Fatalf("expected request to succeed, got %s", err)
+		}
+	}
+
+	if len(requests()) != 20 {
+		t.Fatalf("expected 20 requests, got %d", len(requests()))
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second*5 {
+		t.Fatalf("expected requests to not be limited, took %s", elapsed)
+	}
+}
+
+func TestKeycloakClientRateLimit_stopsWhenContextIsDone(t *testing.T) {
+	keycloakClient, requests := newRateLimitTestClient(t, 0.01)
+
+	var result map[string]interface{}
+	if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err != nil {
+		t.Fatalf("expected first request to succeed, got %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	start := time.Now()
+
+	err := keycloakClient.get(ctx, "/realms/test", &result, nil)
+	if err == nil || !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Fatalf("expected context deadline to be exceeded, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second*5 {
+		t.Fatalf("expected waiting for the rate limiter to stop once the context is done, took %s", elapsed)
+	}
+
+	if len(requests()) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests()))
+	}
+}
@@ -0,0 +1,164 @@
+package keycloak
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryPolicy controls how requests are retried when Keycloak, or a load balancer in front of it, is temporarily unavailable.
+type RetryPolicy struct {
+	// MaxAttempts is the number of retries after the initial request, zero disables retries
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter randomizes each backoff between half of it and all of it, so parallel requests don't retry in lockstep
+	Jitter bool
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Second,
+	MaxBackoff:     time.Second * 30,
+	Jitter:         true,
+}
+
+// Gateway errors are returned by load balancers while Keycloak restarts, and 429 by rate limiters in front of Keycloak. Neither
+// reached Keycloak, so they are retried for every method. A 500 might've been returned after a POST was processed, so it isn't.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// A request failing without a response might've been processed anyway, so only requests which can safely be sent twice are retried.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodHead:   true,
+	http.MethodPut:    true,
+	http.MethodDelete: true,
+}
+
+func (policy RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := policy.InitialBackoff
+	for i := 0; i < attempt && backoff < policy.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+
+	if policy.Jitter && backoff > 1 {
+		backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)))
+	}
+
+	return backoff
+}
+
+func (policy RetryPolicy) shouldRetry(request *http.Request, response *http.Response, err error) bool {
+	if request.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotentMethods[request.Method]
+	}
+
+	return retryableStatusCodes[response.StatusCode]
+}
+
+// retryAfter parses the Retry-After header of a response, which is either a number of seconds or a date.
+func retryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// doWithRetry sends the request, retrying it according to the retry policy of the client. The body is sent again with each
+// attempt, either from the given bytes or from the request itself. Every attempt waits for the rate limiter of the client,
+// and waiting stops once the context is done.
+func (keycloakClient *KeycloakClient) doWithRetry(ctx context.Context, request *http.Request, body []byte) (*http.Response, error) {
+	policy := keycloakClient.retryPolicy
+
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		} else if attempt > 0 && request.GetBody != nil {
+			requestBody, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			request.Body = requestBody
+		}
+
+		if err := keycloakClient.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		response, err := keycloakClient.httpClient.Do(request)
+		if attempt >= policy.MaxAttempts || !policy.shouldRetry(request, response, err) {
+			return response, err
+		}
+
+		logArgs := map[string]interface{}{
+			"method":  request.Method,
+			"path":    request.URL.Path,
+			"attempt": attempt + 1,
+		}
+		delay := policy.backoff(attempt)
+		if err != nil {
+			logArgs["error"] = err.Error()
+		} else {
+			logArgs["status"] = response.Status
+
+			// Keycloak, or a proxy in front of it, knows best when it will accept requests again, but waiting longer than the
+			// retry policy allows would hang the provider
+			if retryAfterDelay, ok := retryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+				delay = retryAfterDelay
+				if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+					delay = policy.MaxBackoff
+				}
+			}
+
+			// the connection can only be reused once the body was read
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			delay = time.Until(deadline)
+		}
+		logArgs["delay"] = delay.String()
+		tflog.Debug(ctx, "Request failed, retrying", logArgs)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
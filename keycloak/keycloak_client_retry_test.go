@@ -0,0 +1,364 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond * 10,
+}
+
+// returns a client which sends its requests to a stub server failing with the given status the given number of times
+func newRetryTestClient(t *testing.T, failures int32, status int, policy RetryPolicy) (*KeycloakClient, *int32) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.retryPolicy = policy
+
+	return keycloakClient, &attempts
+}
+
+func TestKeycloakClientRetry_succeedsAfterServiceUnavailable(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 2, http.StatusServiceUnavailable, testRetryPolicy)
+
+	var result map[string]interface{}
+	if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if *attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_retriesPost(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 1, http.StatusBadGateway, testRetryPolicy)
+
+	if _, _, err := keycloakClient.post(context.Background(), "/realms", map[string]string{"realm": "test"}); err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if *attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_doesNotRetryClientErrors(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 1, http.StatusConflict, testRetryPolicy)
+
+	_, _, err := keycloakClient.post(context.Background(), "/realms", map[string]string{"realm": "test"})
+
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusConflict {
+		t.Fatalf("expected a 409 error, got %v", err)
+	}
+
+	if *attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_doesNotRetryInternalServerError(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 1, http.StatusInternalServerError, testRetryPolicy)
+
+	_, _, err := keycloakClient.post(context.Background(), "/realms", map[string]string{"realm": "test"})
+
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusInternalServerError {
+		t.Fatalf("expected a 500 error, got %v", err)
+	}
+
+	if *attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_doesNotRetryNotImplemented(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 1, http.StatusNotImplemented, testRetryPolicy)
+
+	var result map[string]interface{}
+	err := keycloakClient.get(context.Background(), "/realms/test", &result, nil)
+
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotImplemented {
+		t.Fatalf("expected a 501 error, got %v", err)
+	}
+
+	if *attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_stopsAfterMaxAttempts(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 10, http.StatusGatewayTimeout, testRetryPolicy)
+
+	var result map[string]interface{}
+	err := keycloakClient.get(context.Background(), "/realms/test", &result, nil)
+
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected a 504 error, got %v", err)
+	}
+
+	if *attempts != 4 {
+		t.Fatalf("expected 4 attempts, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_disabled(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 1, http.StatusServiceUnavailable, RetryPolicy{})
+
+	var result map[string]interface{}
+	if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err == nil {
+		t.Fatal("expected request to fail")
+	}
+
+	if *attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_stopsWhenContextIsDone(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 10, http.StatusServiceUnavailable, RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	start := time.Now()
+
+	var result map[string]interface{}
+	err := keycloakClient.get(ctx, "/realms/test", &result, nil)
+	if err == nil || !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Fatalf("expected context deadline to be exceeded, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second*5 {
+		t.Fatalf("expected waiting for a retry to stop once the context is done, took %s", elapsed)
+	}
+
+	if *attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_backoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Second * 5,
+	}
+
+	for attempt, expected := range []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5} {
+		if backoff := policy.backoff(attempt); backoff != expected {
+			t.Errorf("expected backoff of attempt %d to be %s, got %s", attempt, expected, backoff)
+		}
+	}
+
+	policy.Jitter = true
+	for attempt := 0; attempt < 5; attempt++ {
+		if backoff := policy.backoff(attempt); backoff < time.Second/2 || backoff > time.Second*5 {
+			t.Errorf("expected jittered backoff of attempt %d to be between 500ms and 5s, got %s", attempt, backoff)
+		}
+	}
+}
+
+func TestKeycloakClientRetry_transportErrorsOnlyRetryIdempotentRequests(t *testing.T) {
+	var attempts int32
+
+	// closes every connection without responding
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+
+		connection, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("error hijacking connection: %s", err)
+			return
+		}
+		connection.Close()
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.retryPolicy = testRetryPolicy
+
+	if err := keycloakClient.put(context.Background(), "/realms/test", map[string]string{"realm": "test"}); err == nil {
+		t.Fatal("expected put to fail")
+	}
+	if attempts != 4 {
+		t.Fatalf("expected put to be attempted 4 times, got %d", attempts)
+	}
+
+	atomic.StoreInt32(&attempts, 0)
+
+	if _, _, err := keycloakClient.post(context.Background(), "/realms", map[string]string{"realm": "test"}); err == nil {
+		t.Fatal("expected post to fail")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected post to be attempted once, got %d", attempts)
+	}
+}
+
+func TestKeycloakClientRetry_waitsForRetryAfter(t *testing.T) {
+	var attempts int32
+	var firstAttempt, secondAttempt time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			firstAttempt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		secondAttempt = time.Now()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.retryPolicy = RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Second * 5,
+	}
+
+	if _, _, err := keycloakClient.post(context.Background(), "/realms", map[string]string{"realm": "test"}); err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+
+	// the retry policy would've retried after a millisecond
+	if elapsed := secondAttempt.Sub(firstAttempt); elapsed < time.Second {
+		t.Fatalf("expected the retry to wait for the Retry-After header, retried after %s", elapsed)
+	}
+}
+
+func TestKeycloakClientRetry_capsRetryAfter(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.retryPolicy = testRetryPolicy
+
+	start := time.Now()
+	var result map[string]interface{}
+	if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the Retry-After header to be capped by the retry policy, retried after %s", elapsed)
+	}
+}
+
+func TestKeycloakClientRetry_retryAfterStopsAtContextDeadline(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.retryPolicy = RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Hour,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+
+	start := time.Now()
+	var result map[string]interface{}
+	if err := keycloakClient.get(ctx, "/realms/test", &result, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the context deadline to be exceeded, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected retrying to stop at the context deadline, stopped after %s", elapsed)
+	}
+}
+
+func TestKeycloakClientRetry_tooManyRequestsWithoutRetryAfter(t *testing.T) {
+	keycloakClient, attempts := newRetryTestClient(t, 2, http.StatusTooManyRequests, testRetryPolicy)
+
+	var result map[string]interface{}
+	if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err != nil {
+		t.Fatalf("expected request to succeed after retrying, got %s", err)
+	}
+
+	if *attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *attempts)
+	}
+}
+
+func TestKeycloakClientRetry_retryAfter(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		"seconds":     {header: "120", expected: time.Minute * 2, ok: true},
+		"zero":        {header: "0", expected: 0, ok: true},
+		"date":        {header: "Mon, 01 Jan 2024 12:00:30 GMT", expected: time.Second * 30, ok: true},
+		"past date":   {header: "Mon, 01 Jan 2024 11:00:00 GMT", expected: 0, ok: true},
+		"missing":     {header: "", ok: false},
+		"negative":    {header: "-1", ok: false},
+		"unparseable": {header: "soon", ok: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			delay, ok := retryAfter(test.header, now)
+			if ok != test.ok || delay != test.expected {
+				t.Fatalf("expected Retry-After %q to be parsed to %s (%t), got %s (%t)", test.header, test.expected, test.ok, delay, ok)
+			}
+		})
+	}
+}
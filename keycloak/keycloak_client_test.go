@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"testing"
@@ -50,7 +52,7 @@ func TestAccKeycloakApiClientRefresh(t *testing.T) {
 
 	keycloakClient, err := NewKeycloakClient(ctx, os.Getenv("KEYCLOAK_URL"), "", os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), os.Getenv("KEYCLOAK_USER"), os.Getenv("KEYCLOAK_PASSWORD"), true, clientTimeout, "", false, "", false, map[string]string{
 		"foo": "bar",
-	})
+	}, DefaultRetryPolicy, 0, DefaultRequestTimeout)
 	if err != nil {
 		t.Fatalf("%s", err)
 	}
@@ -109,3 +111,13 @@ func TestAccKeycloakApiClientRefresh(t *testing.T) {
 		}
 	}
 }
+
+// newTestKeycloakClient returns a client sending its requests to the given server, without logging in first
+func newTestKeycloakClient(server *httptest.Server) *KeycloakClient {
+	return &KeycloakClient{
+		baseUrl:           server.URL,
+		initialLogin:      true,
+		clientCredentials: &ClientCredentials{},
+		httpClient:        &http.Client{},
+	}
+}
@@ -0,0 +1,120 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// returns a client which sends its requests to a stub server answering after the given delay
+func newTimeoutTestClient(t *testing.T, delay time.Duration, requestTimeout time.Duration, httpClientTimeout time.Duration) *KeycloakClient {
+	done := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-done:
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(func() {
+		close(done)
+		server.Close()
+	})
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.httpClient = &http.Client{Timeout: httpClientTimeout}
+	keycloakClient.requestTimeout = requestTimeout
+
+	return keycloakClient
+}
+
+func TestKeycloakClientTimeout_requestTimeout(t *testing.T) {
+	keycloakClient := newTimeoutTestClient(t, time.Second*5, time.Millisecond*50, 0)
+
+	start := time.Now()
+	var result map[string]interface{}
+	err := keycloakClient.get(context.Background(), "/realms/test", &result, nil)
+	if err == nil {
+		t.Fatal("expected request to time out")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second*2 {
+		t.Fatalf("expected request to time out after 50ms, took %s", elapsed)
+	}
+
+	if !strings.Contains(err.Error(), "GET request to /admin/realms/test timed out after 50ms") || !strings.Contains(err.Error(), "request_timeout") {
+		t.Fatalf("expected error to tell the request_timeout was exceeded, got %s", err)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected error to wrap context.DeadlineExceeded, got %s", err)
+	}
+}
+
+func TestKeycloakClientTimeout_coversRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+	keycloakClient.retryPolicy = RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Second * 10,
+	}
+	keycloakClient.requestTimeout = time.Millisecond * 50
+
+	start := time.Now()
+	err := keycloakClient.put(context.Background(), "/realms/test", map[string]string{"realm": "test"})
+	if err == nil || !strings.Contains(err.Error(), "PUT request to /admin/realms/test timed out after 50ms") {
+		t.Fatalf("expected request to time out while waiting to retry, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second*2 {
+		t.Fatalf("expected request to time out after 50ms, took %s", elapsed)
+	}
+}
+
+func TestKeycloakClientTimeout_clientTimeout(t *testing.T) {
+	keycloakClient := newTimeoutTestClient(t, time.Second*5, 0, time.Millisecond*50)
+
+	var result map[string]interface{}
+	err := keycloakClient.get(context.Background(), "/realms/test", &result, nil)
+	if err == nil || !strings.Contains(err.Error(), "GET request to /admin/realms/test timed out after 50ms") || !strings.Contains(err.Error(), "client_timeout") {
+		t.Fatalf("expected error to tell the client_timeout was exceeded, got %v", err)
+	}
+}
+
+func TestKeycloakClientTimeout_callerDeadline(t *testing.T) {
+	keycloakClient := newTimeoutTestClient(t, time.Second*5, time.Minute, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	var result map[string]interface{}
+	err := keycloakClient.get(ctx, "/realms/test", &result, nil)
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline of the caller to be exceeded, got %v", err)
+	}
+
+	if strings.Contains(err.Error(), "request_timeout") {
+		t.Fatalf("expected error not to blame the request_timeout, got %s", err)
+	}
+}
+
+func TestKeycloakClientTimeout_fastRequest(t *testing.T) {
+	keycloakClient := newTimeoutTestClient(t, 0, time.Second*5, 0)
+
+	var result map[string]interface{}
+	if err := keycloakClient.get(context.Background(), "/realms/test", &result, nil); err != nil {
+		t.Fatalf("expected request to succeed, got %s", err)
+	}
+}
@@ -13,8 +13,9 @@ type OpenIdAudienceProtocolMapper struct {
 	ClientId      string
 	ClientScopeId string
 
-	AddToIdToken     bool
-	AddToAccessToken bool
+	AddToIdToken          bool
+	AddToAccessToken      bool
+	AddToLightweightClaim bool
 
 	IncludedClientAudience string
 	IncludedCustomAudience string
@@ -29,6 +30,7 @@ func (mapper *OpenIdAudienceProtocolMapper) convertToGenericProtocolMapper() *pr
 		Config: map[string]string{
 			addToIdTokenField:           strconv.FormatBool(mapper.AddToIdToken),
 			addToAccessTokenField:       strconv.FormatBool(mapper.AddToAccessToken),
+			addToLightweightClaimField:  strconv.FormatBool(mapper.AddToLightweightClaim),
 			includedClientAudienceField: mapper.IncludedClientAudience,
 			includedCustomAudienceField: mapper.IncludedCustomAudience,
 		},
@@ -46,6 +48,11 @@ func (protocolMapper *protocolMapper) convertToOpenIdAudienceProtocolMapper(real
 		return nil, err
 	}
 
+	addToLightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
 	return &OpenIdAudienceProtocolMapper{
 		Id:            protocolMapper.Id,
 		Name:          protocolMapper.Name,
@@ -53,8 +60,9 @@ func (protocolMapper *protocolMapper) convertToOpenIdAudienceProtocolMapper(real
 		ClientId:      clientId,
 		ClientScopeId: clientScopeId,
 
-		AddToIdToken:     addToIdToken,
-		AddToAccessToken: addToAccessToken,
+		AddToIdToken:          addToIdToken,
+		AddToAccessToken:      addToAccessToken,
+		AddToLightweightClaim: addToLightweightClaim,
 
 		IncludedClientAudience: protocolMapper.Config[includedClientAudienceField],
 		IncludedCustomAudience: protocolMapper.Config[includedCustomAudienceField],
@@ -2,7 +2,9 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"reflect"
 
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
@@ -56,8 +58,9 @@ type OpenidClient struct {
 	Attributes                         OpenidClientAttributes                   `json:"attributes"`
 	AuthorizationSettings              *OpenidClientAuthorizationSettings       `json:"authorizationSettings,omitempty"`
 	ConsentRequired                    bool                                     `json:"consentRequired"`
-	AuthenticationFlowBindingOverrides OpenidAuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides,omitempty"`
+	AuthenticationFlowBindingOverrides OpenidAuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides"`
 	AlwaysDisplayInConsole             bool                                     `json:"alwaysDisplayInConsole"`
+	RegistrationAccessToken            string                                   `json:"registrationAccessToken,omitempty"`
 }
 
 type OpenidClientAttributes struct {
@@ -83,8 +86,18 @@ type OpenidClientAttributes struct {
 	Oauth2DeviceCodeLifespan              string                           `json:"oauth2.device.code.lifespan,omitempty"`
 	Oauth2DevicePollingInterval           string                           `json:"oauth2.device.polling.interval,omitempty"`
 	PostLogoutRedirectUris                types.KeycloakSliceHashDelimited `json:"post.logout.redirect.uris,omitempty"`
+	UseLightweightAccessToken             types.KeycloakBoolQuoted         `json:"client.use.lightweight.access.token.enabled"`
+	IntrospectionResponseAllowJwtClaim    types.KeycloakBoolQuoted         `json:"client.introspection.response.allow.jwt.claim.enabled"`
+	CibaGrantEnabled                      types.KeycloakBoolQuoted         `json:"oidc.ciba.grant.enabled"`
+	CibaBackchannelTokenDeliveryMode      string                           `json:"ciba.backchannel.token.delivery.mode,omitempty"`
+	CibaBackchannelAuthRequestSigningAlg  string                           `json:"ciba.backchannel.auth.request.signing.alg,omitempty"`
+	CibaBackchannelClientNotificationUrl  string                           `json:"ciba.backchannel.client.notification.endpoint,omitempty"`
+	StandardTokenExchangeEnabled          *types.KeycloakBoolQuoted        `json:"standard.token.exchange.enabled,omitempty"`
+	StandardTokenExchangeRefresh          *string                          `json:"standard.token.exchange.enableRefreshRequestedTokenType,omitempty"`
 }
 
+// OpenidAuthenticationFlowBindingOverrides is always sent with both flows, as Keycloak only removes an override which is
+// set to an empty string. Overrides missing from an update are left as they are.
 type OpenidAuthenticationFlowBindingOverrides struct {
 	BrowserId     string `json:"browser"`
 	DirectGrantId string `json:"direct_grant"`
@@ -116,6 +129,22 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: service accounts (client credentials flow) cannot be enabled on public clients")
 	}
 
+	if client.Attributes.CibaBackchannelTokenDeliveryMode == "ping" && client.Attributes.CibaBackchannelClientNotificationUrl == "" {
+		return fmt.Errorf("validation error: ciba ping delivery mode requires a client notification endpoint")
+	}
+
+	if backchannelLogoutUrl := client.Attributes.BackchannelLogoutUrl; backchannelLogoutUrl != "" {
+		if parsedUrl, err := url.Parse(backchannelLogoutUrl); err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+			return fmt.Errorf("validation error: backchannel logout url \"%s\" must be an absolute http or https url", backchannelLogoutUrl)
+		}
+	} else if client.Attributes.BackchannelLogoutRevokeOfflineTokens {
+		return fmt.Errorf("validation error: revoking offline sessions on backchannel logout requires a backchannel logout url")
+	}
+
+	if client.Attributes.StandardTokenExchangeRefresh != nil && *client.Attributes.StandardTokenExchangeRefresh == "SAME_SESSION" && (client.Attributes.StandardTokenExchangeEnabled == nil || !*client.Attributes.StandardTokenExchangeEnabled) {
+		return fmt.Errorf("validation error: refresh tokens can only be requested through standard token exchange when standard token exchange is enabled")
+	}
+
 	serverInfo, err := keycloakClient.GetServerInfo(ctx)
 	if err != nil {
 		return err
@@ -230,6 +259,24 @@ func (keycloakClient *KeycloakClient) GetOpenidClientByClientId(ctx context.Cont
 	return &client, nil
 }
 
+// RegenerateOpenidClientRegistrationAccessToken invalidates the client's current registration access token and returns a new one.
+// Keycloak only keeps the id of the token, so the signed token is only available from this response.
+func (keycloakClient *KeycloakClient) RegenerateOpenidClientRegistrationAccessToken(ctx context.Context, realmId, id string) (string, error) {
+	var client OpenidClient
+
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/registration-access-token", realmId, id), nil)
+	if err != nil {
+		return "", err
+	}
+
+	err = json.Unmarshal(body, &client)
+	if err != nil {
+		return "", err
+	}
+
+	return client.RegistrationAccessToken, nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateOpenidClient(ctx context.Context, client *OpenidClient) error {
 	client.Protocol = "openid-connect"
 
@@ -0,0 +1,65 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type OpenidClientAuthorizationEvaluationScope struct {
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+type OpenidClientAuthorizationEvaluationResource struct {
+	Id     string                                     `json:"_id,omitempty"`
+	Name   string                                     `json:"name,omitempty"`
+	Scopes []OpenidClientAuthorizationEvaluationScope `json:"scopes,omitempty"`
+}
+
+type OpenidClientAuthorizationEvaluationRequest struct {
+	RealmId          string                                        `json:"-"`
+	ResourceServerId string                                        `json:"-"`
+	ClientId         string                                        `json:"clientId,omitempty"`
+	UserId           string                                        `json:"userId,omitempty"`
+	RoleIds          []string                                      `json:"roleIds,omitempty"`
+	Resources        []OpenidClientAuthorizationEvaluationResource `json:"resources,omitempty"`
+	Context          map[string]map[string]string                  `json:"context,omitempty"`
+	Entitlements     bool                                          `json:"entitlements"`
+}
+
+type OpenidClientAuthorizationEvaluationPolicy struct {
+	Name string `json:"name"`
+}
+
+type OpenidClientAuthorizationEvaluationPolicyResult struct {
+	Policy OpenidClientAuthorizationEvaluationPolicy `json:"policy"`
+	Status string                                    `json:"status"`
+}
+
+type OpenidClientAuthorizationEvaluationResult struct {
+	Resource      OpenidClientAuthorizationEvaluationResource       `json:"resource"`
+	Status        string                                            `json:"status"`
+	AllowedScopes []OpenidClientAuthorizationEvaluationScope        `json:"allowedScopes"`
+	Policies      []OpenidClientAuthorizationEvaluationPolicyResult `json:"policies"`
+}
+
+type OpenidClientAuthorizationEvaluationResponse struct {
+	Status  string                                      `json:"status"`
+	Results []OpenidClientAuthorizationEvaluationResult `json:"results"`
+}
+
+func (keycloakClient *KeycloakClient) EvaluateOpenidClientAuthorization(ctx context.Context, request *OpenidClientAuthorizationEvaluationRequest) (*OpenidClientAuthorizationEvaluationResponse, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/evaluate", request.RealmId, request.ResourceServerId), request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response OpenidClientAuthorizationEvaluationResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
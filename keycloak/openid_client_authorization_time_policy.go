@@ -14,18 +14,18 @@ type OpenidClientAuthorizationTimePolicy struct {
 	DecisionStrategy string `json:"decisionStrategy"`
 	Logic            string `json:"logic"`
 	Type             string `json:"type"`
-	NotBefore        string `json:"notBefore"`
-	NotOnOrAfter     string `json:"notOnOrAfter"`
-	DayMonth         string `json:"dayMonth"`
-	DayMonthEnd      string `json:"dayMonthEnd"`
-	Month            string `json:"month"`
-	MonthEnd         string `json:"monthEnd"`
-	Year             string `json:"year"`
-	YearEnd          string `json:"yearEnd"`
-	Hour             string `json:"hour"`
-	HourEnd          string `json:"hourEnd"`
-	Minute           string `json:"minute"`
-	MinuteEnd        string `json:"minuteEnd"`
+	NotBefore        string `json:"notBefore,omitempty"`
+	NotOnOrAfter     string `json:"notOnOrAfter,omitempty"`
+	DayMonth         string `json:"dayMonth,omitempty"`
+	DayMonthEnd      string `json:"dayMonthEnd,omitempty"`
+	Month            string `json:"month,omitempty"`
+	MonthEnd         string `json:"monthEnd,omitempty"`
+	Year             string `json:"year,omitempty"`
+	YearEnd          string `json:"yearEnd,omitempty"`
+	Hour             string `json:"hour,omitempty"`
+	HourEnd          string `json:"hourEnd,omitempty"`
+	Minute           string `json:"minute,omitempty"`
+	MinuteEnd        string `json:"minuteEnd,omitempty"`
 	Description      string `json:"description"`
 }
 
@@ -0,0 +1,163 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type OpenIdOrganizationMembershipProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToUserinfo           bool
+	AddToTokenIntrospection bool
+	AddToLightweightClaim   bool
+
+	ClaimName                 string
+	AddOrganizationId         bool
+	AddOrganizationAttributes bool
+}
+
+func (mapper *OpenIdOrganizationMembershipProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "openid-connect",
+		ProtocolMapper: "oidc-organization-membership-mapper",
+		Config: map[string]string{
+			addToIdTokenField:              strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:          strconv.FormatBool(mapper.AddToAccessToken),
+			addToUserInfoField:             strconv.FormatBool(mapper.AddToUserinfo),
+			addToTokenIntrospectionField:   strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addToLightweightClaimField:     strconv.FormatBool(mapper.AddToLightweightClaim),
+			claimNameField:                 mapper.ClaimName,
+			addOrganizationIdField:         strconv.FormatBool(mapper.AddOrganizationId),
+			addOrganizationAttributesField: strconv.FormatBool(mapper.AddOrganizationAttributes),
+			// a user can be a member of several organizations
+			multivaluedField:    "true",
+			claimValueTypeField: "String",
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToOpenIdOrganizationMembershipProtocolMapper(realmId, clientId, clientScopeId string) (*OpenIdOrganizationMembershipProtocolMapper, error) {
+	idTokenClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToIdTokenField])
+	if err != nil {
+		return nil, err
+	}
+
+	accessTokenClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToAccessTokenField])
+	if err != nil {
+		return nil, err
+	}
+
+	userinfoTokenClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIntrospectionClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToTokenIntrospectionField])
+	if err != nil {
+		return nil, err
+	}
+
+	lightweightClaim, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToLightweightClaimField])
+	if err != nil {
+		return nil, err
+	}
+
+	addOrganizationId, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addOrganizationIdField])
+	if err != nil {
+		return nil, err
+	}
+
+	addOrganizationAttributes, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addOrganizationAttributesField])
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenIdOrganizationMembershipProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		AddToIdToken:            idTokenClaim,
+		AddToAccessToken:        accessTokenClaim,
+		AddToUserinfo:           userinfoTokenClaim,
+		AddToTokenIntrospection: tokenIntrospectionClaim,
+		AddToLightweightClaim:   lightweightClaim,
+
+		ClaimName:                 protocolMapper.Config[claimNameField],
+		AddOrganizationId:         addOrganizationId,
+		AddOrganizationAttributes: addOrganizationAttributes,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) GetOpenIdOrganizationMembershipProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*OpenIdOrganizationMembershipProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToOpenIdOrganizationMembershipProtocolMapper(realmId, clientId, clientScopeId)
+}
+
+func (keycloakClient *KeycloakClient) DeleteOpenIdOrganizationMembershipProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewOpenIdOrganizationMembershipProtocolMapper(ctx context.Context, mapper *OpenIdOrganizationMembershipProtocolMapper) error {
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper())
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOpenIdOrganizationMembershipProtocolMapper(ctx context.Context, mapper *OpenIdOrganizationMembershipProtocolMapper) error {
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper())
+}
+
+func (keycloakClient *KeycloakClient) ValidateOpenIdOrganizationMembershipProtocolMapper(ctx context.Context, mapper *OpenIdOrganizationMembershipProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_25)
+	if err != nil {
+		return err
+	}
+	if !versionOk {
+		return fmt.Errorf("validation error: the organization membership protocol mapper requires Keycloak 25 or later")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	return nil
+}
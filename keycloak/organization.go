@@ -0,0 +1,143 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type OrganizationDomain struct {
+	Name     string `json:"name"`
+	Verified bool   `json:"verified"`
+}
+
+type Organization struct {
+	Id          string               `json:"id,omitempty"`
+	RealmId     string               `json:"-"`
+	Name        string               `json:"name"`
+	Alias       string               `json:"alias,omitempty"`       // since keycloak v26
+	RedirectUrl string               `json:"redirectUrl,omitempty"` // since keycloak v26
+	Enabled     bool                 `json:"enabled"`
+	Description string               `json:"description"`
+	Domains     []OrganizationDomain `json:"domains"`
+	Attributes  map[string][]string  `json:"attributes"`
+}
+
+// ValidateOrganization checks that organizations can be managed in the realm of the organization. Keycloak answers
+// requests for organizations with a 404 when they aren't enabled, which would otherwise be reported as a missing organization.
+func (keycloakClient *KeycloakClient) ValidateOrganization(ctx context.Context, organization *Organization) error {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_25)
+	if err != nil {
+		return err
+	}
+	if !versionOk {
+		return fmt.Errorf("validation error: organizations require Keycloak 25 or later")
+	}
+
+	if organization.Alias != "" || organization.RedirectUrl != "" {
+		versionOk, err = keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_26)
+		if err != nil {
+			return err
+		}
+		if !versionOk {
+			return fmt.Errorf("validation error: the alias and redirect_url of organizations require Keycloak 26 or later")
+		}
+	}
+
+	domains := make(map[string]bool, len(organization.Domains))
+	for _, domain := range organization.Domains {
+		name := strings.ToLower(domain.Name)
+		if domains[name] {
+			return fmt.Errorf("validation error: domain %s is specified more than once", domain.Name)
+		}
+		domains[name] = true
+	}
+
+	realm, err := keycloakClient.GetRealm(ctx, organization.RealmId)
+	if err != nil {
+		return err
+	}
+	if realm.OrganizationsEnabled == nil || !*realm.OrganizationsEnabled {
+		return fmt.Errorf("validation error: organizations are not enabled in realm %s, they can be enabled with organizations_enabled", organization.RealmId)
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) NewOrganization(ctx context.Context, organization *Organization) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/organizations", organization.RealmId), organization)
+	if err != nil {
+		if ErrorIs409(err) {
+			return keycloakClient.organizationDomainConflictError(ctx, organization, err)
+		}
+		return err
+	}
+
+	organization.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetOrganization(ctx context.Context, realmId, id string) (*Organization, error) {
+	var organization Organization
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations/%s", realmId, id), &organization, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	organization.RealmId = realmId
+
+	// keycloak doesn't return the domains in a stable order
+	sort.Slice(organization.Domains, func(i, j int) bool {
+		return organization.Domains[i].Name < organization.Domains[j].Name
+	})
+
+	return &organization, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOrganization(ctx context.Context, organization *Organization) error {
+	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/organizations/%s", organization.RealmId, organization.Id), organization)
+	if err != nil && ErrorIs409(err) {
+		return keycloakClient.organizationDomainConflictError(ctx, organization, err)
+	}
+
+	return err
+}
+
+// organizationDomainConflictError explains which organization already claims one of the domains of the organization, as
+// Keycloak responds with 409 both when a domain and when the name of an organization is already taken.
+func (keycloakClient *KeycloakClient) organizationDomainConflictError(ctx context.Context, organization *Organization, err error) error {
+	for _, domain := range organization.Domains {
+		var organizations []*Organization
+
+		params := map[string]string{
+			"search":              domain.Name,
+			"exact":               "true",
+			"briefRepresentation": "false",
+		}
+
+		if keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations", organization.RealmId), &organizations, params) != nil {
+			continue
+		}
+
+		for _, other := range organizations {
+			if other.Id == organization.Id {
+				continue
+			}
+
+			for _, otherDomain := range other.Domains {
+				if strings.EqualFold(otherDomain.Name, domain.Name) {
+					return fmt.Errorf("domain %s can't be added to organization %s, it is already claimed by organization %s", domain.Name, organization.Name, other.Name)
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteOrganization(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/organizations/%s", realmId, id), nil)
+}
@@ -0,0 +1,65 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+func (keycloakClient *KeycloakClient) AddOrganizationMember(ctx context.Context, realmId, organizationId, userId string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members", realmId, organizationId), userId)
+
+	return err
+}
+
+// GetOrganizationMember returns a 404 when the user isn't a member of the organization.
+func (keycloakClient *KeycloakClient) GetOrganizationMember(ctx context.Context, realmId, organizationId, userId string) (*User, error) {
+	var member User
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members/%s", realmId, organizationId, userId), &member, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	member.RealmId = realmId
+
+	return &member, nil
+}
+
+// RemoveOrganizationMember doesn't fail if the user was already removed from the organization.
+func (keycloakClient *KeycloakClient) RemoveOrganizationMember(ctx context.Context, realmId, organizationId, userId string) error {
+	err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members/%s", realmId, organizationId, userId), nil)
+	if err != nil && !ErrorIs404(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) AddOrganizationIdentityProvider(ctx context.Context, realmId, organizationId, alias string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/organizations/%s/identity-providers", realmId, organizationId), alias)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) GetOrganizationIdentityProvider(ctx context.Context, realmId, organizationId, alias string) (*IdentityProvider, error) {
+	var identityProvider IdentityProvider
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations/%s/identity-providers/%s", realmId, organizationId, alias), &identityProvider, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	identityProvider.Realm = realmId
+
+	return &identityProvider, nil
+}
+
+// RemoveOrganizationIdentityProvider unlinks the identity provider from the organization, the identity provider itself is kept.
+func (keycloakClient *KeycloakClient) RemoveOrganizationIdentityProvider(ctx context.Context, realmId, organizationId, alias string) error {
+	err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/organizations/%s/identity-providers/%s", realmId, organizationId, alias), nil)
+	if err != nil && !ErrorIs404(err) {
+		return err
+	}
+
+	return nil
+}
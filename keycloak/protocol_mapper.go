@@ -17,6 +17,8 @@ type protocolMapper struct {
 var (
 	addToAccessTokenField                = "access.token.claim"
 	addToIdTokenField                    = "id.token.claim"
+	addToLightweightClaimField           = "lightweight.claim"
+	addToTokenIntrospectionField         = "introspection.token.claim"
 	addToUserInfoField                   = "userinfo.token.claim"
 	attributeNameField                   = "attribute.name"
 	attributeNameFormatField             = "attribute.nameformat"
@@ -38,6 +40,8 @@ var (
 	userClientRoleMappingRolePrefixField = "usermodel.clientRoleMapping.rolePrefix"
 	userSessionNoteField                 = "user.session.note"
 	aggregateAttributeValuesField        = "aggregate.attrs"
+	addOrganizationIdField               = "addOrganizationId"
+	addOrganizationAttributesField       = "addOrganizationAttributes"
 )
 
 func protocolMapperPath(realmId, clientId, clientScopeId string) string {
@@ -2,8 +2,10 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+	"sort"
 	"strings"
 )
 
@@ -16,6 +18,7 @@ type Key struct {
 	Kid              *string `json:"kid,omitempty"`
 	Status           *string `json:"status,omitempty"`
 	Type             *string `json:"type,omitempty"`
+	Use              *string `json:"use,omitempty"`
 }
 
 type Keys struct {
@@ -23,13 +26,17 @@ type Keys struct {
 }
 
 type Realm struct {
-	Id                   string `json:"id,omitempty"`
-	Realm                string `json:"realm"`
-	Enabled              bool   `json:"enabled"`
-	DisplayName          string `json:"displayName"`
-	DisplayNameHtml      string `json:"displayNameHtml"`
-	UserManagedAccess    bool   `json:"userManagedAccessAllowed"`
-	OrganizationsEnabled bool   `json:"organizationsEnabled,omitempty"`
+	Id                string `json:"id,omitempty"`
+	Realm             string `json:"realm"`
+	Enabled           bool   `json:"enabled"`
+	DisplayName       string `json:"displayName"`
+	DisplayNameHtml   string `json:"displayNameHtml"`
+	UserManagedAccess bool   `json:"userManagedAccessAllowed"`
+
+	// organizations, verifiable credentials and admin permissions are only sent to versions of Keycloak that know about them
+	OrganizationsEnabled         *bool `json:"organizationsEnabled,omitempty"`
+	VerifiableCredentialsEnabled *bool `json:"verifiableCredentialsEnabled,omitempty"`
+	AdminPermissionsEnabled      *bool `json:"adminPermissionsEnabled,omitempty"`
 
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
@@ -52,27 +59,28 @@ type Realm struct {
 	EmailTheme   string `json:"emailTheme,omitempty"`
 
 	// Tokens
-	DefaultSignatureAlgorithm           string `json:"defaultSignatureAlgorithm"`
-	RevokeRefreshToken                  bool   `json:"revokeRefreshToken"`
-	RefreshTokenMaxReuse                int    `json:"refreshTokenMaxReuse"`
-	SsoSessionIdleTimeout               int    `json:"ssoSessionIdleTimeout,omitempty"`
-	SsoSessionMaxLifespan               int    `json:"ssoSessionMaxLifespan,omitempty"`
-	SsoSessionIdleTimeoutRememberMe     int    `json:"ssoSessionIdleTimeoutRememberMe,omitempty"`
-	SsoSessionMaxLifespanRememberMe     int    `json:"ssoSessionMaxLifespanRememberMe,omitempty"`
-	OfflineSessionIdleTimeout           int    `json:"offlineSessionIdleTimeout,omitempty"`
-	OfflineSessionMaxLifespan           int    `json:"offlineSessionMaxLifespan,omitempty"`
-	OfflineSessionMaxLifespanEnabled    bool   `json:"offlineSessionMaxLifespanEnabled,omitempty"`
-	ClientSessionIdleTimeout            int    `json:"clientSessionIdleTimeout,omitempty"`
-	ClientSessionMaxLifespan            int    `json:"clientSessionMaxLifespan,omitempty"`
-	AccessTokenLifespan                 int    `json:"accessTokenLifespan,omitempty"`
-	AccessTokenLifespanForImplicitFlow  int    `json:"accessTokenLifespanForImplicitFlow,omitempty"`
-	AccessCodeLifespan                  int    `json:"accessCodeLifespan,omitempty"`
-	AccessCodeLifespanLogin             int    `json:"accessCodeLifespanLogin,omitempty"`
-	AccessCodeLifespanUserAction        int    `json:"accessCodeLifespanUserAction,omitempty"`
-	ActionTokenGeneratedByUserLifespan  int    `json:"actionTokenGeneratedByUserLifespan,omitempty"`
-	ActionTokenGeneratedByAdminLifespan int    `json:"actionTokenGeneratedByAdminLifespan,omitempty"`
-	Oauth2DeviceCodeLifespan            int    `json:"oauth2DeviceCodeLifespan,omitempty"`
-	Oauth2DevicePollingInterval         int    `json:"oauth2DevicePollingInterval,omitempty"`
+	// the refresh token and signature settings are left out when unset, so updates don't change them
+	DefaultSignatureAlgorithm           *string `json:"defaultSignatureAlgorithm,omitempty"`
+	RevokeRefreshToken                  *bool   `json:"revokeRefreshToken,omitempty"`
+	RefreshTokenMaxReuse                *int    `json:"refreshTokenMaxReuse,omitempty"`
+	SsoSessionIdleTimeout               int     `json:"ssoSessionIdleTimeout,omitempty"`
+	SsoSessionMaxLifespan               int     `json:"ssoSessionMaxLifespan,omitempty"`
+	SsoSessionIdleTimeoutRememberMe     int     `json:"ssoSessionIdleTimeoutRememberMe,omitempty"`
+	SsoSessionMaxLifespanRememberMe     int     `json:"ssoSessionMaxLifespanRememberMe,omitempty"`
+	OfflineSessionIdleTimeout           int     `json:"offlineSessionIdleTimeout,omitempty"`
+	OfflineSessionMaxLifespan           int     `json:"offlineSessionMaxLifespan,omitempty"`
+	OfflineSessionMaxLifespanEnabled    *bool   `json:"offlineSessionMaxLifespanEnabled,omitempty"`
+	ClientSessionIdleTimeout            int     `json:"clientSessionIdleTimeout,omitempty"`
+	ClientSessionMaxLifespan            int     `json:"clientSessionMaxLifespan,omitempty"`
+	AccessTokenLifespan                 int     `json:"accessTokenLifespan,omitempty"`
+	AccessTokenLifespanForImplicitFlow  int     `json:"accessTokenLifespanForImplicitFlow,omitempty"`
+	AccessCodeLifespan                  int     `json:"accessCodeLifespan,omitempty"`
+	AccessCodeLifespanLogin             int     `json:"accessCodeLifespanLogin,omitempty"`
+	AccessCodeLifespanUserAction        int     `json:"accessCodeLifespanUserAction,omitempty"`
+	ActionTokenGeneratedByUserLifespan  int     `json:"actionTokenGeneratedByUserLifespan,omitempty"`
+	ActionTokenGeneratedByAdminLifespan int     `json:"actionTokenGeneratedByAdminLifespan,omitempty"`
+	Oauth2DeviceCodeLifespan            int     `json:"oauth2DeviceCodeLifespan,omitempty"`
+	Oauth2DevicePollingInterval         int     `json:"oauth2DevicePollingInterval,omitempty"`
 
 	//internationalization
 	InternationalizationEnabled bool     `json:"internationalizationEnabled"`
@@ -88,14 +96,16 @@ type Realm struct {
 
 	BrowserSecurityHeaders BrowserSecurityHeaders `json:"browserSecurityHeaders"`
 
-	BruteForceProtected          bool `json:"bruteForceProtected"`
-	PermanentLockout             bool `json:"permanentLockout"`
-	FailureFactor                int  `json:"failureFactor"` //Max Login Failures
-	WaitIncrementSeconds         int  `json:"waitIncrementSeconds"`
-	QuickLoginCheckMilliSeconds  int  `json:"quickLoginCheckMilliSeconds"`
-	MinimumQuickLoginWaitSeconds int  `json:"minimumQuickLoginWaitSeconds"`
-	MaxFailureWaitSeconds        int  `json:"maxFailureWaitSeconds"` //Max Wait
-	MaxDeltaTimeSeconds          int  `json:"maxDeltaTimeSeconds"`   //Failure Reset Time
+	BruteForceProtected          bool   `json:"bruteForceProtected"`
+	PermanentLockout             bool   `json:"permanentLockout"`
+	FailureFactor                int    `json:"failureFactor"` //Max Login Failures
+	WaitIncrementSeconds         int    `json:"waitIncrementSeconds"`
+	QuickLoginCheckMilliSeconds  int    `json:"quickLoginCheckMilliSeconds"`
+	MinimumQuickLoginWaitSeconds int    `json:"minimumQuickLoginWaitSeconds"`
+	MaxFailureWaitSeconds        int    `json:"maxFailureWaitSeconds"`          //Max Wait
+	MaxDeltaTimeSeconds          int    `json:"maxDeltaTimeSeconds"`            //Failure Reset Time
+	MaxTemporaryLockouts         *int   `json:"maxTemporaryLockouts,omitempty"` // since keycloak v24
+	BruteForceStrategy           string `json:"bruteForceStrategy,omitempty"`   // since keycloak v26, MULTIPLE or LINEAR
 
 	PasswordPolicy string `json:"passwordPolicy"`
 
@@ -168,6 +178,12 @@ type SmtpServer struct {
 	Ssl                types.KeycloakBoolQuoted `json:"ssl,omitempty"`
 	User               string                   `json:"user,omitempty"`
 	Password           string                   `json:"password,omitempty"`
+	// since keycloak v26.1
+	AuthType              string `json:"authType,omitempty"`
+	AuthTokenUrl          string `json:"authTokenUrl,omitempty"`
+	AuthTokenScope        string `json:"authTokenScope,omitempty"`
+	AuthTokenClientId     string `json:"authTokenClientId,omitempty"`
+	AuthTokenClientSecret string `json:"authTokenClientSecret,omitempty"`
 }
 
 func (keycloakClient *KeycloakClient) NewRealm(ctx context.Context, realm *Realm) error {
@@ -186,6 +202,36 @@ func (keycloakClient *KeycloakClient) GetRealm(ctx context.Context, name string)
 	return &realm, nil
 }
 
+// TestSmtpConnection sends a test email using the given SMTP configuration to the email address of the user the provider is authenticated as.
+// A password of "**********" makes Keycloak use the password stored for the realm.
+func (keycloakClient *KeycloakClient) TestSmtpConnection(ctx context.Context, realmId string, config map[string]string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/testSMTPConnection", realmId), config)
+
+	return err
+}
+
+// GetRealmSmtpConfig returns the SMTP configuration stored for the realm, in the format expected by TestSmtpConnection.
+func (keycloakClient *KeycloakClient) GetRealmSmtpConfig(ctx context.Context, realmId string) (map[string]string, error) {
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]string)
+
+	smtpServer, err := json.Marshal(realm.SmtpServer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(smtpServer, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
 func (keycloakClient *KeycloakClient) GetRealms(ctx context.Context) ([]*Realm, error) {
 	var realms []*Realm
 
@@ -208,6 +254,29 @@ func (keycloakClient *KeycloakClient) GetRealmKeys(ctx context.Context, name str
 	return &keys, nil
 }
 
+// ValidateRealmKeystoreKey checks that the keystore with the given id provides a key with the given use, as Keycloak
+// accepts keystores it can't generate a key for, such as keystores with an algorithm its version doesn't support.
+func (keycloakClient *KeycloakClient) ValidateRealmKeystoreKey(ctx context.Context, realmId, keystoreId, use string) error {
+	keys, err := keycloakClient.GetRealmKeys(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys.Keys {
+		if key.ProviderId == nil || *key.ProviderId != keystoreId {
+			continue
+		}
+
+		if key.Use != nil && !strings.EqualFold(*key.Use, use) {
+			return fmt.Errorf("validation error: keystore %s provides a key for %s rather than %s", keystoreId, strings.ToLower(*key.Use), use)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("validation error: keystore %s doesn't provide any key in realm %s, the algorithm may not be supported by this version of Keycloak", keystoreId, realmId)
+}
+
 func (keycloakClient *KeycloakClient) UpdateRealm(ctx context.Context, realm *Realm) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s", realm.Realm), realm)
 }
@@ -262,11 +331,9 @@ func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *
 	}
 
 	if realm.PasswordPolicy != "" {
-		policies := strings.Split(realm.PasswordPolicy, " and ")
-		for _, policyTypeRepresentation := range policies {
-			policy := strings.Split(policyTypeRepresentation, "(")
-			if !serverInfo.providerInstalled("password-policy", policy[0]) {
-				return fmt.Errorf("validation error: password-policy \"%s\" does not exist on the server, installed providers: %s", policy[0], serverInfo.getInstalledProvidersNames("password-policy"))
+		for _, policy := range parsePasswordPolicy(realm.PasswordPolicy) {
+			if !serverInfo.providerInstalled("password-policy", policy.id) {
+				return fmt.Errorf("validation error: password-policy \"%s\" does not exist on the server, installed providers: %s", policy.id, serverInfo.getInstalledProvidersNames("password-policy"))
 			}
 		}
 	}
@@ -274,6 +341,60 @@ func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *
 	return nil
 }
 
+type passwordPolicyEntry struct {
+	id    string
+	value string
+}
+
+// parsePasswordPolicy splits a password policy string such as "length(8) and notUsername" into its individual policies.
+// Keycloak serializes policies without a value as "notUsername(undefined)", so both forms are treated the same.
+func parsePasswordPolicy(passwordPolicy string) []passwordPolicyEntry {
+	var policies []passwordPolicyEntry
+
+	for _, policyRepresentation := range strings.Split(passwordPolicy, " and ") {
+		policyRepresentation = strings.TrimSpace(policyRepresentation)
+		if policyRepresentation == "" {
+			continue
+		}
+
+		policy := passwordPolicyEntry{id: policyRepresentation}
+		if i := strings.Index(policyRepresentation, "("); i != -1 && strings.HasSuffix(policyRepresentation, ")") {
+			policy.id = strings.TrimSpace(policyRepresentation[:i])
+			policy.value = strings.TrimSpace(policyRepresentation[i+1 : len(policyRepresentation)-1])
+		}
+
+		if policy.value == "undefined" {
+			policy.value = ""
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+// NormalizePasswordPolicy returns a canonical representation of a password policy string, with policies sorted by
+// their id and consistent formatting, so that semantically equivalent policies can be compared.
+// Ex: "upperCase(1) and length( 8 ) and notUsername(undefined)" => "length(8) and notUsername and upperCase(1)"
+func NormalizePasswordPolicy(passwordPolicy string) string {
+	policies := parsePasswordPolicy(passwordPolicy)
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i].id < policies[j].id
+	})
+
+	policyRepresentations := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		if policy.value == "" {
+			policyRepresentations = append(policyRepresentations, policy.id)
+		} else {
+			policyRepresentations = append(policyRepresentations, fmt.Sprintf("%s(%s)", policy.id, policy.value))
+		}
+	}
+
+	return strings.Join(policyRepresentations, " and ")
+}
+
 func contains(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -0,0 +1,101 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const realmAllowedClientScopesPolicyProviderId = "allowed-client-templates"
+
+type RealmAllowedClientScopesPolicy struct {
+	Id      string
+	Name    string
+	RealmId string
+	SubType string
+
+	AllowedClientScopes []string
+	AllowDefaultScopes  bool
+}
+
+func convertFromRealmAllowedClientScopesPolicyToComponent(policy *RealmAllowedClientScopesPolicy) *component {
+	componentConfig := map[string][]string{
+		"allowed-client-scopes": policy.AllowedClientScopes,
+		"allow-default-scopes": {
+			strconv.FormatBool(policy.AllowDefaultScopes),
+		},
+	}
+
+	return &component{
+		Id:           policy.Id,
+		Name:         policy.Name,
+		ParentId:     policy.RealmId,
+		ProviderId:   realmAllowedClientScopesPolicyProviderId,
+		ProviderType: clientRegistrationPolicyProviderType,
+		SubType:      policy.SubType,
+		Config:       componentConfig,
+	}
+}
+
+func convertFromComponentToRealmAllowedClientScopesPolicy(component *component, realmId string) (*RealmAllowedClientScopesPolicy, error) {
+	allowDefaultScopes, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("allow-default-scopes"))
+	if err != nil {
+		return nil, err
+	}
+
+	allowedClientScopes := component.Config["allowed-client-scopes"]
+	if allowedClientScopes == nil {
+		allowedClientScopes = make([]string, 0)
+	}
+
+	return &RealmAllowedClientScopesPolicy{
+		Id:      component.Id,
+		Name:    component.Name,
+		RealmId: realmId,
+		SubType: component.SubType,
+
+		AllowedClientScopes: allowedClientScopes,
+		AllowDefaultScopes:  allowDefaultScopes,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmAllowedClientScopesPolicy(ctx context.Context, policy *RealmAllowedClientScopesPolicy) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", policy.RealmId), convertFromRealmAllowedClientScopesPolicyToComponent(policy))
+	if err != nil {
+		return err
+	}
+
+	policy.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+// GetRealmAllowedClientScopesPolicy returns the allowed client scopes policy with the given id. Other realm components, like
+// keystores or other client registration policies, can't be read as an allowed client scopes policy, they're reported as
+// missing instead.
+func (keycloakClient *KeycloakClient) GetRealmAllowedClientScopesPolicy(ctx context.Context, realmId, id string) (*RealmAllowedClientScopesPolicy, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if component.ProviderType != clientRegistrationPolicyProviderType || component.ProviderId != realmAllowedClientScopesPolicyProviderId {
+		return nil, &ApiError{
+			Code:    http.StatusNotFound,
+			Message: fmt.Sprintf("component %s of realm %s is not an allowed client scopes policy", id, realmId),
+		}
+	}
+
+	return convertFromComponentToRealmAllowedClientScopesPolicy(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateRealmAllowedClientScopesPolicy(ctx context.Context, policy *RealmAllowedClientScopesPolicy) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", policy.RealmId, policy.Id), convertFromRealmAllowedClientScopesPolicyToComponent(policy))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmAllowedClientScopesPolicy(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
+}
@@ -0,0 +1,71 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newRealmAllowedClientScopesPolicyTestClient(t *testing.T) *KeycloakClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/realms/test/components/policy-id":
+			json.NewEncoder(w).Encode(&component{
+				Id:           "policy-id",
+				Name:         "Allowed Client Scopes",
+				ProviderId:   realmAllowedClientScopesPolicyProviderId,
+				ProviderType: clientRegistrationPolicyProviderType,
+				SubType:      "anonymous",
+				Config: map[string][]string{
+					"allowed-client-scopes": {"profile", "email"},
+					"allow-default-scopes":  {"true"},
+				},
+			})
+		case "/admin/realms/test/components/trusted-hosts-id":
+			json.NewEncoder(w).Encode(&component{
+				Id:           "trusted-hosts-id",
+				ProviderId:   "trusted-hosts",
+				ProviderType: clientRegistrationPolicyProviderType,
+				SubType:      "anonymous",
+			})
+		case "/admin/realms/test/components/keystore-id":
+			json.NewEncoder(w).Encode(&component{
+				Id:           "keystore-id",
+				ProviderId:   realmAllowedClientScopesPolicyProviderId,
+				ProviderType: "org.keycloak.keys.KeyProvider",
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return newTestKeycloakClient(server)
+}
+
+func TestGetRealmAllowedClientScopesPolicy(t *testing.T) {
+	keycloakClient := newRealmAllowedClientScopesPolicyTestClient(t)
+
+	policy, err := keycloakClient.GetRealmAllowedClientScopesPolicy(context.Background(), "test", "policy-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if policy.SubType != "anonymous" || len(policy.AllowedClientScopes) != 2 || !policy.AllowDefaultScopes {
+		t.Fatalf("expected the anonymous policy with two allowed client scopes, got %+v", policy)
+	}
+}
+
+func TestGetRealmAllowedClientScopesPolicy_otherComponent(t *testing.T) {
+	keycloakClient := newRealmAllowedClientScopesPolicyTestClient(t)
+
+	for _, id := range []string{"trusted-hosts-id", "keystore-id"} {
+		_, err := keycloakClient.GetRealmAllowedClientScopesPolicy(context.Background(), "test", id)
+		if !ErrorIs404(err) {
+			t.Fatalf("expected component %s to be reported as a missing allowed client scopes policy, got %v", id, err)
+		}
+	}
+}
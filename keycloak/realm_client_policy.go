@@ -0,0 +1,135 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+type ClientPolicyCondition struct {
+	Condition     string                 `json:"condition"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type ClientPolicy struct {
+	RealmId     string                  `json:"-"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	Enabled     bool                    `json:"enabled"`
+	Conditions  []ClientPolicyCondition `json:"conditions"`
+	Profiles    []string                `json:"profiles"`
+}
+
+type clientPolicies struct {
+	Policies       []*ClientPolicy `json:"policies"`
+	GlobalPolicies []*ClientPolicy `json:"globalPolicies,omitempty"`
+}
+
+// Keycloak only allows replacing all of a realm's client policies at once, so concurrent
+// changes to different policies within the same provider have to be serialized.
+var clientPoliciesMutex sync.Mutex
+
+// Like the global profiles, the global policies are built into Keycloak and can't be changed or deleted.
+func (keycloakClient *KeycloakClient) getClientPolicies(ctx context.Context, realmId string, includeGlobalPolicies bool) (*clientPolicies, error) {
+	var policies clientPolicies
+
+	params := map[string]string{
+		"include-global-policies": strconv.FormatBool(includeGlobalPolicies),
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-policies/policies", realmId), &policies, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policies, nil
+}
+
+func (keycloakClient *KeycloakClient) updateClientPolicies(ctx context.Context, realmId string, update func(policies []*ClientPolicy) ([]*ClientPolicy, error)) error {
+	clientPoliciesMutex.Lock()
+	defer clientPoliciesMutex.Unlock()
+
+	policies, err := keycloakClient.getClientPolicies(ctx, realmId, false)
+	if err != nil {
+		return err
+	}
+
+	policies.Policies, err = update(policies.Policies)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/client-policies/policies", realmId), policies)
+}
+
+func (keycloakClient *KeycloakClient) GetClientPolicy(ctx context.Context, realmId, name string) (*ClientPolicy, error) {
+	policies, err := keycloakClient.getClientPolicies(ctx, realmId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies.Policies {
+		if policy.Name == name {
+			policy.RealmId = realmId
+
+			return policy, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("client policy %s does not exist in realm %s", name, realmId),
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewClientPolicy(ctx context.Context, policy *ClientPolicy) error {
+	policies, err := keycloakClient.getClientPolicies(ctx, policy.RealmId, true)
+	if err != nil {
+		return err
+	}
+
+	for _, globalPolicy := range policies.GlobalPolicies {
+		if globalPolicy.Name == policy.Name {
+			return fmt.Errorf("client policy %s is a global policy of Keycloak, which can't be managed", policy.Name)
+		}
+	}
+
+	return keycloakClient.updateClientPolicies(ctx, policy.RealmId, func(policies []*ClientPolicy) ([]*ClientPolicy, error) {
+		for _, existingPolicy := range policies {
+			if existingPolicy.Name == policy.Name {
+				return nil, fmt.Errorf("client policy %s already exists in realm %s", policy.Name, policy.RealmId)
+			}
+		}
+
+		return append(policies, policy), nil
+	})
+}
+
+func (keycloakClient *KeycloakClient) UpdateClientPolicy(ctx context.Context, policy *ClientPolicy) error {
+	return keycloakClient.updateClientPolicies(ctx, policy.RealmId, func(policies []*ClientPolicy) ([]*ClientPolicy, error) {
+		for i, existingPolicy := range policies {
+			if existingPolicy.Name == policy.Name {
+				policies[i] = policy
+
+				return policies, nil
+			}
+		}
+
+		return append(policies, policy), nil
+	})
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientPolicy(ctx context.Context, realmId, name string) error {
+	return keycloakClient.updateClientPolicies(ctx, realmId, func(policies []*ClientPolicy) ([]*ClientPolicy, error) {
+		remainingPolicies := make([]*ClientPolicy, 0, len(policies))
+		for _, policy := range policies {
+			if policy.Name != name {
+				remainingPolicies = append(remainingPolicies, policy)
+			}
+		}
+
+		return remainingPolicies, nil
+	})
+}
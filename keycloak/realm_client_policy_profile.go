@@ -0,0 +1,134 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+type ClientPolicyProfileExecutor struct {
+	Executor      string                 `json:"executor"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type ClientPolicyProfile struct {
+	RealmId     string                        `json:"-"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Executors   []ClientPolicyProfileExecutor `json:"executors"`
+}
+
+type clientPolicyProfiles struct {
+	Profiles       []*ClientPolicyProfile `json:"profiles"`
+	GlobalProfiles []*ClientPolicyProfile `json:"globalProfiles,omitempty"`
+}
+
+// Keycloak only allows replacing all of a realm's client profiles at once, so concurrent
+// changes to different profiles within the same provider have to be serialized.
+var clientPolicyProfilesMutex sync.Mutex
+
+// The global profiles are built into Keycloak and can be used by the client policies of every realm, but they can't be
+// changed or deleted. They are only returned when includeGlobalProfiles is true, and must never be sent back to Keycloak.
+func (keycloakClient *KeycloakClient) getClientPolicyProfiles(ctx context.Context, realmId string, includeGlobalProfiles bool) (*clientPolicyProfiles, error) {
+	var profiles clientPolicyProfiles
+
+	params := map[string]string{
+		"include-global-profiles": strconv.FormatBool(includeGlobalProfiles),
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-policies/profiles", realmId), &profiles, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profiles, nil
+}
+
+func (keycloakClient *KeycloakClient) updateClientPolicyProfiles(ctx context.Context, realmId string, update func(profiles []*ClientPolicyProfile) ([]*ClientPolicyProfile, error)) error {
+	clientPolicyProfilesMutex.Lock()
+	defer clientPolicyProfilesMutex.Unlock()
+
+	profiles, err := keycloakClient.getClientPolicyProfiles(ctx, realmId, false)
+	if err != nil {
+		return err
+	}
+
+	profiles.Profiles, err = update(profiles.Profiles)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/client-policies/profiles", realmId), profiles)
+}
+
+func (keycloakClient *KeycloakClient) GetClientPolicyProfile(ctx context.Context, realmId, name string) (*ClientPolicyProfile, error) {
+	profiles, err := keycloakClient.getClientPolicyProfiles(ctx, realmId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles.Profiles {
+		if profile.Name == name {
+			profile.RealmId = realmId
+
+			return profile, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("client policy profile %s does not exist in realm %s", name, realmId),
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewClientPolicyProfile(ctx context.Context, profile *ClientPolicyProfile) error {
+	profiles, err := keycloakClient.getClientPolicyProfiles(ctx, profile.RealmId, true)
+	if err != nil {
+		return err
+	}
+
+	for _, globalProfile := range profiles.GlobalProfiles {
+		if globalProfile.Name == profile.Name {
+			return fmt.Errorf("client policy profile %s is a global profile of Keycloak, which can't be managed", profile.Name)
+		}
+	}
+
+	return keycloakClient.updateClientPolicyProfiles(ctx, profile.RealmId, func(profiles []*ClientPolicyProfile) ([]*ClientPolicyProfile, error) {
+		for _, existingProfile := range profiles {
+			if existingProfile.Name == profile.Name {
+				return nil, fmt.Errorf("client policy profile %s already exists in realm %s", profile.Name, profile.RealmId)
+			}
+		}
+
+		return append(profiles, profile), nil
+	})
+}
+
+func (keycloakClient *KeycloakClient) UpdateClientPolicyProfile(ctx context.Context, profile *ClientPolicyProfile) error {
+	return keycloakClient.updateClientPolicyProfiles(ctx, profile.RealmId, func(profiles []*ClientPolicyProfile) ([]*ClientPolicyProfile, error) {
+		for i, existingProfile := range profiles {
+			if existingProfile.Name == profile.Name {
+				profiles[i] = profile
+
+				return profiles, nil
+			}
+		}
+
+		return append(profiles, profile), nil
+	})
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientPolicyProfile(ctx context.Context, realmId, name string) error {
+	return keycloakClient.updateClientPolicyProfiles(ctx, realmId, func(profiles []*ClientPolicyProfile) ([]*ClientPolicyProfile, error) {
+		remainingProfiles := make([]*ClientPolicyProfile, 0, len(profiles))
+		for _, profile := range profiles {
+			if profile.Name != name {
+				remainingProfiles = append(remainingProfiles, profile)
+			}
+		}
+
+		return remainingProfiles, nil
+	})
+}
@@ -25,6 +25,27 @@ func (keycloakClient *KeycloakClient) GetRealmEventsConfig(ctx context.Context,
 	return &realmEventsConfig, nil
 }
 
+// ValidateRealmEventsConfig checks that every events listener is installed on the server.
+// Custom listeners are deployed as "eventsListener" SPI providers, so they are validated the same way as the built-in ones.
+func (keycloakClient *KeycloakClient) ValidateRealmEventsConfig(ctx context.Context, realmEventsConfig *RealmEventsConfig) error {
+	if len(realmEventsConfig.EventsListeners) == 0 {
+		return nil
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, eventsListener := range realmEventsConfig.EventsListeners {
+		if !serverInfo.providerInstalled("eventsListener", eventsListener) {
+			return fmt.Errorf("validation error: events listener \"%s\" does not exist on the server, installed providers: %s", eventsListener, serverInfo.getInstalledProvidersNames("eventsListener"))
+		}
+	}
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateRealmEventsConfig(ctx context.Context, realmId string, realmEventsConfig *RealmEventsConfig) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/events/config", realmId), realmEventsConfig)
 }
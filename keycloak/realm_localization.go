@@ -0,0 +1,53 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// ValidateRealmLocalization checks that internationalization is enabled in the realm, as Keycloak only uses the
+// localization texts of a realm which has internationalization enabled.
+func (keycloakClient *KeycloakClient) ValidateRealmLocalization(ctx context.Context, realmId string) error {
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	if !realm.InternationalizationEnabled {
+		return fmt.Errorf("validation error: internationalization is not enabled in realm %s, it can be enabled with the internationalization block of the realm", realmId)
+	}
+
+	return nil
+}
+
+// GetRealmLocalizationTexts only returns the texts which are overridden by the realm for the locale.
+func (keycloakClient *KeycloakClient) GetRealmLocalizationTexts(ctx context.Context, realmId, locale string) (map[string]string, error) {
+	var texts map[string]string
+
+	params := map[string]string{
+		"useRealmDefaultLocaleFallback": "false",
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale)), &texts, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return texts, nil
+}
+
+// UpsertRealmLocalizationTexts creates or updates the given texts of the locale, other texts of the locale are kept.
+func (keycloakClient *KeycloakClient) UpsertRealmLocalizationTexts(ctx context.Context, realmId, locale string, texts map[string]string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale)), texts)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmLocalizationText(ctx context.Context, realmId, locale, key string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/localization/%s/%s", realmId, url.PathEscape(locale), url.PathEscape(key)), nil)
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmLocalizationTexts(ctx context.Context, realmId, locale string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/localization/%s", realmId, url.PathEscape(locale)), nil)
+}
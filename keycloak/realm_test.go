@@ -0,0 +1,25 @@
+package keycloak
+
+import (
+	"testing"
+)
+
+func TestNormalizePasswordPolicy(t *testing.T) {
+	testCases := map[string]string{
+		"":                                      "",
+		"length(8)":                             "length(8)",
+		"upperCase(1) and length(8)":            "length(8) and upperCase(1)",
+		"length( 8 )  and upperCase(1)":         "length(8) and upperCase(1)",
+		"notUsername(undefined) and length(8)":  "length(8) and notUsername",
+		"notUsername and length(8)":             "length(8) and notUsername",
+		"hashAlgorithm(pbkdf2-sha256)":          "hashAlgorithm(pbkdf2-sha256)",
+		"regexPattern(^(a|b)$) and digits(2)":   "digits(2) and regexPattern(^(a|b)$)",
+		"forceExpiredPasswordChange(365) and  ": "forceExpiredPasswordChange(365)",
+	}
+
+	for passwordPolicy, expected := range testCases {
+		if actual := NormalizePasswordPolicy(passwordPolicy); actual != expected {
+			t.Errorf("expected password policy %q to be normalized to %q, but got %q", passwordPolicy, expected, actual)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"sort"
 )
 
 type RequiredAction struct {
@@ -101,6 +102,107 @@ func (keycloakClient *KeycloakClient) DeleteRequiredAction(ctx context.Context,
 	return nil
 }
 
+func (keycloakClient *KeycloakClient) RaiseRequiredActionPriority(ctx context.Context, realmId, alias string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/required-actions/%s/raise-priority", realmId, alias), nil)
+	return err
+}
+
+func (keycloakClient *KeycloakClient) LowerRequiredActionPriority(ctx context.Context, realmId, alias string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/required-actions/%s/lower-priority", realmId, alias), nil)
+	return err
+}
+
+// MoveRequiredActionToPriority raises or lowers the required action towards the given priority, for Keycloak versions
+// which keep the priority of a required action when updating it, and returns the priority it ends up with. Raising or
+// lowering one swaps its priority with the one of its neighbour, so only the priorities the required actions of the realm
+// already have can be reached. When the given priority isn't one of them, the required action is moved to the nearest
+// reachable priority which doesn't overshoot it. The required actions are read again after each operation, as other
+// required actions may share a priority, in which case the required action stops where its priority no longer changes.
+func (keycloakClient *KeycloakClient) MoveRequiredActionToPriority(ctx context.Context, realmId, alias string, priority int) (int, error) {
+	requiredActions, err := keycloakClient.listSortedRequiredActions(ctx, realmId)
+	if err != nil {
+		return 0, err
+	}
+
+	index := indexOfRequiredAction(requiredActions, alias)
+	if index == -1 {
+		return 0, fmt.Errorf("no required action with alias %s found in realm %s", alias, realmId)
+	}
+
+	target := reachableRequiredActionPriority(requiredActions, requiredActions[index].Priority, priority)
+
+	for {
+		current := requiredActions[index].Priority
+		if current == target {
+			return current, nil
+		}
+
+		if target < current {
+			err = keycloakClient.RaiseRequiredActionPriority(ctx, realmId, alias)
+		} else {
+			err = keycloakClient.LowerRequiredActionPriority(ctx, realmId, alias)
+		}
+		if err != nil {
+			return current, err
+		}
+
+		requiredActions, err = keycloakClient.listSortedRequiredActions(ctx, realmId)
+		if err != nil {
+			return current, err
+		}
+
+		index = indexOfRequiredAction(requiredActions, alias)
+		if index == -1 {
+			return current, fmt.Errorf("no required action with alias %s found in realm %s", alias, realmId)
+		}
+		if requiredActions[index].Priority == current {
+			return current, nil
+		}
+	}
+}
+
+// reachableRequiredActionPriority returns the priority in use by the required actions which is the closest to the given
+// one, between it and the current priority of the required action being moved.
+func reachableRequiredActionPriority(requiredActions []*RequiredAction, current, priority int) int {
+	target := current
+
+	for _, requiredAction := range requiredActions {
+		p := requiredAction.Priority
+		if priority < current && p >= priority && p < target {
+			target = p
+		}
+		if priority > current && p <= priority && p > target {
+			target = p
+		}
+	}
+
+	return target
+}
+
+// listSortedRequiredActions returns the required actions of the realm in the order Keycloak runs them.
+func (keycloakClient *KeycloakClient) listSortedRequiredActions(ctx context.Context, realmId string) ([]*RequiredAction, error) {
+	requiredActions, err := keycloakClient.GetRequiredActions(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(requiredActions, func(i, j int) bool {
+		return requiredActions[i].Priority < requiredActions[j].Priority
+	})
+
+	return requiredActions, nil
+}
+
+func indexOfRequiredAction(requiredActions []*RequiredAction, alias string) int {
+	for i, requiredAction := range requiredActions {
+		if requiredAction.Alias == alias {
+			return i
+		}
+	}
+
+	return -1
+}
+
 func (keycloakClient *KeycloakClient) ValidateRequiredAction(ctx context.Context, requiredAction *RequiredAction) error {
 	serverInfo, err := keycloakClient.GetServerInfo(ctx)
 	if err != nil {
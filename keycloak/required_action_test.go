@@ -0,0 +1,129 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// returns a client which sends its requests to a stub server keeping the required actions of a realm, which raises and
+// lowers required actions the way Keycloak does by swapping their priority with the one of their neighbour
+func newRequiredActionTestClient(t *testing.T, requiredActions []*RequiredAction) (*KeycloakClient, *int) {
+	var operations int
+
+	sorted := func() []*RequiredAction {
+		sort.SliceStable(requiredActions, func(i, j int) bool {
+			return requiredActions[i].Priority < requiredActions[j].Priority
+		})
+		return requiredActions
+	}
+
+	move := func(alias string, offset int) {
+		sorted := sorted()
+		for i, requiredAction := range sorted {
+			if requiredAction.Alias == alias && i+offset >= 0 && i+offset < len(sorted) {
+				neighbour := sorted[i+offset]
+				requiredAction.Priority, neighbour.Priority = neighbour.Priority, requiredAction.Priority
+				return
+			}
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/realms/test/authentication/required-actions":
+			json.NewEncoder(w).Encode(sorted())
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/raise-priority"):
+			operations++
+			move(strings.Split(r.URL.Path, "/")[6], -1)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/lower-priority"):
+			operations++
+			move(strings.Split(r.URL.Path, "/")[6], 1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return newTestKeycloakClient(server), &operations
+}
+
+func testRequiredActions() []*RequiredAction {
+	return []*RequiredAction{
+		{Alias: "CONFIGURE_TOTP", Priority: 10},
+		{Alias: "TERMS_AND_CONDITIONS", Priority: 20},
+		{Alias: "UPDATE_PASSWORD", Priority: 30},
+		{Alias: "UPDATE_PROFILE", Priority: 40},
+		{Alias: "VERIFY_EMAIL", Priority: 50},
+	}
+}
+
+func testMoveRequiredActionToPriority(t *testing.T, requiredActions []*RequiredAction, alias string, priority, expectedPriority int, expectedOrder []string, expectedOperations int) {
+	keycloakClient, operations := newRequiredActionTestClient(t, requiredActions)
+
+	reached, err := keycloakClient.MoveRequiredActionToPriority(context.Background(), "test", alias, priority)
+	if err != nil {
+		t.Fatalf("expected required action to be moved, got %s", err)
+	}
+	if reached != expectedPriority {
+		t.Fatalf("expected required action %s to reach priority %d, got %d", alias, expectedPriority, reached)
+	}
+
+	requiredActions, err = keycloakClient.listSortedRequiredActions(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, requiredAction := range requiredActions {
+		if requiredAction.Alias != expectedOrder[i] {
+			t.Fatalf("expected required action %d to be %s, got %s", i, expectedOrder[i], requiredAction.Alias)
+		}
+		if requiredAction.Alias == alias && requiredAction.Priority != expectedPriority {
+			t.Fatalf("expected required action %s to have priority %d, got %d", alias, expectedPriority, requiredAction.Priority)
+		}
+	}
+
+	if *operations != expectedOperations {
+		t.Fatalf("expected %d raise or lower operations, got %d", expectedOperations, *operations)
+	}
+}
+
+func TestMoveRequiredActionToPriority_raise(t *testing.T) {
+	testMoveRequiredActionToPriority(t, testRequiredActions(), "VERIFY_EMAIL", 20, 20, []string{"CONFIGURE_TOTP", "VERIFY_EMAIL", "TERMS_AND_CONDITIONS", "UPDATE_PASSWORD", "UPDATE_PROFILE"}, 3)
+}
+
+func TestMoveRequiredActionToPriority_lower(t *testing.T) {
+	testMoveRequiredActionToPriority(t, testRequiredActions(), "CONFIGURE_TOTP", 50, 50, []string{"TERMS_AND_CONDITIONS", "UPDATE_PASSWORD", "UPDATE_PROFILE", "VERIFY_EMAIL", "CONFIGURE_TOTP"}, 4)
+}
+
+func TestMoveRequiredActionToPriority_unchanged(t *testing.T) {
+	testMoveRequiredActionToPriority(t, testRequiredActions(), "UPDATE_PASSWORD", 30, 30, []string{"CONFIGURE_TOTP", "TERMS_AND_CONDITIONS", "UPDATE_PASSWORD", "UPDATE_PROFILE", "VERIFY_EMAIL"}, 0)
+}
+
+func TestMoveRequiredActionToPriority_raiseToUnusedPriority(t *testing.T) {
+	// 25 isn't in use, VERIFY_EMAIL stops at 30 rather than overshooting it
+	testMoveRequiredActionToPriority(t, testRequiredActions(), "VERIFY_EMAIL", 25, 30, []string{"CONFIGURE_TOTP", "TERMS_AND_CONDITIONS", "VERIFY_EMAIL", "UPDATE_PASSWORD", "UPDATE_PROFILE"}, 2)
+}
+
+func TestMoveRequiredActionToPriority_lowerToUnusedPriority(t *testing.T) {
+	testMoveRequiredActionToPriority(t, testRequiredActions(), "CONFIGURE_TOTP", 25, 20, []string{"TERMS_AND_CONDITIONS", "CONFIGURE_TOTP", "UPDATE_PASSWORD", "UPDATE_PROFILE", "VERIFY_EMAIL"}, 1)
+}
+
+func TestMoveRequiredActionToPriority_outOfRange(t *testing.T) {
+	testMoveRequiredActionToPriority(t, testRequiredActions(), "UPDATE_PASSWORD", 100, 50, []string{"CONFIGURE_TOTP", "TERMS_AND_CONDITIONS", "UPDATE_PROFILE", "VERIFY_EMAIL", "UPDATE_PASSWORD"}, 2)
+}
+
+func TestMoveRequiredActionToPriority_sharedPriority(t *testing.T) {
+	requiredActions := testRequiredActions()
+	requiredActions[3].Priority = 30
+
+	// UPDATE_PROFILE comes after UPDATE_PASSWORD, which has the same priority, so raising it doesn't change its priority
+	testMoveRequiredActionToPriority(t, requiredActions, "UPDATE_PROFILE", 10, 30, []string{"CONFIGURE_TOTP", "TERMS_AND_CONDITIONS", "UPDATE_PASSWORD", "UPDATE_PROFILE", "VERIFY_EMAIL"}, 1)
+}
@@ -34,6 +34,8 @@ type SamlClientAttributes struct {
 	ExtraConfig map[string]interface{} `json:"-"`
 }
 
+// SamlAuthenticationFlowBindingOverrides is always sent with both flows, as Keycloak only removes an override which is
+// set to an empty string. Overrides missing from an update are left as they are.
 type SamlAuthenticationFlowBindingOverrides struct {
 	BrowserId     string `json:"browser"`
 	DirectGrantId string `json:"direct_grant"`
@@ -64,7 +66,7 @@ type SamlClient struct {
 
 	Attributes *SamlClientAttributes `json:"attributes"`
 
-	AuthenticationFlowBindingOverrides SamlAuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides,omitempty"`
+	AuthenticationFlowBindingOverrides SamlAuthenticationFlowBindingOverrides `json:"authenticationFlowBindingOverrides"`
 }
 
 func (keycloakClient *KeycloakClient) NewSamlClient(ctx context.Context, client *SamlClient) error {
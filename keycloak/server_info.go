@@ -42,6 +42,20 @@ func (serverInfo *ServerInfo) ThemeIsInstalled(t, themeName string) bool {
 	return false
 }
 
+// ThemeLocales returns the locales an installed theme provides messages for. Themes which don't declare any locales
+// aren't localized.
+func (serverInfo *ServerInfo) ThemeLocales(t, themeName string) ([]string, bool) {
+	if themes, ok := serverInfo.Themes[t]; ok {
+		for _, theme := range themes {
+			if theme.Name == themeName {
+				return theme.Locales, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
 func (serverInfo *ServerInfo) ComponentTypeIsInstalled(componentType, componentTypeId string) bool {
 	if componentTypes, ok := serverInfo.ComponentTypes[componentType]; ok {
 		for _, componentType := range componentTypes {
@@ -54,6 +68,12 @@ func (serverInfo *ServerInfo) ComponentTypeIsInstalled(componentType, componentT
 	return false
 }
 
+// ProviderIsInstalled returns whether the provider is installed, ex. the RS256 provider of the signature provider type.
+// The names of the installed providers of the type are returned as well, so they can be listed in validation errors.
+func (serverInfo *ServerInfo) ProviderIsInstalled(providerType, providerName string) (bool, []string) {
+	return serverInfo.providerInstalled(providerType, providerName), serverInfo.getInstalledProvidersNames(providerType)
+}
+
 func (serverInfo *ServerInfo) getInstalledProvidersNames(providerType string) []string {
 	providers := serverInfo.ProviderTypes[providerType].Providers
 	keys := make([]string, 0, len(providers))
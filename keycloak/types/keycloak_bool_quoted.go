@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"strconv"
+	"strings"
 )
 
 // KeycloakBoolQuoted is a bool that is marshalled to a quoted string in JSON. This is needed for some boolean
@@ -17,16 +18,23 @@ func (c KeycloakBoolQuoted) MarshalJSON() ([]byte, error) {
 
 func (c *KeycloakBoolQuoted) UnmarshalJSON(in []byte) error {
 	value := string(in)
+	if value == "null" {
+		return nil
+	}
 	if value == `""` {
 		*c = false
 		return nil
 	}
-	unquoted, err := strconv.Unquote(value)
-	if err != nil {
-		return err
+	// unquoted booleans are accepted as well, so values which aren't stored as strings can still be read
+	unquoted := value
+	if strings.HasPrefix(value, `"`) {
+		var err error
+		unquoted, err = strconv.Unquote(value)
+		if err != nil {
+			return err
+		}
 	}
-	var b bool
-	b, err = strconv.ParseBool(unquoted)
+	b, err := strconv.ParseBool(unquoted)
 	if err != nil {
 		return err
 	}
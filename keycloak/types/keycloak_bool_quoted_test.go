@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestKeycloakBoolQuoted_unmarshal(t *testing.T) {
+	tests := map[string]struct {
+		json     string
+		expected bool
+	}{
+		"quoted true":    {json: `{"value": "true"}`, expected: true},
+		"quoted false":   {json: `{"value": "false"}`, expected: false},
+		"empty string":   {json: `{"value": ""}`, expected: false},
+		"unquoted true":  {json: `{"value": true}`, expected: true},
+		"unquoted false": {json: `{"value": false}`, expected: false},
+		"null":           {json: `{"value": null}`, expected: false},
+		"missing":        {json: `{}`, expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attributes struct {
+				Value KeycloakBoolQuoted `json:"value"`
+			}
+
+			err := json.Unmarshal([]byte(test.json), &attributes)
+			if err != nil {
+				t.Fatalf("expected %s to unmarshal, got %s", test.json, err)
+			}
+
+			if bool(attributes.Value) != test.expected {
+				t.Fatalf("expected %s to unmarshal to %t, got %t", test.json, test.expected, bool(attributes.Value))
+			}
+		})
+	}
+}
+
+func TestKeycloakBoolQuoted_unmarshalInvalid(t *testing.T) {
+	var attributes struct {
+		Value KeycloakBoolQuoted `json:"value"`
+	}
+
+	err := json.Unmarshal([]byte(`{"value": "yes please"}`), &attributes)
+	if err == nil {
+		t.Fatalf("expected unmarshalling an invalid bool to fail")
+	}
+}
+
+func TestKeycloakBoolQuoted_marshal(t *testing.T) {
+	body, err := json.Marshal(map[string]KeycloakBoolQuoted{"value": true})
+	if err != nil {
+		t.Fatalf("expected marshalling to succeed, got %s", err)
+	}
+
+	if string(body) != `{"value":"true"}` {
+		t.Fatalf("expected bool to be marshalled as a quoted string, got %s", body)
+	}
+}
@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 type FederatedIdentity struct {
@@ -26,6 +28,11 @@ type User struct {
 	Attributes          map[string][]string `json:"attributes"`
 	FederatedIdentities FederatedIdentities `json:"federatedIdentities"`
 	RequiredActions     []string            `json:"requiredActions"`
+
+	// the id of the client this user is the service account of. it is set by Keycloak and never sent back
+	ServiceAccountClientId string `json:"serviceAccountClientId,omitempty"`
+	// the id of the user federation provider, ex. LDAP, this user has been imported from
+	FederationLink string `json:"federationLink,omitempty"`
 }
 
 type PasswordCredentials struct {
@@ -54,14 +61,7 @@ func (keycloakClient *KeycloakClient) NewUser(ctx context.Context, user *User) e
 
 	user.Id = getIdFromLocationHeader(location)
 
-	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return keycloakClient.setUserFederatedIdentities(ctx, user)
 }
 
 func (keycloakClient *KeycloakClient) ResetUserPassword(ctx context.Context, realmId, userId string, newPassword string, isTemporary bool) error {
@@ -78,19 +78,41 @@ func (keycloakClient *KeycloakClient) ResetUserPassword(ctx context.Context, rea
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) GetUsers(ctx context.Context, realmId string) ([]*User, error) {
+// listUsersPaginated pages through the users of the realm matching the given query params, as Keycloak only returns
+// the first page of users when first and max aren't given.
+func (keycloakClient *KeycloakClient) listUsersPaginated(ctx context.Context, realmId string, params map[string]string) ([]*User, error) {
 	var users []*User
+	var first, pagination = 0, 100
 
-	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users", realmId), &users, nil)
-	if err != nil {
-		return nil, err
-	}
+	for {
+		var iterationUsers []*User
+		iterationParams := map[string]string{
+			"first": strconv.Itoa(first),
+			"max":   strconv.Itoa(pagination),
+		}
+		for key, value := range params {
+			iterationParams[key] = value
+		}
 
-	for _, user := range users {
-		user.RealmId = realmId
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users", realmId), &iterationUsers, iterationParams)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range iterationUsers {
+			user.RealmId = realmId
+		}
+
+		users = append(users, iterationUsers...)
+		if len(iterationUsers) < pagination {
+			return users, nil
+		}
+		first += pagination
 	}
+}
 
-	return users, nil
+func (keycloakClient *KeycloakClient) GetUsers(ctx context.Context, realmId string) ([]*User, error) {
+	return keycloakClient.listUsersPaginated(ctx, realmId, nil)
 }
 
 func (keycloakClient *KeycloakClient) GetUser(ctx context.Context, realmId, id string) (*User, error) {
@@ -112,18 +134,78 @@ func (keycloakClient *KeycloakClient) UpdateUser(ctx context.Context, user *User
 		return err
 	}
 
-	var federatedIdentities []*FederatedIdentity
-	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", user.RealmId, user.Id), &federatedIdentities, nil)
+	return keycloakClient.setUserFederatedIdentities(ctx, user)
+}
+
+// MergeUserAttributes applies attributes to the current attributes of a user without touching the attributes set by
+// other systems. Each key is replaced by its whole list of values, while keys which are set to no values, or were managed
+// before (previousKeys) and are no longer set, are removed.
+func MergeUserAttributes(currentAttributes map[string][]string, previousKeys []string, attributes map[string][]string) map[string][]string {
+	mergedAttributes := map[string][]string{}
+	for key, values := range currentAttributes {
+		mergedAttributes[key] = values
+	}
+
+	for _, key := range previousKeys {
+		if _, ok := attributes[key]; !ok {
+			delete(mergedAttributes, key)
+		}
+	}
+
+	for key, values := range attributes {
+		if isEmptyUserAttribute(values) {
+			delete(mergedAttributes, key)
+			continue
+		}
+
+		mergedAttributes[key] = values
+	}
+
+	return mergedAttributes
+}
+
+// an attribute set to an empty string in terraform ends up as a single empty value
+func isEmptyUserAttribute(values []string) bool {
+	return len(values) == 0 || (len(values) == 1 && values[0] == "")
+}
+
+// RemoveUserAttributes removes the given attributes of a user, keeping its other attributes and identity provider links
+func (keycloakClient *KeycloakClient) RemoveUserAttributes(ctx context.Context, realmId, id string, keys []string) error {
+	user, err := keycloakClient.GetUser(ctx, realmId, id)
 	if err != nil {
 		return err
 	}
 
-	for _, federatedIdentity := range federatedIdentities {
-		keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), nil)
+	user.Attributes = MergeUserAttributes(user.Attributes, keys, nil)
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s", realmId, id), user)
+}
+
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentities(ctx context.Context, realmId, userId string) (FederatedIdentities, error) {
+	var federatedIdentities FederatedIdentities
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", realmId, userId), &federatedIdentities, nil)
+	if err != nil {
+		return nil, err
 	}
 
+	return federatedIdentities, nil
+}
+
+// ValidateUserFederatedIdentities checks that a user is linked at most once to each identity provider, and that the identity
+// providers exist, before anything is changed.
+func (keycloakClient *KeycloakClient) ValidateUserFederatedIdentities(ctx context.Context, user *User) error {
+	identityProviders := map[string]bool{}
 	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
+		if identityProviders[federatedIdentity.IdentityProvider] {
+			return fmt.Errorf("validation error: user %s can only be linked once to identity provider %s", user.Username, federatedIdentity.IdentityProvider)
+		}
+		identityProviders[federatedIdentity.IdentityProvider] = true
+
+		_, err := keycloakClient.GetIdentityProvider(ctx, user.RealmId, federatedIdentity.IdentityProvider)
+		if ErrorIs404(err) {
+			return fmt.Errorf("validation error: identity provider %s of the federated identity %s doesn't exist", federatedIdentity.IdentityProvider, federatedIdentity.UserId)
+		}
 		if err != nil {
 			return err
 		}
@@ -132,28 +214,110 @@ func (keycloakClient *KeycloakClient) UpdateUser(ctx context.Context, user *User
 	return nil
 }
 
-func (keycloakClient *KeycloakClient) DeleteUser(ctx context.Context, realmId, id string) error {
-	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s", realmId, id), nil)
+// setUserFederatedIdentities reconciles the identity provider links of a user with the given ones. Links which are
+// unchanged are kept, so that users don't lose them while they're updated. Each link is verified afterwards.
+func (keycloakClient *KeycloakClient) setUserFederatedIdentities(ctx context.Context, user *User) error {
+	currentFederatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, user.RealmId, user.Id)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]*FederatedIdentity{}
+	for _, federatedIdentity := range currentFederatedIdentities {
+		current[federatedIdentity.IdentityProvider] = federatedIdentity
+	}
+
+	desired := map[string]*FederatedIdentity{}
+	for _, federatedIdentity := range user.FederatedIdentities {
+		desired[federatedIdentity.IdentityProvider] = federatedIdentity
+	}
+
+	// a link can't be updated, so it's removed before it's added again
+	for identityProvider, federatedIdentity := range current {
+		if d, ok := desired[identityProvider]; ok && *d == *federatedIdentity {
+			continue
+		}
+
+		err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, identityProvider), nil)
+		if err != nil && !ErrorIs404(err) {
+			return err
+		}
+	}
+
+	for identityProvider, federatedIdentity := range desired {
+		if c, ok := current[identityProvider]; ok && *c == *federatedIdentity {
+			continue
+		}
+
+		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, identityProvider), federatedIdentity)
+		if ErrorIs409(err) {
+			return keycloakClient.federatedIdentityConflictError(ctx, user, federatedIdentity)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return keycloakClient.verifyUserFederatedIdentities(ctx, user)
 }
 
-func (keycloakClient *KeycloakClient) GetUserByUsername(ctx context.Context, realmId, username string) (*User, error) {
+// verifyUserFederatedIdentities checks that each link of the user resolves to the configured user of its identity provider
+func (keycloakClient *KeycloakClient) verifyUserFederatedIdentities(ctx context.Context, user *User) error {
+	federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, user.RealmId, user.Id)
+	if err != nil {
+		return err
+	}
+
+	linked := map[string]*FederatedIdentity{}
+	for _, federatedIdentity := range federatedIdentities {
+		linked[federatedIdentity.IdentityProvider] = federatedIdentity
+	}
+
+	for _, federatedIdentity := range user.FederatedIdentities {
+		l, ok := linked[federatedIdentity.IdentityProvider]
+		if !ok || l.UserId != federatedIdentity.UserId {
+			return fmt.Errorf("federated identity %s of user %s couldn't be verified: it isn't linked to identity provider %s", federatedIdentity.UserId, user.Username, federatedIdentity.IdentityProvider)
+		}
+	}
+
+	return nil
+}
+
+// federatedIdentityConflictError explains that the external id of a federated identity is already linked to another user,
+// as Keycloak only responds with 409.
+func (keycloakClient *KeycloakClient) federatedIdentityConflictError(ctx context.Context, user *User, federatedIdentity *FederatedIdentity) error {
 	var users []*User
 
 	params := map[string]string{
-		"username": escapeBackslashes(username),
+		"idpAlias":  federatedIdentity.IdentityProvider,
+		"idpUserId": federatedIdentity.UserId,
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users", user.RealmId), &users, params)
+	if err == nil && len(users) == 1 && users[0].Id != user.Id {
+		return fmt.Errorf("federated identity %s of identity provider %s can't be linked to user %s, it is already linked to user %s", federatedIdentity.UserId, federatedIdentity.IdentityProvider, user.Username, users[0].Username)
 	}
 
-	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users", realmId), &users, params)
+	return fmt.Errorf("federated identity %s of identity provider %s can't be linked to user %s, it is already linked to another user", federatedIdentity.UserId, federatedIdentity.IdentityProvider, user.Username)
+}
+
+func (keycloakClient *KeycloakClient) DeleteUser(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s", realmId, id), nil)
+}
+
+func (keycloakClient *KeycloakClient) GetUserByUsername(ctx context.Context, realmId, username string) (*User, error) {
+	users, err := keycloakClient.listUsersPaginated(ctx, realmId, map[string]string{
+		"username": escapeBackslashes(username),
+		"exact":    "true",
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// more than one user could be returned so we need to search through all results and return the correct one
+	// older versions of keycloak ignore exact, so more than one user could be returned and we need to search through all results
 	// ex: foo and foo-user could both exist, but searching for "foo" will return both
 	for _, user := range users {
 		if user.Username == username {
-			user.RealmId = realmId
-
 			return user, nil
 		}
 	}
@@ -163,6 +327,26 @@ func (keycloakClient *KeycloakClient) GetUserByUsername(ctx context.Context, rea
 	return nil, nil
 }
 
+// GetUserByEmail returns nil when no user has the email, like GetUserByUsername. Keycloak stores emails in lower case,
+// so they are compared case-insensitively.
+func (keycloakClient *KeycloakClient) GetUserByEmail(ctx context.Context, realmId, email string) (*User, error) {
+	users, err := keycloakClient.listUsersPaginated(ctx, realmId, map[string]string{
+		"email": escapeBackslashes(email),
+		"exact": "true",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (keycloakClient *KeycloakClient) GetUserGroups(ctx context.Context, realmId, userId string) ([]*Group, error) {
 	var groups []*Group
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/groups/", realmId, userId), &groups, nil)
@@ -174,6 +358,42 @@ func (keycloakClient *KeycloakClient) GetUserGroups(ctx context.Context, realmId
 	return groups, nil
 }
 
+// IsUserMemberOfGroup checks whether the user is a direct member of the group by paging through the groups of the user, which
+// are usually far fewer than the members of the group.
+func (keycloakClient *KeycloakClient) IsUserMemberOfGroup(ctx context.Context, realmId, userId, groupId string) (bool, error) {
+	var first, pagination = 0, 100
+
+	for {
+		var groups []*Group
+		params := map[string]string{
+			"first":               strconv.Itoa(first),
+			"max":                 strconv.Itoa(pagination),
+			"briefRepresentation": "true",
+		}
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/groups", realmId, userId), &groups, params)
+		if err != nil {
+			return false, err
+		}
+
+		for _, group := range groups {
+			if group.Id == groupId {
+				return true, nil
+			}
+		}
+
+		if len(groups) < pagination {
+			return false, nil
+		}
+		first += pagination
+	}
+}
+
+// AddUserToGroup adds the user to the group, which Keycloak does nothing for when the user already is a member of the group.
+func (keycloakClient *KeycloakClient) AddUserToGroup(ctx context.Context, realmId, userId, groupId string) error {
+	return keycloakClient.addUserToGroup(ctx, &User{Id: userId, RealmId: realmId}, groupId)
+}
+
 func (keycloakClient *KeycloakClient) addUserToGroup(ctx context.Context, user *User, groupId string) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s/groups/%s", user.RealmId, user.Id, groupId), nil)
 }
@@ -0,0 +1,31 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type UserConsent struct {
+	ClientId            string   `json:"clientId"`
+	GrantedClientScopes []string `json:"grantedClientScopes"`
+	CreatedDate         int64    `json:"createdDate"`
+	LastUpdatedDate     int64    `json:"lastUpdatedDate"`
+}
+
+func (keycloakClient *KeycloakClient) GetUserConsents(ctx context.Context, realmId, userId string) ([]*UserConsent, error) {
+	var consents []*UserConsent
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/consents", realmId, userId), &consents, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return consents, nil
+}
+
+// RevokeUserConsent revokes the consent and offline tokens a user has granted to a client. clientId is the client's
+// client_id, not its unique ID.
+func (keycloakClient *KeycloakClient) RevokeUserConsent(ctx context.Context, realmId, userId, clientId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/consents/%s", realmId, userId, url.PathEscape(clientId)), nil)
+}
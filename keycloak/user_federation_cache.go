@@ -0,0 +1,96 @@
+package keycloak
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// UserFederationCache holds the cache settings Keycloak supports for every user storage provider.
+type UserFederationCache struct {
+	CachePolicy    string
+	MaxLifespan    string // duration string (ex: 1h30m)
+	EvictionDay    *int
+	EvictionHour   *int
+	EvictionMinute *int
+}
+
+func (cache *UserFederationCache) setComponentConfig(componentConfig map[string][]string) error {
+	componentConfig["cachePolicy"] = []string{cache.CachePolicy}
+
+	// the keycloak API will not unset these unless the config is present with an empty array
+	componentConfig["evictionHour"] = []string{}
+	componentConfig["evictionMinute"] = []string{}
+	componentConfig["evictionDay"] = []string{}
+	componentConfig["maxLifespan"] = []string{}
+
+	if cache.CachePolicy != "" {
+		if cache.EvictionHour != nil {
+			componentConfig["evictionHour"] = []string{strconv.Itoa(*cache.EvictionHour)}
+		}
+		if cache.EvictionMinute != nil {
+			componentConfig["evictionMinute"] = []string{strconv.Itoa(*cache.EvictionMinute)}
+		}
+		if cache.EvictionDay != nil {
+			componentConfig["evictionDay"] = []string{strconv.Itoa(*cache.EvictionDay)}
+		}
+
+		if cache.MaxLifespan != "" {
+			maxLifespanMs, err := getMillisecondsFromDurationString(cache.MaxLifespan)
+			if err != nil {
+				return err
+			}
+			componentConfig["maxLifespan"] = []string{maxLifespanMs}
+		}
+	}
+
+	return nil
+}
+
+func (cache *UserFederationCache) readComponentConfig(component *component) error {
+	cache.CachePolicy = component.getConfig("cachePolicy")
+
+	if maxLifespan, ok := component.getConfigOk("maxLifespan"); ok {
+		maxLifespanString, err := GetDurationStringFromMilliseconds(maxLifespan)
+		if err != nil {
+			return err
+		}
+
+		cache.MaxLifespan = maxLifespanString
+	}
+
+	defaultEvictioValue := -1
+
+	if evictionDay, ok := component.getConfigOk("evictionDay"); ok {
+		evictionDayInt, err := atoiAndTreatEmptyStringAsZero(evictionDay)
+		if err != nil {
+			return fmt.Errorf("unable to parse `evictionDay`: %w", err)
+		}
+
+		cache.EvictionDay = &evictionDayInt
+	} else {
+		cache.EvictionDay = &defaultEvictioValue
+	}
+
+	if evictionHour, ok := component.getConfigOk("evictionHour"); ok {
+		evictionHourInt, err := atoiAndTreatEmptyStringAsZero(evictionHour)
+		if err != nil {
+			return fmt.Errorf("unable to parse `evictionHour`: %w", err)
+		}
+
+		cache.EvictionHour = &evictionHourInt
+	} else {
+		cache.EvictionHour = &defaultEvictioValue
+	}
+	if evictionMinute, ok := component.getConfigOk("evictionMinute"); ok {
+		evictionMinuteInt, err := atoiAndTreatEmptyStringAsZero(evictionMinute)
+		if err != nil {
+			return fmt.Errorf("unable to parse `evictionMinute`: %w", err)
+		}
+
+		cache.EvictionMinute = &evictionMinuteInt
+	} else {
+		cache.EvictionMinute = &defaultEvictioValue
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// returns a client which sends its requests to a stub server paging through the given number of users the way
+// Keycloak does, as well as the query params of every request it received
+func newUserTestClient(t *testing.T, count int) (*KeycloakClient, *[]map[string]string) {
+	var requests []map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/admin/realms/test/users" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		params := make(map[string]string)
+		for key := range r.URL.Query() {
+			params[key] = r.URL.Query().Get(key)
+		}
+		requests = append(requests, params)
+
+		first, _ := strconv.Atoi(params["first"])
+		max, _ := strconv.Atoi(params["max"])
+
+		users := make([]*User, 0)
+		for i := first; i < count && i < first+max; i++ {
+			users = append(users, &User{
+				Id:       strconv.Itoa(i),
+				Username: fmt.Sprintf("user-%d", i),
+				Email:    fmt.Sprintf("user-%d@example.com", i),
+			})
+		}
+
+		json.NewEncoder(w).Encode(users)
+	}))
+	t.Cleanup(server.Close)
+
+	return newTestKeycloakClient(server), &requests
+}
+
+func TestListUsersPaginated_multiplePages(t *testing.T) {
+	keycloakClient, requests := newUserTestClient(t, 250)
+
+	users, err := keycloakClient.listUsersPaginated(context.Background(), "test", map[string]string{"search": "user"})
+	if err != nil {
+		t.Fatalf("expected listing users to succeed, got %s", err)
+	}
+
+	if len(users) != 250 {
+		t.Fatalf("expected 250 users, got %d", len(users))
+	}
+	for i, user := range users {
+		if user.Id != strconv.Itoa(i) || user.RealmId != "test" {
+			t.Fatalf("expected user %d of realm test, got user %s of realm %s", i, user.Id, user.RealmId)
+		}
+	}
+
+	if len(*requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(*requests))
+	}
+	for i, params := range *requests {
+		if params["first"] != strconv.Itoa(i*100) || params["max"] != "100" || params["search"] != "user" {
+			t.Errorf("unexpected params of request %d: %v", i, params)
+		}
+	}
+}
+
+func TestListUsersPaginated_fullLastPage(t *testing.T) {
+	keycloakClient, requests := newUserTestClient(t, 200)
+
+	users, err := keycloakClient.listUsersPaginated(context.Background(), "test", nil)
+	if err != nil {
+		t.Fatalf("expected listing users to succeed, got %s", err)
+	}
+
+	if len(users) != 200 {
+		t.Fatalf("expected 200 users, got %d", len(users))
+	}
+
+	// the last page is only known to be the last one once an empty page was returned
+	if len(*requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(*requests))
+	}
+}
+
+func TestGetUserByEmail(t *testing.T) {
+	keycloakClient, requests := newUserTestClient(t, 150)
+
+	user, err := keycloakClient.GetUserByEmail(context.Background(), "test", "USER-120@example.com")
+	if err != nil {
+		t.Fatalf("expected getting user by email to succeed, got %s", err)
+	}
+
+	if user == nil || user.Username != "user-120" {
+		t.Fatalf("expected user-120, got %v", user)
+	}
+
+	if (*requests)[0]["email"] != "USER-120@example.com" || (*requests)[0]["exact"] != "true" {
+		t.Errorf("unexpected params: %v", (*requests)[0])
+	}
+
+	user, err = keycloakClient.GetUserByEmail(context.Background(), "test", "unknown@example.com")
+	if err != nil {
+		t.Fatalf("expected getting user by email to succeed, got %s", err)
+	}
+
+	if user != nil {
+		t.Fatalf("expected no user, got %s", user.Username)
+	}
+}
+
+func TestIsUserMemberOfGroup(t *testing.T) {
+	var requests []map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/admin/realms/test/users/user/groups" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		params := make(map[string]string)
+		for key := range r.URL.Query() {
+			params[key] = r.URL.Query().Get(key)
+		}
+		requests = append(requests, params)
+
+		first, _ := strconv.Atoi(params["first"])
+		max, _ := strconv.Atoi(params["max"])
+
+		groups := make([]*Group, 0)
+		for i := first; i < 150 && i < first+max; i++ {
+			groups = append(groups, &Group{Id: fmt.Sprintf("group-%d", i)})
+		}
+
+		json.NewEncoder(w).Encode(groups)
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+
+	isMember, err := keycloakClient.IsUserMemberOfGroup(context.Background(), "test", "user", "group-120")
+	if err != nil {
+		t.Fatalf("expected checking the membership to succeed, got %s", err)
+	}
+
+	if !isMember {
+		t.Fatalf("expected user to be a member of group-120")
+	}
+
+	if len(requests) != 2 || requests[1]["first"] != "100" || requests[1]["briefRepresentation"] != "true" {
+		t.Errorf("unexpected requests: %v", requests)
+	}
+
+	requests = nil
+	isMember, err = keycloakClient.IsUserMemberOfGroup(context.Background(), "test", "user", "group-200")
+	if err != nil {
+		t.Fatalf("expected checking the membership to succeed, got %s", err)
+	}
+
+	if isMember {
+		t.Fatalf("expected user not to be a member of group-200")
+	}
+
+	if len(requests) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(requests))
+	}
+}
+
+func TestMergeUserAttributes_keepsAttributesSetElsewhere(t *testing.T) {
+	currentAttributes := map[string][]string{
+		"team":    {"red", "blue"},
+		"scim_id": {"1234"},
+		"locale":  {"en"},
+	}
+
+	mergedAttributes := MergeUserAttributes(currentAttributes, []string{"team"}, map[string][]string{
+		"team":   {"green", "yellow"},
+		"office": {"berlin"},
+	})
+
+	expected := map[string][]string{
+		"team":    {"green", "yellow"},
+		"office":  {"berlin"},
+		"scim_id": {"1234"},
+		"locale":  {"en"},
+	}
+	if !reflect.DeepEqual(mergedAttributes, expected) {
+		t.Fatalf("expected attributes %v, got %v", expected, mergedAttributes)
+	}
+
+	if !reflect.DeepEqual(currentAttributes["team"], []string{"red", "blue"}) {
+		t.Fatalf("expected the current attributes not to be changed, got %v", currentAttributes)
+	}
+}
+
+func TestMergeUserAttributes_removesAttributes(t *testing.T) {
+	currentAttributes := map[string][]string{
+		"team":     {"red"},
+		"nickname": {"bob"},
+		"scim_id":  {"1234", "5678"},
+	}
+
+	// team is no longer configured, and nickname is configured without a value
+	mergedAttributes := MergeUserAttributes(currentAttributes, []string{"team", "nickname"}, map[string][]string{
+		"nickname": {""},
+	})
+
+	expected := map[string][]string{
+		"scim_id": {"1234", "5678"},
+	}
+	if !reflect.DeepEqual(mergedAttributes, expected) {
+		t.Fatalf("expected attributes %v, got %v", expected, mergedAttributes)
+	}
+}
+
+func TestMergeUserAttributes_keepsUnmanagedEmptyKeys(t *testing.T) {
+	// a key which wasn't managed before and isn't configured is left as it is, even when it has no values
+	mergedAttributes := MergeUserAttributes(map[string][]string{"empty": {}}, nil, map[string][]string{"team": {"red"}})
+
+	expected := map[string][]string{
+		"empty": {},
+		"team":  {"red"},
+	}
+	if !reflect.DeepEqual(mergedAttributes, expected) {
+		t.Fatalf("expected attributes %v, got %v", expected, mergedAttributes)
+	}
+}
+
+func TestRemoveUserAttributes(t *testing.T) {
+	var updatedUser *User
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/realms/test/users/user" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(&User{
+				Id:       "user",
+				Username: "user",
+				Attributes: map[string][]string{
+					"team":    {"red", "blue"},
+					"scim_id": {"1234"},
+				},
+			})
+		case http.MethodPut:
+			json.NewDecoder(r.Body).Decode(&updatedUser)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	keycloakClient := newTestKeycloakClient(server)
+
+	err := keycloakClient.RemoveUserAttributes(context.Background(), "test", "user", []string{"team"})
+	if err != nil {
+		t.Fatalf("expected removing the attributes to succeed, got %s", err)
+	}
+
+	if updatedUser == nil || updatedUser.Username != "user" {
+		t.Fatalf("expected the user to be updated, got %v", updatedUser)
+	}
+
+	expected := map[string][]string{"scim_id": {"1234"}}
+	if !reflect.DeepEqual(updatedUser.Attributes, expected) {
+		t.Fatalf("expected attributes %v, got %v", expected, updatedUser.Attributes)
+	}
+}
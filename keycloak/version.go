@@ -29,6 +29,10 @@ const (
 	Version_24 Version = "24.0.0"
 	Version_25 Version = "25.0.0"
 	Version_26 Version = "26.0.0"
+	// token authentication to SMTP servers was added within a minor release
+	Version_26_1 Version = "26.1.0"
+	// standard token exchange was promoted to a supported feature within a minor release
+	Version_26_2 Version = "26.2.0"
 )
 
 func (v Version) AsVersion() *version.Version {
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// getAuthenticationPriorityWarnings warns about a configured priority which Keycloak before 25 ignores, as it runs
+// executions in the order they were created in.
+func getAuthenticationPriorityWarnings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, parentFlowAlias string, priority int) diag.Diagnostics {
+	if priority == 0 {
+		return nil
+	}
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_25)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if versionOk {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("priority %d of an execution in flow %s is ignored", priority, parentFlowAlias),
+			Detail:   "Keycloak only supports setting the priority of executions and subflows from version 25 onwards. Older versions run them in the order they were created in.",
+		},
+	}
+}
+
+// getAuthenticationSharedPriorityWarnings warns about an execution sharing its priority with a sibling. Keycloak orders
+// executions sharing a priority arbitrarily, so a flow is only ordered the same way on every apply when each of its executions
+// has a distinct priority. The priorities of the siblings are never changed, as they may be managed elsewhere, so this is
+// reported whenever the execution is read, including during plan.
+func getAuthenticationSharedPriorityWarnings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, parentFlowAlias, executionId string, priority int) diag.Diagnostics {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_25)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !versionOk {
+		return nil
+	}
+
+	executions, err := keycloakClient.ListAuthenticationExecutionsSharingPriority(ctx, realmId, parentFlowAlias, executionId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(executions) == 0 {
+		return nil
+	}
+
+	var names []string
+	for _, execution := range executions {
+		name := execution.ProviderId
+		if execution.AuthenticationFlow {
+			name = execution.DisplayName
+		}
+		names = append(names, name)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("priority %d is shared by several executions of flow %s", priority, parentFlowAlias),
+			Detail:   fmt.Sprintf("The execution shares its priority with %s. Keycloak orders executions sharing a priority arbitrarily, give each execution of the flow a distinct priority.", strings.Join(names, ", ")),
+		},
+	}
+}
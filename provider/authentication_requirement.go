@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakAuthenticationRequirements = []string{"REQUIRED", "ALTERNATIVE", "OPTIONAL", "CONDITIONAL", "DISABLED"} //OPTIONAL is removed from 8.0.0 onwards
+
+// validateAuthenticationExecutionRequirement catches requirements Keycloak doesn't support for executions.
+// Only subflows can be CONDITIONAL, conditions themselves are REQUIRED executions within a CONDITIONAL subflow.
+func validateAuthenticationExecutionRequirement(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("requirement").(string) == "CONDITIONAL" {
+		return fmt.Errorf("validation error: requirement CONDITIONAL is only supported for subflows, use REQUIRED for the condition executions within a CONDITIONAL subflow")
+	}
+
+	return nil
+}
+
+// validateAuthenticationSubFlowRequirement catches requirements Keycloak doesn't support for the subflow's type.
+// Form and client flows are always evaluated as a whole, so they can't be CONDITIONAL.
+func validateAuthenticationSubFlowRequirement(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	providerId := d.Get("provider_id").(string)
+	if d.Get("requirement").(string) == "CONDITIONAL" && providerId != "basic-flow" {
+		return fmt.Errorf("validation error: requirement CONDITIONAL is only supported for basic-flow subflows, got %s", providerId)
+	}
+
+	return nil
+}
+
+// getAuthenticationRequirementWarnings warns about a flow which mixes REQUIRED and ALTERNATIVE executions on the same level.
+// Keycloak ignores the ALTERNATIVE executions in this case, which is rarely what was intended.
+func getAuthenticationRequirementWarnings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, parentFlowAlias string) diag.Diagnostics {
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var hasRequired, hasAlternative bool
+	for _, execution := range executions {
+		// only direct children of the parent flow are siblings, deeper levels belong to subflows
+		if execution.Level != 0 {
+			continue
+		}
+
+		switch execution.Requirement {
+		case "REQUIRED", "CONDITIONAL":
+			hasRequired = true
+		case "ALTERNATIVE":
+			hasAlternative = true
+		}
+	}
+
+	if hasRequired && hasAlternative {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("authentication flow %s mixes REQUIRED and ALTERNATIVE executions", parentFlowAlias),
+				Detail:   "Keycloak ignores ALTERNATIVE executions when a REQUIRED or CONDITIONAL execution exists on the same level. Move the ALTERNATIVE executions into their own subflow.",
+			},
+		}
+	}
+
+	return nil
+}
@@ -27,6 +27,20 @@ func dataSourceKeycloakAuthenticationExecution() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:      schema.TypeMap,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -43,7 +57,15 @@ func dataSourceKeycloakAuthenticationExecutionRead(ctx context.Context, data *sc
 		return diag.FromErr(err)
 	}
 
-	mapFromAuthenticationExecutionInfoToData(ctx, keycloakClient, data, authenticationExecutionInfo)
+	err = mapFromAuthenticationExecutionInfoToData(ctx, keycloakClient, data, authenticationExecutionInfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setAttachedAuthenticationExecutionConfigData(ctx, keycloakClient, data, realmID, authenticationExecutionInfo.Id, authenticationExecutionInfo.AuthenticationConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
@@ -19,6 +21,76 @@ func dataSourceKeycloakAuthenticationFlow() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"built_in": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"executions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The executions and subflows of the flow, including those of its subflows, in the order Keycloak runs them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authenticator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"requirement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"level": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"authentication_flow": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"flow_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_flow_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"export_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The structure of the flow, including its subflows, executions and their configs, as JSON which can be used as import_json of a keycloak_authentication_flow.",
+			},
 		},
 	}
 }
@@ -34,7 +106,60 @@ func dataSourceKeycloakAuthenticationFlowRead(ctx context.Context, data *schema.
 		return diag.FromErr(err)
 	}
 
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmID, alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	export, err := keycloakClient.ExportAuthenticationFlow(ctx, realmID, alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	exportJson, err := json.Marshal(export)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	mapFromAuthenticationFlowInfoToData(data, authenticationFlowInfo)
+	data.Set("provider_id", authenticationFlowInfo.ProviderId)
+	data.Set("description", authenticationFlowInfo.Description)
+	data.Set("built_in", authenticationFlowInfo.BuiltIn)
+	data.Set("executions", getAuthenticationFlowExecutionsData(alias, executions))
+	data.Set("export_json", string(exportJson))
 
 	return nil
 }
+
+func getAuthenticationFlowExecutionsData(alias string, executions keycloak.AuthenticationExecutionList) []interface{} {
+	// Keycloak lists the executions of each subflow right after the subflow, so the aliases of the flows enclosing the
+	// current execution are kept by level
+	parentFlowAliases := []string{alias}
+
+	var executionsData []interface{}
+	for _, execution := range executions {
+		if execution.Level < len(parentFlowAliases) {
+			parentFlowAliases = parentFlowAliases[:execution.Level+1]
+		}
+
+		executionsData = append(executionsData, map[string]interface{}{
+			"id":                  execution.Id,
+			"authenticator":       execution.ProviderId,
+			"display_name":        execution.DisplayName,
+			"requirement":         execution.Requirement,
+			"priority":            execution.Priority,
+			"level":               execution.Level,
+			"index":               execution.Index,
+			"authentication_flow": execution.AuthenticationFlow,
+			"flow_id":             execution.FlowId,
+			"parent_flow_alias":   parentFlowAliases[len(parentFlowAliases)-1],
+			"config_id":           execution.AuthenticationConfig,
+		})
+
+		if execution.AuthenticationFlow {
+			parentFlowAliases = append(parentFlowAliases, execution.DisplayName)
+		}
+	}
+
+	return executionsData
+}
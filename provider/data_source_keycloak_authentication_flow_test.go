@@ -32,6 +32,44 @@ func TestAccKeycloakDataSourceAuthenticationFlow_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceAuthenticationFlow_builtIn(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakAuthenticationFlow_builtIn(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_authentication_flow.browser", "provider_id", "basic-flow"),
+					resource.TestCheckResourceAttr("data.keycloak_authentication_flow.browser", "built_in", "true"),
+					resource.TestCheckResourceAttr("data.keycloak_authentication_flow.browser", "executions.0.authenticator", "auth-cookie"),
+					resource.TestCheckResourceAttr("data.keycloak_authentication_flow.browser", "executions.0.level", "0"),
+					resource.TestCheckResourceAttrSet("data.keycloak_authentication_flow.browser", "executions.0.requirement"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_authentication_flow.browser", "executions.*", map[string]string{
+						"authentication_flow": "true",
+						"level":               "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_authentication_flow.browser", "executions.*", map[string]string{
+						"authenticator":     "identity-provider-redirector",
+						"parent_flow_alias": "browser",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_authentication_flow.browser", "executions.*", map[string]string{
+						"authenticator":     "auth-username-password-form",
+						"level":             "1",
+						"parent_flow_alias": "forms",
+					}),
+					resource.TestCheckResourceAttr("data.keycloak_authentication_flow.clients", "provider_id", "client-flow"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_authentication_flow.clients", "executions.*", map[string]string{
+						"authenticator": "client-secret",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataKeycloakAuthenticationFlow(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -94,6 +132,24 @@ data "keycloak_authentication_flow" "flow" {
 	`, testAccRealm.Realm, alias)
 }
 
+func testDataSourceKeycloakAuthenticationFlow_builtIn() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_authentication_flow" "browser" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "browser"
+}
+
+data "keycloak_authentication_flow" "clients" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "clients"
+}
+	`, testAccRealm.Realm)
+}
+
 func testDataSourceKeycloakAuthenticationFlow_wrongAlias(alias string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
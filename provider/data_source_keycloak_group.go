@@ -27,6 +27,10 @@ func dataSourceKeycloakGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"sub_group_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"attributes": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -238,11 +238,121 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"use_lightweight_access_token": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"introspection_response_allow_jwt_claim": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"require_pushed_authorization_requests": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_backchannel_token_delivery_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciba_backchannel_auth_request_signing_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciba_backchannel_client_notification_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tls_client_certificate_bound_access_tokens": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tls_client_auth_subject_dn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"x509_allow_regex_pattern_comparison": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"use_jwks_url": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"token_endpoint_auth_signing_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"jwt_credential_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"introspection_signed_response_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"introspection_encrypted_response_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"introspection_encrypted_response_enc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minimum_acr_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_acr_values": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"standard_token_exchange_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"standard_token_exchange_refresh_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"verifiable_credential": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"always_display_in_console": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"acr_loa_map": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
 		},
 	}
 }
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakOpenidClientAuthorizationEvaluate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakOpenidClientAuthorizationEvaluateRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID or username of the user to evaluate the request for.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID (not the client_id) of the client the request is made from.",
+			},
+			"role_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Names of roles to grant the user in addition to the user's own roles.",
+			},
+			"resource": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"scopes": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"context_attributes": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed_scopes": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+						"policies": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getOpenidClientAuthorizationEvaluationRequestFromData(data *schema.ResourceData) *keycloak.OpenidClientAuthorizationEvaluationRequest {
+	var resources []keycloak.OpenidClientAuthorizationEvaluationResource
+	for _, r := range data.Get("resource").([]interface{}) {
+		resourceData := r.(map[string]interface{})
+
+		var scopes []keycloak.OpenidClientAuthorizationEvaluationScope
+		for _, scope := range resourceData["scopes"].(*schema.Set).List() {
+			scopes = append(scopes, keycloak.OpenidClientAuthorizationEvaluationScope{Name: scope.(string)})
+		}
+
+		resources = append(resources, keycloak.OpenidClientAuthorizationEvaluationResource{
+			Id:     resourceData["id"].(string),
+			Scopes: scopes,
+		})
+	}
+
+	var evaluationContext map[string]map[string]string
+	if v, ok := data.GetOk("context_attributes"); ok {
+		attributes := map[string]string{}
+		for key, value := range v.(map[string]interface{}) {
+			attributes[key] = value.(string)
+		}
+		evaluationContext = map[string]map[string]string{
+			"attributes": attributes,
+		}
+	}
+
+	return &keycloak.OpenidClientAuthorizationEvaluationRequest{
+		RealmId:          data.Get("realm_id").(string),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		ClientId:         data.Get("client_id").(string),
+		UserId:           data.Get("user_id").(string),
+		RoleIds:          interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List()),
+		Resources:        resources,
+		Context:          evaluationContext,
+	}
+}
+
+func setOpenidClientAuthorizationEvaluationData(data *schema.ResourceData, response *keycloak.OpenidClientAuthorizationEvaluationResponse) {
+	var results []interface{}
+	for _, result := range response.Results {
+		var allowedScopes []string
+		for _, scope := range result.AllowedScopes {
+			allowedScopes = append(allowedScopes, scope.Name)
+		}
+
+		var policies []interface{}
+		for _, policy := range result.Policies {
+			policies = append(policies, map[string]interface{}{
+				"name":   policy.Policy.Name,
+				"status": policy.Status,
+			})
+		}
+
+		results = append(results, map[string]interface{}{
+			"resource_id":    result.Resource.Id,
+			"resource_name":  result.Resource.Name,
+			"status":         result.Status,
+			"allowed_scopes": allowedScopes,
+			"policies":       policies,
+		})
+	}
+
+	data.Set("status", response.Status)
+	data.Set("results", results)
+}
+
+func dataSourceKeycloakOpenidClientAuthorizationEvaluateRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	request := getOpenidClientAuthorizationEvaluationRequestFromData(data)
+	if request.UserId == "" && request.ClientId == "" {
+		return diag.Errorf("one of user_id or client_id must be set")
+	}
+
+	response, err := keycloakClient.EvaluateOpenidClientAuthorization(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setOpenidClientAuthorizationEvaluationData(data, response)
+	data.SetId(fmt.Sprintf("%s/%s", request.RealmId, request.ResourceServerId))
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceOpenidClientAuthorizationEvaluate_basic(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	allowedUsername := acctest.RandomWithPrefix("tf-acc")
+	deniedUsername := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakOpenidClientAuthorizationEvaluate_basic(clientId, allowedUsername, deniedUsername),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_openid_client_authorization_evaluate.allowed", "status", "PERMIT"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_client_authorization_evaluate.allowed", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_client_authorization_evaluate.allowed", "results.0.status", "PERMIT"),
+					resource.TestCheckTypeSetElemAttr("data.keycloak_openid_client_authorization_evaluate.allowed", "results.0.allowed_scopes.*", "read"),
+					resource.TestCheckResourceAttr("data.keycloak_openid_client_authorization_evaluate.denied", "status", "DENY"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakOpenidClientAuthorizationEvaluate_basic(clientId, allowedUsername, deniedUsername string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "test" {
+	client_id                = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+	authorization {
+		policy_enforcement_mode = "ENFORCING"
+	}
+}
+
+resource "keycloak_user" "allowed" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user" "denied" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_openid_client_authorization_scope" "read" {
+	resource_server_id = keycloak_openid_client.test.resource_server_id
+	realm_id           = data.keycloak_realm.realm.id
+	name               = "read"
+}
+
+resource "keycloak_openid_client_authorization_resource" "test" {
+	resource_server_id = keycloak_openid_client.test.resource_server_id
+	realm_id           = data.keycloak_realm.realm.id
+	name               = "documents"
+	scopes             = [keycloak_openid_client_authorization_scope.read.name]
+}
+
+resource "keycloak_openid_client_user_policy" "test" {
+	resource_server_id = keycloak_openid_client.test.resource_server_id
+	realm_id           = data.keycloak_realm.realm.id
+	name               = "allowed-user"
+	users              = [keycloak_user.allowed.id]
+	logic              = "POSITIVE"
+	decision_strategy  = "UNANIMOUS"
+}
+
+resource "keycloak_openid_client_authorization_permission" "test" {
+	resource_server_id = keycloak_openid_client.test.resource_server_id
+	realm_id           = data.keycloak_realm.realm.id
+	name               = "documents-permission"
+	policies           = [keycloak_openid_client_user_policy.test.id]
+	resources          = [keycloak_openid_client_authorization_resource.test.id]
+}
+
+data "keycloak_openid_client_authorization_evaluate" "allowed" {
+	realm_id           = data.keycloak_realm.realm.id
+	resource_server_id = keycloak_openid_client.test.resource_server_id
+	client_id          = keycloak_openid_client.test.id
+	user_id            = keycloak_user.allowed.id
+
+	resource {
+		id     = keycloak_openid_client_authorization_resource.test.id
+		scopes = ["read"]
+	}
+
+	depends_on = [keycloak_openid_client_authorization_permission.test]
+}
+
+data "keycloak_openid_client_authorization_evaluate" "denied" {
+	realm_id           = data.keycloak_realm.realm.id
+	resource_server_id = keycloak_openid_client.test.resource_server_id
+	client_id          = keycloak_openid_client.test.id
+	user_id            = keycloak_user.denied.id
+
+	resource {
+		id     = keycloak_openid_client_authorization_resource.test.id
+		scopes = ["read"]
+	}
+
+	depends_on = [keycloak_openid_client_authorization_permission.test]
+}
+	`, testAccRealm.Realm, clientId, allowedUsername, deniedUsername)
+}
@@ -44,6 +44,14 @@ func dataSourceKeycloakOpenidClientServiceAccountUser() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"service_account_client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"federation_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"attributes": {
 				Type:     schema.TypeMap,
 				Computed: true,
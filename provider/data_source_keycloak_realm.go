@@ -120,6 +120,14 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"admin_permissions_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"verifiable_credentials_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 
 			// Login Config
 
@@ -219,6 +227,27 @@ func dataSourceKeycloakRealm() *schema.Resource {
 										Computed:  true,
 										Sensitive: true,
 									},
+									"auth_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"auth_token_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"auth_token_scope": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"auth_token_client_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"auth_token_client_secret": {
+										Type:      schema.TypeString,
+										Computed:  true,
+										Sensitive: true,
+									},
 								},
 							},
 						},
@@ -232,6 +261,10 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dark_mode": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"account_theme": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -435,6 +468,14 @@ func dataSourceKeycloakRealm() *schema.Resource {
 										Type:     schema.TypeInt,
 										Computed: true,
 									},
+									"max_temporary_lockouts": { //maxTemporaryLockouts
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"brute_force_strategy": { //bruteForceStrategy
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 								},
 							},
 						},
@@ -488,6 +529,11 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"acr_loa_map": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Computed: true,
+			},
 			// default default client scopes
 			"default_default_client_scopes": {
 				Type:     schema.TypeSet,
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRealmSmtpConnection() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmSmtpConnectionRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The realm whose SMTP configuration is tested.",
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakRealmSmtpConnectionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	config, err := keycloakClient.GetRealmSmtpConfig(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if config["host"] == "" {
+		return diag.Errorf("realm %s does not have an SMTP server configured", realmId)
+	}
+
+	err = keycloakClient.TestSmtpConnection(ctx, realmId, config)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error sending test email for realm %s: %s", realmId, err))
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRealmSmtpConnection_noSmtpServer(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeycloakRealmSmtpConnection_noSmtpServer(realmName),
+				ExpectError: regexp.MustCompile("does not have an SMTP server configured"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceRealmSmtpConnection_unreachableSmtpServer(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeycloakRealmSmtpConnection_unreachableSmtpServer(realmName),
+				ExpectError: regexp.MustCompile("error sending test email for realm " + realmName),
+			},
+		},
+	})
+}
+
+func testAccKeycloakRealmSmtpConnection_noSmtpServer(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_realm_smtp_connection" "smtp_connection" {
+	realm_id = keycloak_realm.realm.id
+}
+	`, realm)
+}
+
+func testAccKeycloakRealmSmtpConnection_unreachableSmtpServer(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	smtp_server {
+		host = "localhost"
+		port = 1
+		from = "keycloak@example.com"
+	}
+}
+
+data "keycloak_realm_smtp_connection" "smtp_connection" {
+	realm_id = keycloak_realm.realm.id
+}
+	`, realm)
+}
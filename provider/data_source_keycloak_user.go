@@ -17,12 +17,16 @@ func dataSourceKeycloakUser() *schema.Resource {
 				Required: true,
 			},
 			"username": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"username", "email"},
 			},
 			"email": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"username", "email"},
 			},
 			"email_verified": {
 				Type:     schema.TypeBool,
@@ -54,6 +58,14 @@ func dataSourceKeycloakUser() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"service_account_client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"federation_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -62,6 +74,21 @@ func dataSourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData,
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmID := data.Get("realm_id").(string)
+
+	if email, ok := data.GetOk("email"); ok {
+		user, err := keycloakClient.GetUserByEmail(ctx, realmID, email.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if user == nil {
+			return diag.Errorf("user with email %s not found", email)
+		}
+
+		mapFromUserToData(data, user)
+
+		return nil
+	}
+
 	username := data.Get("username").(string)
 
 	user, err := keycloakClient.GetUserByUsername(ctx, realmID, username)
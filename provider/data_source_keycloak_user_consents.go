@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserConsents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUserConsentsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"consents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"granted_client_scopes": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_updated_date": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserConsentsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	consents, err := keycloakClient.GetUserConsents(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var consentList []interface{}
+	for _, consent := range consents {
+		consentList = append(consentList, map[string]interface{}{
+			"client_id":             consent.ClientId,
+			"granted_client_scopes": consent.GrantedClientScopes,
+			"created_date":          consent.CreatedDate,
+			"last_updated_date":     consent.LastUpdatedDate,
+		})
+	}
+
+	data.Set("consents", consentList)
+	data.SetId(realmId + "/" + userId)
+
+	return nil
+}
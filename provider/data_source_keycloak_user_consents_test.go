@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUserConsents_noConsents(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserConsents(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.keycloak_user_consents.consents", "user_id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_user_consents.consents", "consents.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserConsents(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	username = "%s"
+	realm_id = data.keycloak_realm.realm.id
+	enabled  = true
+}
+
+data "keycloak_user_consents" "consents" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+}
+	`, testAccRealm.Realm, username)
+}
@@ -33,6 +33,28 @@ func TestAccKeycloakDataSourceUser(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceUser_email(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	email := acctest.RandomWithPrefix("tf-acc") + "@fakedomain.com"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUser_email(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_user.user", "id", "data.keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "username", username),
+					resource.TestCheckResourceAttr("data.keycloak_user.user", "email", email),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakDataSourceUser_gracefulError(t *testing.T) {
 	t.Parallel()
 	username := acctest.RandomWithPrefix("tf-acc")
@@ -113,3 +135,28 @@ data "keycloak_user" "user" {
 }
 	`, testAccRealm.Realm, username)
 }
+
+func testDataSourceKeycloakUser_email(username, email string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+	email    = "%s"
+}
+
+resource "keycloak_user" "other_user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-other"
+	email    = "other-%s"
+}
+
+data "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	email    = keycloak_user.user.email
+}
+	`, testAccRealm.Realm, username, email, username, email)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 	"reflect"
+	"regexp"
 	"strings"
 )
 
@@ -45,6 +46,11 @@ func resourceKeycloakIdentityProvider() *schema.Resource {
 				Computed:    true,
 				Description: "Internal Identity Provider Id",
 			},
+			"account_linking_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The url applications redirect users to in order to link their account to this identity provider. The client_id, redirect_uri, nonce and hash query parameters have to be added to it.",
+			},
 			"display_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -107,10 +113,11 @@ func resourceKeycloakIdentityProvider() *schema.Resource {
 				ValidateDiagFunc: validateExtraConfig(reflect.ValueOf(&keycloak.IdentityProviderConfig{}).Elem()),
 			},
 			"gui_order": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "GUI Order",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(-?[0-9]+)?$`), "gui_order must be a whole number"),
+				Description:  "GUI Order",
 			},
 			"sync_mode": {
 				Type:         schema.TypeString,
@@ -239,7 +246,12 @@ func resourceKeycloakIdentityProviderRead(setDataFromIdentityProvider identityPr
 			return handleNotFoundError(ctx, err, data)
 		}
 
-		return diag.FromErr(setDataFromIdentityProvider(data, identityProvider, keycloakVersion))
+		if err = setDataFromIdentityProvider(data, identityProvider, keycloakVersion); err != nil {
+			return diag.FromErr(err)
+		}
+		data.Set("account_linking_url", keycloakClient.GetIdentityProviderAccountLinkingUrl(realm, alias))
+
+		return nil
 	}
 }
 
@@ -260,6 +272,11 @@ func resourceKeycloakIdentityProviderUpdate(getIdentityProviderFromData identity
 			return diag.FromErr(err)
 		}
 
-		return diag.FromErr(setDataFromIdentityProvider(data, identityProvider, keycloakVersion))
+		if err = setDataFromIdentityProvider(data, identityProvider, keycloakVersion); err != nil {
+			return diag.FromErr(err)
+		}
+		data.Set("account_linking_url", keycloakClient.GetIdentityProviderAccountLinkingUrl(identityProvider.Realm, identityProvider.Alias))
+
+		return nil
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
@@ -46,6 +47,30 @@ func resourceKeycloakIdentityProviderMapper() *schema.Resource {
 	}
 }
 
+// validateIdentityProviderMapperExtraConfigKeys rejects the extra_config keys a mapper resource manages through its own
+// arguments, as they'd be overwritten by these arguments.
+func validateIdentityProviderMapperExtraConfigKeys(keys []string) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		extraConfig := v.(map[string]interface{})
+		for _, key := range keys {
+			if _, ok := extraConfig[key]; ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid extra_config key",
+					Detail:   fmt.Sprintf(`extra_config key "%s" is not allowed, as it conflicts with a top-level schema attribute`, key),
+					AttributePath: append(path, cty.IndexStep{
+						Key: cty.StringVal(key),
+					}),
+				})
+			}
+		}
+
+		return diags
+	}
+}
+
 func getIdentityProviderMapperFromData(data *schema.ResourceData) (*keycloak.IdentityProviderMapper, error) {
 	rec := &keycloak.IdentityProviderMapper{
 		Id:                    data.Id(),
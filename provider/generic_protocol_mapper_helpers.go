@@ -4,23 +4,32 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 	"strings"
 )
 
-func genericProtocolMapperImport(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(data.Id(), "/")
-	if len(parts) != 4 {
+func genericProtocolMapperImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// client ids may contain slashes, ex. when they are URLs, so the protocol mapper id is everything after the last one
+	parts := strings.SplitN(data.Id(), "/", 3)
+	separator := strings.LastIndex(parts[len(parts)-1], "/")
+	if len(parts) != 3 || separator == -1 {
 		return nil, fmt.Errorf("invalid import. supported import formats: {{realmId}}/client/{{clientId}}/{{protocolMapperId}}, {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}")
 	}
 
 	parentResourceType := parts[1]
-	parentResourceId := parts[2]
+	parentResourceId := parts[2][:separator]
 
 	data.Set("realm_id", parts[0])
-	data.SetId(parts[3])
+	data.SetId(parts[2][separator+1:])
 
 	if parentResourceType == "client" {
-		data.Set("client_id", parentResourceId)
+		clientId, err := getClientUuid(ctx, keycloakClient, parts[0], parentResourceId)
+		if err != nil {
+			return nil, err
+		}
+		data.Set("client_id", clientId)
 	} else if parentResourceType == "client-scope" {
 		data.Set("client_scope_id", parentResourceId)
 	} else {
@@ -8,7 +8,7 @@ import (
 )
 
 func setOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, realmManagementClientId string, authorizationPermissionId string, scopeDataSet *schema.Set) error {
-	var policies []string
+	policies := make([]string, 0)
 
 	scopePermission := scopeDataSet.List()[0].(map[string]interface{})
 
@@ -36,6 +36,79 @@ func setOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *k
 	return keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
 }
 
+// resetOpenidClientScopePermissionPolicy gives the scope permission the settings Keycloak creates it with, which is how the
+// scope permission of a block that was removed is cleaned up.
+func resetOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, realmManagementClientId, authorizationPermissionId string) error {
+	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClientId, authorizationPermissionId)
+	if err != nil {
+		return err
+	}
+
+	permission.Description = ""
+	permission.DecisionStrategy = "UNANIMOUS"
+	// Keycloak keeps the policies of a permission when they're left out
+	permission.Policies = []string{}
+
+	return keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
+}
+
+// reconcileOpenidClientScopePermissionPolicy sets the scope permission from the given block of the resource, or resets it when
+// the block was removed. Scope permissions which were never managed by the resource are left alone.
+func reconcileOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, key, realmId, realmManagementClientId, authorizationPermissionId string) error {
+	if scope, ok := data.GetOk(key); ok {
+		return setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClientId, authorizationPermissionId, scope.(*schema.Set))
+	}
+
+	if data.HasChange(key) {
+		return resetOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClientId, authorizationPermissionId)
+	}
+
+	return nil
+}
+
+// setOpenidClientScopePermissionPolicyData sets the given block of the resource from the scope permission. Blocks which aren't
+// part of the state are only set on import, as the scope permission of a block which was never configured may be managed
+// elsewhere, for example by keycloak_openid_client_token_exchange, and reading it into state would make the next apply reset it.
+// When the scope permission has the settings Keycloak creates it with, the block is only kept if it's part of the configuration,
+// as a block which only uses the defaults would otherwise always produce a diff.
+func setOpenidClientScopePermissionPolicyData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, key, realmId, realmManagementClientId, authorizationPermissionId string, importing bool) error {
+	scopeData := data.Get(key).(*schema.Set).List()
+	if len(scopeData) == 0 && !importing {
+		return nil
+	}
+
+	scope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClientId, authorizationPermissionId)
+	if err != nil {
+		return err
+	}
+
+	if scope != nil {
+		data.Set(key, []interface{}{scope})
+		return nil
+	}
+
+	if len(scopeData) != 0 {
+		if isDefaultOpenidClientScopePermissionPolicyData(scopeData[0].(map[string]interface{})) {
+			return nil
+		}
+
+		data.Set(key, []interface{}{map[string]interface{}{"decision_strategy": "UNANIMOUS"}})
+		return nil
+	}
+
+	data.Set(key, nil)
+
+	return nil
+}
+
+// isDefaultOpenidClientScopePermissionPolicyData checks whether a block of the resource leaves the scope permission with the
+// settings Keycloak creates it with.
+func isDefaultOpenidClientScopePermissionPolicyData(scopeData map[string]interface{}) bool {
+	decisionStrategy := scopeData["decision_strategy"].(string)
+
+	return scopeData["description"].(string) == "" && (decisionStrategy == "" || decisionStrategy == "UNANIMOUS") && scopeData["policies"].(*schema.Set).Len() == 0
+}
+
 func getOpenidClientScopePermissionPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, realmManagementClientId, permissionId string) (map[string]interface{}, error) {
 	permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClientId, permissionId)
 	if err != nil {
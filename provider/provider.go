@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
@@ -13,113 +15,142 @@ import (
 func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 	provider := &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
-			"keycloak_group":                              dataSourceKeycloakGroup(),
-			"keycloak_openid_client":                      dataSourceKeycloakOpenidClient(),
-			"keycloak_openid_client_authorization_policy": dataSourceKeycloakOpenidClientAuthorizationPolicy(),
-			"keycloak_openid_client_scope":                dataSourceKeycloakOpenidClientScope(),
-			"keycloak_openid_client_service_account_user": dataSourceKeycloakOpenidClientServiceAccountUser(),
-			"keycloak_realm":                              dataSourceKeycloakRealm(),
-			"keycloak_realm_keys":                         dataSourceKeycloakRealmKeys(),
-			"keycloak_role":                               dataSourceKeycloakRole(),
-			"keycloak_user":                               dataSourceKeycloakUser(),
-			"keycloak_user_realm_roles":                   dataSourceKeycloakUserRealmRoles(),
-			"keycloak_saml_client_installation_provider":  dataSourceKeycloakSamlClientInstallationProvider(),
-			"keycloak_saml_client":                        dataSourceKeycloakSamlClient(),
-			"keycloak_authentication_execution":           dataSourceKeycloakAuthenticationExecution(),
-			"keycloak_authentication_flow":                dataSourceKeycloakAuthenticationFlow(),
-			"keycloak_client_description_converter":       dataSourceKeycloakClientDescriptionConverter(),
+			"keycloak_group":                                dataSourceKeycloakGroup(),
+			"keycloak_openid_client":                        dataSourceKeycloakOpenidClient(),
+			"keycloak_openid_client_authorization_policy":   dataSourceKeycloakOpenidClientAuthorizationPolicy(),
+			"keycloak_openid_client_authorization_evaluate": dataSourceKeycloakOpenidClientAuthorizationEvaluate(),
+			"keycloak_openid_client_scope":                  dataSourceKeycloakOpenidClientScope(),
+			"keycloak_openid_client_service_account_user":   dataSourceKeycloakOpenidClientServiceAccountUser(),
+			"keycloak_realm":                                dataSourceKeycloakRealm(),
+			"keycloak_realm_keys":                           dataSourceKeycloakRealmKeys(),
+			"keycloak_realm_smtp_connection":                dataSourceKeycloakRealmSmtpConnection(),
+			"keycloak_role":                                 dataSourceKeycloakRole(),
+			"keycloak_user":                                 dataSourceKeycloakUser(),
+			"keycloak_user_realm_roles":                     dataSourceKeycloakUserRealmRoles(),
+			"keycloak_user_consents":                        dataSourceKeycloakUserConsents(),
+			"keycloak_saml_client_installation_provider":    dataSourceKeycloakSamlClientInstallationProvider(),
+			"keycloak_saml_client":                          dataSourceKeycloakSamlClient(),
+			"keycloak_authentication_execution":             dataSourceKeycloakAuthenticationExecution(),
+			"keycloak_authentication_flow":                  dataSourceKeycloakAuthenticationFlow(),
+			"keycloak_client_description_converter":         dataSourceKeycloakClientDescriptionConverter(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"keycloak_realm":                                             resourceKeycloakRealm(),
-			"keycloak_realm_events":                                      resourceKeycloakRealmEvents(),
-			"keycloak_realm_default_client_scopes":                       resourceKeycloakRealmDefaultClientScopes(),
-			"keycloak_realm_optional_client_scopes":                      resourceKeycloakRealmOptionalClientScopes(),
-			"keycloak_realm_keystore_aes_generated":                      resourceKeycloakRealmKeystoreAesGenerated(),
-			"keycloak_realm_keystore_ecdsa_generated":                    resourceKeycloakRealmKeystoreEcdsaGenerated(),
-			"keycloak_realm_keystore_hmac_generated":                     resourceKeycloakRealmKeystoreHmacGenerated(),
-			"keycloak_realm_keystore_java_keystore":                      resourceKeycloakRealmKeystoreJavaKeystore(),
-			"keycloak_realm_keystore_rsa":                                resourceKeycloakRealmKeystoreRsa(),
-			"keycloak_realm_keystore_rsa_generated":                      resourceKeycloakRealmKeystoreRsaGenerated(),
-			"keycloak_realm_user_profile":                                resourceKeycloakRealmUserProfile(),
-			"keycloak_required_action":                                   resourceKeycloakRequiredAction(),
-			"keycloak_group":                                             resourceKeycloakGroup(),
-			"keycloak_group_memberships":                                 resourceKeycloakGroupMemberships(),
-			"keycloak_default_groups":                                    resourceKeycloakDefaultGroups(),
-			"keycloak_default_roles":                                     resourceKeycloakDefaultRoles(),
-			"keycloak_group_roles":                                       resourceKeycloakGroupRoles(),
-			"keycloak_user":                                              resourceKeycloakUser(),
-			"keycloak_user_roles":                                        resourceKeycloakUserRoles(),
-			"keycloak_openid_client":                                     resourceKeycloakOpenidClient(),
-			"keycloak_openid_client_scope":                               resourceKeycloakOpenidClientScope(),
-			"keycloak_ldap_user_federation":                              resourceKeycloakLdapUserFederation(),
-			"keycloak_ldap_user_attribute_mapper":                        resourceKeycloakLdapUserAttributeMapper(),
-			"keycloak_hardcoded_attribute_mapper":                        resourceKeycloakHardcodedAttributeMapper(),
-			"keycloak_ldap_group_mapper":                                 resourceKeycloakLdapGroupMapper(),
-			"keycloak_ldap_role_mapper":                                  resourceKeycloakLdapRoleMapper(),
-			"keycloak_ldap_hardcoded_role_mapper":                        resourceKeycloakLdapHardcodedRoleMapper(),
-			"keycloak_ldap_hardcoded_attribute_mapper":                   resourceKeycloakLdapHardcodedAttributeMapper(),
-			"keycloak_ldap_hardcoded_group_mapper":                       resourceKeycloakLdapHardcodedGroupMapper(),
-			"keycloak_ldap_msad_user_account_control_mapper":             resourceKeycloakLdapMsadUserAccountControlMapper(),
-			"keycloak_ldap_msad_lds_user_account_control_mapper":         resourceKeycloakLdapMsadLdsUserAccountControlMapper(),
-			"keycloak_ldap_full_name_mapper":                             resourceKeycloakLdapFullNameMapper(),
-			"keycloak_ldap_custom_mapper":                                resourceKeycloakLdapCustomMapper(),
-			"keycloak_custom_user_federation":                            resourceKeycloakCustomUserFederation(),
-			"keycloak_openid_user_attribute_protocol_mapper":             resourceKeycloakOpenIdUserAttributeProtocolMapper(),
-			"keycloak_openid_user_property_protocol_mapper":              resourceKeycloakOpenIdUserPropertyProtocolMapper(),
-			"keycloak_openid_group_membership_protocol_mapper":           resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
-			"keycloak_openid_full_name_protocol_mapper":                  resourceKeycloakOpenIdFullNameProtocolMapper(),
-			"keycloak_openid_hardcoded_claim_protocol_mapper":            resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
-			"keycloak_openid_audience_protocol_mapper":                   resourceKeycloakOpenIdAudienceProtocolMapper(),
-			"keycloak_openid_audience_resolve_protocol_mapper":           resourceKeycloakOpenIdAudienceResolveProtocolMapper(),
-			"keycloak_openid_hardcoded_role_protocol_mapper":             resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
-			"keycloak_openid_user_realm_role_protocol_mapper":            resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
-			"keycloak_openid_user_client_role_protocol_mapper":           resourceKeycloakOpenIdUserClientRoleProtocolMapper(),
-			"keycloak_openid_user_session_note_protocol_mapper":          resourceKeycloakOpenIdUserSessionNoteProtocolMapper(),
-			"keycloak_openid_script_protocol_mapper":                     resourceKeycloakOpenIdScriptProtocolMapper(),
-			"keycloak_openid_client_default_scopes":                      resourceKeycloakOpenidClientDefaultScopes(),
-			"keycloak_openid_client_optional_scopes":                     resourceKeycloakOpenidClientOptionalScopes(),
-			"keycloak_saml_client":                                       resourceKeycloakSamlClient(),
-			"keycloak_saml_client_scope":                                 resourceKeycloakSamlClientScope(),
-			"keycloak_saml_client_default_scopes":                        resourceKeycloakSamlClientDefaultScopes(),
-			"keycloak_generic_client_protocol_mapper":                    resourceKeycloakGenericClientProtocolMapper(),
-			"keycloak_generic_client_role_mapper":                        resourceKeycloakGenericClientRoleMapper(),
-			"keycloak_generic_protocol_mapper":                           resourceKeycloakGenericProtocolMapper(),
-			"keycloak_generic_role_mapper":                               resourceKeycloakGenericRoleMapper(),
-			"keycloak_saml_user_attribute_protocol_mapper":               resourceKeycloakSamlUserAttributeProtocolMapper(),
-			"keycloak_saml_user_property_protocol_mapper":                resourceKeycloakSamlUserPropertyProtocolMapper(),
-			"keycloak_saml_script_protocol_mapper":                       resourceKeycloakSamlScriptProtocolMapper(),
-			"keycloak_hardcoded_attribute_identity_provider_mapper":      resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
-			"keycloak_hardcoded_role_identity_provider_mapper":           resourceKeycloakHardcodedRoleIdentityProviderMapper(),
-			"keycloak_attribute_importer_identity_provider_mapper":       resourceKeycloakAttributeImporterIdentityProviderMapper(),
-			"keycloak_attribute_to_role_identity_provider_mapper":        resourceKeycloakAttributeToRoleIdentityProviderMapper(),
-			"keycloak_user_template_importer_identity_provider_mapper":   resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
-			"keycloak_custom_identity_provider_mapper":                   resourceKeycloakCustomIdentityProviderMapper(),
-			"keycloak_saml_identity_provider":                            resourceKeycloakSamlIdentityProvider(),
-			"keycloak_oidc_google_identity_provider":                     resourceKeycloakOidcGoogleIdentityProvider(),
-			"keycloak_oidc_identity_provider":                            resourceKeycloakOidcIdentityProvider(),
-			"keycloak_openid_client_authorization_resource":              resourceKeycloakOpenidClientAuthorizationResource(),
-			"keycloak_openid_client_group_policy":                        resourceKeycloakOpenidClientAuthorizationGroupPolicy(),
-			"keycloak_openid_client_role_policy":                         resourceKeycloakOpenidClientAuthorizationRolePolicy(),
-			"keycloak_openid_client_aggregate_policy":                    resourceKeycloakOpenidClientAuthorizationAggregatePolicy(),
-			"keycloak_openid_client_js_policy":                           resourceKeycloakOpenidClientAuthorizationJSPolicy(),
-			"keycloak_openid_client_time_policy":                         resourceKeycloakOpenidClientAuthorizationTimePolicy(),
-			"keycloak_openid_client_user_policy":                         resourceKeycloakOpenidClientAuthorizationUserPolicy(),
-			"keycloak_openid_client_client_policy":                       resourceKeycloakOpenidClientAuthorizationClientPolicy(),
-			"keycloak_openid_client_authorization_scope":                 resourceKeycloakOpenidClientAuthorizationScope(),
-			"keycloak_openid_client_authorization_permission":            resourceKeycloakOpenidClientAuthorizationPermission(),
-			"keycloak_openid_client_service_account_role":                resourceKeycloakOpenidClientServiceAccountRole(),
-			"keycloak_openid_client_service_account_realm_role":          resourceKeycloakOpenidClientServiceAccountRealmRole(),
-			"keycloak_role":                                              resourceKeycloakRole(),
-			"keycloak_authentication_flow":                               resourceKeycloakAuthenticationFlow(),
-			"keycloak_authentication_subflow":                            resourceKeycloakAuthenticationSubFlow(),
-			"keycloak_authentication_execution":                          resourceKeycloakAuthenticationExecution(),
-			"keycloak_authentication_execution_config":                   resourceKeycloakAuthenticationExecutionConfig(),
-			"keycloak_identity_provider_token_exchange_scope_permission": resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
-			"keycloak_openid_client_permissions":                         resourceKeycloakOpenidClientPermissions(),
-			"keycloak_users_permissions":                                 resourceKeycloakUsersPermissions(),
-			"keycloak_user_groups":                                       resourceKeycloakUserGroups(),
-			"keycloak_group_permissions":                                 resourceKeycloakGroupPermissions(),
-			"keycloak_authentication_bindings":                           resourceKeycloakAuthenticationBindings(),
+			"keycloak_realm":                                                    resourceKeycloakRealm(),
+			"keycloak_realm_client_profile":                                     resourceKeycloakRealmClientProfile(),
+			"keycloak_realm_client_policy_profile":                              resourceKeycloakRealmClientPolicyProfile(),
+			"keycloak_realm_client_policy":                                      resourceKeycloakRealmClientPolicy(),
+			"keycloak_realm_events":                                             resourceKeycloakRealmEvents(),
+			"keycloak_realm_localization":                                       resourceKeycloakRealmLocalization(),
+			"keycloak_realm_allowed_client_scopes_policy":                       resourceKeycloakRealmAllowedClientScopesPolicy(),
+			"keycloak_client_registration_policy":                               resourceKeycloakClientRegistrationPolicy(),
+			"keycloak_realm_default_client_scopes":                              resourceKeycloakRealmDefaultClientScopes(),
+			"keycloak_realm_optional_client_scopes":                             resourceKeycloakRealmOptionalClientScopes(),
+			"keycloak_realm_keystore_aes_generated":                             resourceKeycloakRealmKeystoreAesGenerated(),
+			"keycloak_realm_keystore_ecdsa_generated":                           resourceKeycloakRealmKeystoreEcdsaGenerated(),
+			"keycloak_realm_keystore_hmac_generated":                            resourceKeycloakRealmKeystoreHmacGenerated(),
+			"keycloak_realm_keystore_java_keystore":                             resourceKeycloakRealmKeystoreJavaKeystore(),
+			"keycloak_realm_keystore_rsa":                                       resourceKeycloakRealmKeystoreRsa(),
+			"keycloak_realm_keystore_rsa_enc_generated":                         resourceKeycloakRealmKeystoreRsaEncGenerated(),
+			"keycloak_realm_keystore_rsa_generated":                             resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_user_profile":                                       resourceKeycloakRealmUserProfile(),
+			"keycloak_realm_token_settings":                                     resourceKeycloakRealmTokenSettings(),
+			"keycloak_required_action":                                          resourceKeycloakRequiredAction(),
+			"keycloak_group":                                                    resourceKeycloakGroup(),
+			"keycloak_group_member":                                             resourceKeycloakGroupMember(),
+			"keycloak_group_memberships":                                        resourceKeycloakGroupMemberships(),
+			"keycloak_default_groups":                                           resourceKeycloakDefaultGroups(),
+			"keycloak_organization":                                             resourceKeycloakOrganization(),
+			"keycloak_organization_member":                                      resourceKeycloakOrganizationMember(),
+			"keycloak_organization_identity_provider":                           resourceKeycloakOrganizationIdentityProvider(),
+			"keycloak_admin_permissions_policy":                                 resourceKeycloakAdminPermissionsPolicy(),
+			"keycloak_admin_permissions_resource_permission":                    resourceKeycloakAdminPermissionsResourcePermission(),
+			"keycloak_default_roles":                                            resourceKeycloakDefaultRoles(),
+			"keycloak_client_default_roles":                                     resourceKeycloakClientDefaultRoles(),
+			"keycloak_client_initial_access_token":                              resourceKeycloakClientInitialAccessToken(),
+			"keycloak_group_roles":                                              resourceKeycloakGroupRoles(),
+			"keycloak_user":                                                     resourceKeycloakUser(),
+			"keycloak_user_roles":                                               resourceKeycloakUserRoles(),
+			"keycloak_user_consent_revocation":                                  resourceKeycloakUserConsentRevocation(),
+			"keycloak_openid_client":                                            resourceKeycloakOpenidClient(),
+			"keycloak_openid_client_scope":                                      resourceKeycloakOpenidClientScope(),
+			"keycloak_ldap_user_federation":                                     resourceKeycloakLdapUserFederation(),
+			"keycloak_ldap_user_attribute_mapper":                               resourceKeycloakLdapUserAttributeMapper(),
+			"keycloak_hardcoded_attribute_mapper":                               resourceKeycloakHardcodedAttributeMapper(),
+			"keycloak_ldap_group_mapper":                                        resourceKeycloakLdapGroupMapper(),
+			"keycloak_ldap_role_mapper":                                         resourceKeycloakLdapRoleMapper(),
+			"keycloak_ldap_hardcoded_role_mapper":                               resourceKeycloakLdapHardcodedRoleMapper(),
+			"keycloak_ldap_hardcoded_attribute_mapper":                          resourceKeycloakLdapHardcodedAttributeMapper(),
+			"keycloak_ldap_hardcoded_group_mapper":                              resourceKeycloakLdapHardcodedGroupMapper(),
+			"keycloak_ldap_msad_user_account_control_mapper":                    resourceKeycloakLdapMsadUserAccountControlMapper(),
+			"keycloak_ldap_msad_lds_user_account_control_mapper":                resourceKeycloakLdapMsadLdsUserAccountControlMapper(),
+			"keycloak_ldap_full_name_mapper":                                    resourceKeycloakLdapFullNameMapper(),
+			"keycloak_ldap_custom_mapper":                                       resourceKeycloakLdapCustomMapper(),
+			"keycloak_custom_user_federation":                                   resourceKeycloakCustomUserFederation(),
+			"keycloak_component":                                                resourceKeycloakComponent(),
+			"keycloak_openid_user_attribute_protocol_mapper":                    resourceKeycloakOpenIdUserAttributeProtocolMapper(),
+			"keycloak_openid_user_property_protocol_mapper":                     resourceKeycloakOpenIdUserPropertyProtocolMapper(),
+			"keycloak_openid_group_membership_protocol_mapper":                  resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
+			"keycloak_openid_organization_membership_protocol_mapper":           resourceKeycloakOpenIdOrganizationMembershipProtocolMapper(),
+			"keycloak_openid_address_protocol_mapper":                           resourceKeycloakOpenIdAddressProtocolMapper(),
+			"keycloak_openid_full_name_protocol_mapper":                         resourceKeycloakOpenIdFullNameProtocolMapper(),
+			"keycloak_openid_hardcoded_claim_protocol_mapper":                   resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
+			"keycloak_openid_audience_protocol_mapper":                          resourceKeycloakOpenIdAudienceProtocolMapper(),
+			"keycloak_openid_audience_resolve_protocol_mapper":                  resourceKeycloakOpenIdAudienceResolveProtocolMapper(),
+			"keycloak_openid_hardcoded_role_protocol_mapper":                    resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
+			"keycloak_openid_user_realm_role_protocol_mapper":                   resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
+			"keycloak_openid_user_client_role_protocol_mapper":                  resourceKeycloakOpenIdUserClientRoleProtocolMapper(),
+			"keycloak_openid_user_session_note_protocol_mapper":                 resourceKeycloakOpenIdUserSessionNoteProtocolMapper(),
+			"keycloak_openid_script_protocol_mapper":                            resourceKeycloakOpenIdScriptProtocolMapper(),
+			"keycloak_openid_client_default_scopes":                             resourceKeycloakOpenidClientDefaultScopes(),
+			"keycloak_openid_client_optional_scopes":                            resourceKeycloakOpenidClientOptionalScopes(),
+			"keycloak_saml_client":                                              resourceKeycloakSamlClient(),
+			"keycloak_saml_client_scope":                                        resourceKeycloakSamlClientScope(),
+			"keycloak_saml_client_default_scopes":                               resourceKeycloakSamlClientDefaultScopes(),
+			"keycloak_generic_client_protocol_mapper":                           resourceKeycloakGenericClientProtocolMapper(),
+			"keycloak_generic_client_role_mapper":                               resourceKeycloakGenericClientRoleMapper(),
+			"keycloak_generic_protocol_mapper":                                  resourceKeycloakGenericProtocolMapper(),
+			"keycloak_generic_role_mapper":                                      resourceKeycloakGenericRoleMapper(),
+			"keycloak_saml_user_attribute_protocol_mapper":                      resourceKeycloakSamlUserAttributeProtocolMapper(),
+			"keycloak_saml_user_property_protocol_mapper":                       resourceKeycloakSamlUserPropertyProtocolMapper(),
+			"keycloak_saml_script_protocol_mapper":                              resourceKeycloakSamlScriptProtocolMapper(),
+			"keycloak_hardcoded_attribute_identity_provider_mapper":             resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
+			"keycloak_hardcoded_role_identity_provider_mapper":                  resourceKeycloakHardcodedRoleIdentityProviderMapper(),
+			"keycloak_attribute_importer_identity_provider_mapper":              resourceKeycloakAttributeImporterIdentityProviderMapper(),
+			"keycloak_attribute_to_role_identity_provider_mapper":               resourceKeycloakAttributeToRoleIdentityProviderMapper(),
+			"keycloak_saml_attribute_to_role_identity_provider_mapper":          resourceKeycloakSamlAttributeToRoleIdentityProviderMapper(),
+			"keycloak_saml_advanced_attribute_to_role_identity_provider_mapper": resourceKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper(),
+			"keycloak_user_template_importer_identity_provider_mapper":          resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
+			"keycloak_oidc_username_identity_provider_mapper":                   resourceKeycloakOidcUsernameIdentityProviderMapper(),
+			"keycloak_saml_username_identity_provider_mapper":                   resourceKeycloakSamlUsernameIdentityProviderMapper(),
+			"keycloak_custom_identity_provider_mapper":                          resourceKeycloakCustomIdentityProviderMapper(),
+			"keycloak_saml_identity_provider":                                   resourceKeycloakSamlIdentityProvider(),
+			"keycloak_oidc_google_identity_provider":                            resourceKeycloakOidcGoogleIdentityProvider(),
+			"keycloak_oidc_identity_provider":                                   resourceKeycloakOidcIdentityProvider(),
+			"keycloak_openid_client_authorization_resource":                     resourceKeycloakOpenidClientAuthorizationResource(),
+			"keycloak_openid_client_group_policy":                               resourceKeycloakOpenidClientAuthorizationGroupPolicy(),
+			"keycloak_openid_client_role_policy":                                resourceKeycloakOpenidClientAuthorizationRolePolicy(),
+			"keycloak_openid_client_aggregate_policy":                           resourceKeycloakOpenidClientAuthorizationAggregatePolicy(),
+			"keycloak_openid_client_js_policy":                                  resourceKeycloakOpenidClientAuthorizationJSPolicy(),
+			"keycloak_openid_client_time_policy":                                resourceKeycloakOpenidClientAuthorizationTimePolicy(),
+			"keycloak_openid_client_user_policy":                                resourceKeycloakOpenidClientAuthorizationUserPolicy(),
+			"keycloak_openid_client_client_policy":                              resourceKeycloakOpenidClientAuthorizationClientPolicy(),
+			"keycloak_openid_client_authorization_scope":                        resourceKeycloakOpenidClientAuthorizationScope(),
+			"keycloak_openid_client_authorization_permission":                   resourceKeycloakOpenidClientAuthorizationPermission(),
+			"keycloak_openid_client_service_account_role":                       resourceKeycloakOpenidClientServiceAccountRole(),
+			"keycloak_openid_client_service_account_realm_role":                 resourceKeycloakOpenidClientServiceAccountRealmRole(),
+			"keycloak_role":                                                     resourceKeycloakRole(),
+			"keycloak_authentication_flow":                                      resourceKeycloakAuthenticationFlow(),
+			"keycloak_authentication_subflow":                                   resourceKeycloakAuthenticationSubFlow(),
+			"keycloak_authentication_flow_tree":                                 resourceKeycloakAuthenticationFlowTree(),
+			"keycloak_authentication_execution":                                 resourceKeycloakAuthenticationExecution(),
+			"keycloak_authentication_execution_config":                          resourceKeycloakAuthenticationExecutionConfig(),
+			"keycloak_identity_provider_token_exchange_scope_permission":        resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
+			"keycloak_openid_client_permissions":                                resourceKeycloakOpenidClientPermissions(),
+			"keycloak_openid_client_token_exchange":                             resourceKeycloakOpenidClientTokenExchange(),
+			"keycloak_users_permissions":                                        resourceKeycloakUsersPermissions(),
+			"keycloak_user_groups":                                              resourceKeycloakUserGroups(),
+			"keycloak_group_permissions":                                        resourceKeycloakGroupPermissions(),
+			"keycloak_authentication_bindings":                                  resourceKeycloakAuthenticationBindings(),
 		},
 		Schema: map[string]*schema.Schema{
 			"client_id": {
@@ -145,6 +176,7 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"realm": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Description: "The realm the provider authenticates against, which tokens are requested from. Resources can be managed in other realms.",
 				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_REALM", "master"),
 			},
 			"url": {
@@ -165,6 +197,12 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 				Description: "Timeout (in seconds) of the Keycloak client",
 				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_CLIENT_TIMEOUT", 15),
 			},
+			"request_timeout": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Maximum duration of a single call to Keycloak, including its retries. Set to 0 to not limit calls.",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_REQUEST_TIMEOUT", keycloak.DefaultRequestTimeout.String()),
+			},
 			"root_ca_certificate": {
 				Optional:    true,
 				Type:        schema.TypeString,
@@ -195,6 +233,27 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 					Type: schema.TypeString,
 				},
 			},
+			"http_retry_attempts": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Number of times a request is retried when Keycloak responds with 429, 502, 503 or 504, or when an idempotent request fails to connect. Set to 0 to disable retries.",
+				DefaultFunc:  schema.EnvDefaultFunc("KEYCLOAK_HTTP_RETRY_ATTEMPTS", keycloak.DefaultRetryPolicy.MaxAttempts),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"http_retry_base_delay": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				Description:  "Delay before the first retry, doubled with each further retry. Defaults to 1s.",
+				DefaultFunc:  schema.EnvDefaultFunc("KEYCLOAK_HTTP_RETRY_BASE_DELAY", keycloak.DefaultRetryPolicy.InitialBackoff.String()),
+				ValidateFunc: validatePositiveDurationString,
+			},
+			"client_rate_limit": {
+				Optional:     true,
+				Type:         schema.TypeFloat,
+				Description:  "Maximum number of requests per second sent to Keycloak, shared by all resources. Set to 0 to not limit requests.",
+				DefaultFunc:  schema.EnvDefaultFunc("KEYCLOAK_CLIENT_RATE_LIMIT", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
 		},
 	}
 
@@ -222,9 +281,25 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 
 		var diags diag.Diagnostics
 
+		retryPolicy := keycloak.DefaultRetryPolicy
+		retryPolicy.MaxAttempts = data.Get("http_retry_attempts").(int)
+		retryBaseDelay, err := time.ParseDuration(data.Get("http_retry_base_delay").(string))
+		if err != nil {
+			return nil, diag.Errorf("invalid http_retry_base_delay: %s", err)
+		}
+		retryPolicy.InitialBackoff = retryBaseDelay
+		rateLimit := data.Get("client_rate_limit").(float64)
+		requestTimeout, err := time.ParseDuration(data.Get("request_timeout").(string))
+		if err != nil {
+			return nil, diag.Errorf("invalid request_timeout: %s", err)
+		}
+		if requestTimeout < 0 {
+			return nil, diag.Errorf("invalid request_timeout: %s can't be negative", requestTimeout)
+		}
+
 		userAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", provider.TerraformVersion, meta.SDKVersionString())
 
-		keycloakClient, err := keycloak.NewKeycloakClient(ctx, url, basePath, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout, rootCaCertificate, tlsInsecureSkipVerify, userAgent, redHatSSO, additionalHeaders)
+		keycloakClient, err := keycloak.NewKeycloakClient(ctx, url, basePath, clientId, clientSecret, realm, username, password, initialLogin, clientTimeout, rootCaCertificate, tlsInsecureSkipVerify, userAgent, redHatSSO, additionalHeaders, retryPolicy, rateLimit, requestTimeout)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -58,7 +58,7 @@ func init() {
 
 	keycloakClient, err = keycloak.NewKeycloakClient(testCtx, os.Getenv("KEYCLOAK_URL"), "", os.Getenv("KEYCLOAK_CLIENT_ID"), os.Getenv("KEYCLOAK_CLIENT_SECRET"), os.Getenv("KEYCLOAK_REALM"), "", "", true, 5, "", false, userAgent, false, map[string]string{
 		"foo": "bar",
-	})
+	}, keycloak.DefaultRetryPolicy, 0, keycloak.DefaultRequestTimeout)
 	if err != nil {
 		panic(err)
 	}
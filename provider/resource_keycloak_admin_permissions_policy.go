@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloakAdminPermissionsPolicyTypes maps the types of admin permission policies to the argument listing their members
+var keycloakAdminPermissionsPolicyTypes = map[string]string{
+	"user":   "users",
+	"client": "clients",
+	"group":  "groups",
+	"role":   "roles",
+}
+
+func resourceKeycloakAdminPermissionsPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAdminPermissionsPolicyCreate,
+		ReadContext:   resourceKeycloakAdminPermissionsPolicyRead,
+		DeleteContext: resourceKeycloakAdminPermissionsPolicyDelete,
+		UpdateContext: resourceKeycloakAdminPermissionsPolicyUpdate,
+		// This resource can be imported using {{realm}}/{{policy_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAdminPermissionsPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the admin-permissions client holding the policy.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"user", "client", "group", "role"}, false),
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UNANIMOUS",
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+			},
+			"logic": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POSITIVE",
+				ValidateFunc: validation.StringInSlice(keycloakPolicyLogicTypes, false),
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"clients": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+		},
+		CustomizeDiff: validateAdminPermissionsPolicyMembers,
+	}
+}
+
+// validateAdminPermissionsPolicyMembers makes sure only the members matching the type of the policy are given, Keycloak
+// silently drops the others.
+func validateAdminPermissionsPolicyMembers(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	policyType := d.Get("type").(string)
+
+	for memberType, attribute := range keycloakAdminPermissionsPolicyTypes {
+		count := d.Get(attribute).(*schema.Set).Len()
+
+		if memberType == policyType && count == 0 {
+			return fmt.Errorf("validation error: %s is required for policies of type %s", attribute, policyType)
+		}
+		if memberType != policyType && count != 0 {
+			return fmt.Errorf("validation error: %s can't be set for policies of type %s", attribute, policyType)
+		}
+	}
+
+	return nil
+}
+
+func mapFromDataToAdminPermissionsPolicy(data *schema.ResourceData) *keycloak.AdminPermissionsPolicy {
+	policy := &keycloak.AdminPermissionsPolicy{
+		Id:               data.Id(),
+		RealmId:          data.Get("realm_id").(string),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		Name:             data.Get("name").(string),
+		Description:      data.Get("description").(string),
+		Type:             data.Get("type").(string),
+		DecisionStrategy: data.Get("decision_strategy").(string),
+		Logic:            data.Get("logic").(string),
+		Users:            interfaceSliceToStringSlice(data.Get("users").(*schema.Set).List()),
+		Clients:          interfaceSliceToStringSlice(data.Get("clients").(*schema.Set).List()),
+	}
+
+	for _, group := range data.Get("groups").(*schema.Set).List() {
+		policy.Groups = append(policy.Groups, keycloak.OpenidClientAuthorizationGroup{Id: group.(string)})
+	}
+
+	for _, role := range data.Get("roles").(*schema.Set).List() {
+		policy.Roles = append(policy.Roles, keycloak.OpenidClientAuthorizationRole{Id: role.(string)})
+	}
+
+	return policy
+}
+
+func mapFromAdminPermissionsPolicyToData(data *schema.ResourceData, policy *keycloak.AdminPermissionsPolicy) {
+	var groups []string
+	for _, group := range policy.Groups {
+		groups = append(groups, group.Id)
+	}
+
+	var roles []string
+	for _, role := range policy.Roles {
+		roles = append(roles, role.Id)
+	}
+
+	data.SetId(policy.Id)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("resource_server_id", policy.ResourceServerId)
+	data.Set("name", policy.Name)
+	data.Set("description", policy.Description)
+	data.Set("type", policy.Type)
+	data.Set("decision_strategy", policy.DecisionStrategy)
+	data.Set("logic", policy.Logic)
+	data.Set("users", policy.Users)
+	data.Set("clients", policy.Clients)
+	data.Set("groups", groups)
+	data.Set("roles", roles)
+}
+
+func resourceKeycloakAdminPermissionsPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := mapFromDataToAdminPermissionsPolicy(data)
+
+	resourceServerId, err := keycloakClient.GetAdminPermissionsResourceServerId(ctx, policy.RealmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policy.ResourceServerId = resourceServerId
+
+	err = keycloakClient.NewAdminPermissionsPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromAdminPermissionsPolicyToData(data, policy)
+
+	return resourceKeycloakAdminPermissionsPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionsPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	policy, err := keycloakClient.GetAdminPermissionsPolicy(ctx, realmId, resourceServerId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromAdminPermissionsPolicyToData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakAdminPermissionsPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := mapFromDataToAdminPermissionsPolicy(data)
+
+	err := keycloakClient.UpdateAdminPermissionsPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAdminPermissionsPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionsPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	err := keycloakClient.DeleteAdminPermissionsPolicy(ctx, realmId, resourceServerId, data.Id())
+	// policies are removed along with the admin-permissions client when admin permissions are disabled
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakAdminPermissionsPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{policyId}}")
+	}
+
+	resourceServerId, err := keycloakClient.GetAdminPermissionsResourceServerId(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("resource_server_id", resourceServerId)
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakAdminPermissionsPolicyRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("no admin permissions policy with id %s found in realm %s", parts[1], parts[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdminPermissionsPolicy_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_2); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminPermissionsPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminPermissionsPolicy_user(realmName, policyName, "POSITIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionsPolicyExists("keycloak_admin_permissions_policy.policy"),
+					resource.TestCheckResourceAttrSet("keycloak_admin_permissions_policy.policy", "resource_server_id"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_policy.policy", "users.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_policy.policy", "logic", "POSITIVE"),
+				),
+			},
+			{
+				Config: testKeycloakAdminPermissionsPolicy_user(realmName, policyName, "NEGATIVE"),
+				Check:  resource.TestCheckResourceAttr("keycloak_admin_permissions_policy.policy", "logic", "NEGATIVE"),
+			},
+			{
+				ResourceName:        "keycloak_admin_permissions_policy.policy",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+			{
+				Config: testKeycloakAdminPermissionsPolicy_group(realmName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionsPolicyExists("keycloak_admin_permissions_policy.policy"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_policy.policy", "type", "group"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_policy.policy", "groups.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_policy.policy", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdminPermissionsPolicy_membersValidation(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAdminPermissionsPolicy_mismatchedMembers(realmName),
+				ExpectError: regexp.MustCompile("groups can't be set for policies of type user"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdminPermissionsPolicy_adminPermissionsDisabled(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_2); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminPermissionsPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAdminPermissionsPolicy_adminPermissionsDisabled(policyName),
+				ExpectError: regexp.MustCompile("admin permissions are not enabled in realm"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAdminPermissionsPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getAdminPermissionsPolicyFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakAdminPermissionsPolicyDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_admin_permissions_policy" {
+				continue
+			}
+
+			policy, _ := keycloakClient.GetAdminPermissionsPolicy(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID)
+			if policy != nil {
+				return fmt.Errorf("admin permissions policy %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getAdminPermissionsPolicyFromState(s *terraform.State, resourceName string) (*keycloak.AdminPermissionsPolicy, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	policy, err := keycloakClient.GetAdminPermissionsPolicy(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting admin permissions policy %s: %s", rs.Primary.ID, err)
+	}
+
+	return policy, nil
+}
+
+func testKeycloakAdminPermissionsPolicy_user(realm, policy, logic string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+resource "keycloak_user" "admin" {
+	realm_id = keycloak_realm.realm.id
+	username = "helpdesk"
+}
+
+resource "keycloak_admin_permissions_policy" "policy" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+	type     = "user"
+	logic    = "%s"
+	users    = [keycloak_user.admin.id]
+}
+	`, realm, policy, logic)
+}
+
+func testKeycloakAdminPermissionsPolicy_group(realm, policy string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+resource "keycloak_group" "admins" {
+	realm_id = keycloak_realm.realm.id
+	name     = "helpdesk"
+}
+
+resource "keycloak_admin_permissions_policy" "policy" {
+	realm_id    = keycloak_realm.realm.id
+	name        = "%s"
+	description = "members of the helpdesk group"
+	type        = "group"
+	groups      = [keycloak_group.admins.id]
+}
+	`, realm, policy)
+}
+
+func testKeycloakAdminPermissionsPolicy_mismatchedMembers(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_admin_permissions_policy" "policy" {
+	realm_id = "%s"
+	name     = "mismatched"
+	type     = "user"
+	users    = ["user-id"]
+	groups   = ["group-id"]
+}
+	`, realm)
+}
+
+func testKeycloakAdminPermissionsPolicy_adminPermissionsDisabled(policy string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client" "admin_cli" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "admin-cli"
+}
+
+resource "keycloak_admin_permissions_policy" "policy" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+	type     = "client"
+	clients  = [data.keycloak_openid_client.admin_cli.id]
+}
+	`, testAccRealm.Realm, policy)
+}
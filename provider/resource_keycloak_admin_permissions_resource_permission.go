@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloakAdminPermissionsResourceTypeScopes lists the scopes Keycloak defines for each resource type of admin permissions
+var keycloakAdminPermissionsResourceTypeScopes = map[string][]string{
+	"Users":   {"view", "manage", "impersonate", "map-roles", "manage-group-membership"},
+	"Groups":  {"view", "manage", "view-members", "manage-members", "manage-membership", "impersonate-members"},
+	"Clients": {"view", "manage", "map-roles", "map-roles-client-scope", "map-roles-composite"},
+	"Roles":   {"map-role", "map-role-client-scope", "map-role-composite"},
+}
+
+func resourceKeycloakAdminPermissionsResourcePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAdminPermissionsResourcePermissionCreate,
+		ReadContext:   resourceKeycloakAdminPermissionsResourcePermissionRead,
+		DeleteContext: resourceKeycloakAdminPermissionsResourcePermissionDelete,
+		UpdateContext: resourceKeycloakAdminPermissionsResourcePermissionUpdate,
+		// This resource can be imported using {{realm}}/{{permission_id}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAdminPermissionsResourcePermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the admin-permissions client holding the permission.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Users", "Groups", "Clients", "Roles"}, false),
+			},
+			"resources": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The ids of the users, groups, clients or roles the permission applies to. The permission applies to all of them when empty.",
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MinItems: 1,
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MinItems: 1,
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UNANIMOUS",
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+			},
+		},
+		CustomizeDiff: validateAdminPermissionsResourcePermissionScopes,
+	}
+}
+
+// validateAdminPermissionsResourcePermissionScopes catches scopes which don't exist for the resource type, Keycloak only
+// rejects them once the permission is created.
+func validateAdminPermissionsResourcePermissionScopes(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	resourceType := d.Get("resource_type").(string)
+	scopes := keycloakAdminPermissionsResourceTypeScopes[resourceType]
+
+	for _, scope := range d.Get("scopes").(*schema.Set).List() {
+		if scope.(string) != "" && !stringSliceContains(scopes, scope.(string)) {
+			return fmt.Errorf("validation error: scope %s doesn't exist for resource type %s, expected one of %s", scope, resourceType, strings.Join(scopes, ", "))
+		}
+	}
+
+	return nil
+}
+
+func mapFromDataToAdminPermissionsResourcePermission(data *schema.ResourceData) *keycloak.AdminPermissionsResourcePermission {
+	return &keycloak.AdminPermissionsResourcePermission{
+		Id:               data.Id(),
+		RealmId:          data.Get("realm_id").(string),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		Name:             data.Get("name").(string),
+		Description:      data.Get("description").(string),
+		DecisionStrategy: data.Get("decision_strategy").(string),
+		ResourceType:     data.Get("resource_type").(string),
+		Resources:        append([]string{}, interfaceSliceToStringSlice(data.Get("resources").(*schema.Set).List())...),
+		Scopes:           interfaceSliceToStringSlice(data.Get("scopes").(*schema.Set).List()),
+		Policies:         interfaceSliceToStringSlice(data.Get("policies").(*schema.Set).List()),
+	}
+}
+
+func mapFromAdminPermissionsResourcePermissionToData(data *schema.ResourceData, permission *keycloak.AdminPermissionsResourcePermission) {
+	data.SetId(permission.Id)
+	data.Set("realm_id", permission.RealmId)
+	data.Set("resource_server_id", permission.ResourceServerId)
+	data.Set("name", permission.Name)
+	data.Set("description", permission.Description)
+	data.Set("decision_strategy", permission.DecisionStrategy)
+	data.Set("resource_type", permission.ResourceType)
+	data.Set("resources", permission.Resources)
+	data.Set("scopes", permission.Scopes)
+	data.Set("policies", permission.Policies)
+}
+
+func resourceKeycloakAdminPermissionsResourcePermissionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := mapFromDataToAdminPermissionsResourcePermission(data)
+
+	resourceServerId, err := keycloakClient.GetAdminPermissionsResourceServerId(ctx, permission.RealmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	permission.ResourceServerId = resourceServerId
+
+	err = keycloakClient.NewAdminPermissionsResourcePermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromAdminPermissionsResourcePermissionToData(data, permission)
+
+	return resourceKeycloakAdminPermissionsResourcePermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionsResourcePermissionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	permission, err := keycloakClient.GetAdminPermissionsResourcePermission(ctx, realmId, resourceServerId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromAdminPermissionsResourcePermissionToData(data, permission)
+
+	return nil
+}
+
+func resourceKeycloakAdminPermissionsResourcePermissionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := mapFromDataToAdminPermissionsResourcePermission(data)
+
+	err := keycloakClient.UpdateAdminPermissionsResourcePermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAdminPermissionsResourcePermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionsResourcePermissionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	err := keycloakClient.DeleteAdminPermissionsResourcePermission(ctx, realmId, resourceServerId, data.Id())
+	// permissions are removed along with the admin-permissions client when admin permissions are disabled
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakAdminPermissionsResourcePermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{permissionId}}")
+	}
+
+	resourceServerId, err := keycloakClient.GetAdminPermissionsResourceServerId(ctx, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("resource_server_id", resourceServerId)
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakAdminPermissionsResourcePermissionRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("no admin permissions resource permission with id %s found in realm %s", parts[1], parts[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdminPermissionsResourcePermission_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_2); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	permissionName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminPermissionsResourcePermissionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminPermissionsResourcePermission_specificUsers(realmName, permissionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionsResourcePermissionExists("keycloak_admin_permissions_resource_permission.permission"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_resource_permission.permission", "resources.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("keycloak_admin_permissions_resource_permission.permission", "resources.*", "keycloak_user.managed", "id"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_resource_permission.permission", "scopes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("keycloak_admin_permissions_resource_permission.permission", "scopes.*", "manage"),
+				),
+			},
+			{
+				Config: testKeycloakAdminPermissionsResourcePermission_allUsers(realmName, permissionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionsResourcePermissionExists("keycloak_admin_permissions_resource_permission.permission"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_resource_permission.permission", "resources.#", "0"),
+					resource.TestCheckResourceAttr("keycloak_admin_permissions_resource_permission.permission", "scopes.#", "1"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_admin_permissions_resource_permission.permission",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAdminPermissionsResourcePermission_scopeValidation(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAdminPermissionsResourcePermission_unknownScope(realmName),
+				ExpectError: regexp.MustCompile("scope map-role doesn't exist for resource type Users"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAdminPermissionsResourcePermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetAdminPermissionsResourcePermission(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting admin permissions resource permission %s: %s", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAdminPermissionsResourcePermissionDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_admin_permissions_resource_permission" {
+				continue
+			}
+
+			permission, _ := keycloakClient.GetAdminPermissionsResourcePermission(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID)
+			if permission != nil {
+				return fmt.Errorf("admin permissions resource permission %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAdminPermissionsResourcePermission_policy(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+resource "keycloak_user" "admin" {
+	realm_id = keycloak_realm.realm.id
+	username = "helpdesk"
+}
+
+resource "keycloak_user" "managed" {
+	realm_id = keycloak_realm.realm.id
+	username = "customer"
+}
+
+resource "keycloak_admin_permissions_policy" "helpdesk" {
+	realm_id = keycloak_realm.realm.id
+	name     = "helpdesk"
+	type     = "user"
+	users    = [keycloak_user.admin.id]
+}
+	`, realm)
+}
+
+func testKeycloakAdminPermissionsResourcePermission_specificUsers(realm, permission string) string {
+	return testKeycloakAdminPermissionsResourcePermission_policy(realm) + fmt.Sprintf(`
+resource "keycloak_admin_permissions_resource_permission" "permission" {
+	realm_id      = keycloak_realm.realm.id
+	name          = "%s"
+	resource_type = "Users"
+	resources     = [keycloak_user.managed.id]
+	scopes        = ["view", "manage"]
+	policies      = [keycloak_admin_permissions_policy.helpdesk.id]
+}
+	`, permission)
+}
+
+func testKeycloakAdminPermissionsResourcePermission_allUsers(realm, permission string) string {
+	return testKeycloakAdminPermissionsResourcePermission_policy(realm) + fmt.Sprintf(`
+resource "keycloak_admin_permissions_resource_permission" "permission" {
+	realm_id      = keycloak_realm.realm.id
+	name          = "%s"
+	description   = "view all users"
+	resource_type = "Users"
+	scopes        = ["view"]
+	policies      = [keycloak_admin_permissions_policy.helpdesk.id]
+}
+	`, permission)
+}
+
+func testKeycloakAdminPermissionsResourcePermission_unknownScope(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_admin_permissions_resource_permission" "permission" {
+	realm_id      = "%s"
+	name          = "unknown-scope"
+	resource_type = "Users"
+	scopes        = ["map-role"]
+	policies      = ["policy-id"]
+}
+	`, realm)
+}
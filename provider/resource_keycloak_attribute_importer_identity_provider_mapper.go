@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
@@ -30,7 +31,13 @@ func resourceKeycloakAttributeImporterIdentityProviderMapper() *schema.Resource
 		"claim_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "Claim Name",
+			Description: "Claim Name. For OIDC based providers, nested claims can be selected with a dot separated path, for example address.country.",
+		},
+		"sync_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(keycloakIdentityProviderMapperSyncModes, false),
+			Description:  "Sync mode for the mapper.",
 		},
 	}
 	genericMapperResource := resourceKeycloakIdentityProviderMapper()
@@ -53,6 +60,16 @@ func getAttributeImporterIdentityProviderMapperFromData(ctx context.Context, dat
 	rec.IdentityProviderMapper = fmt.Sprintf("%s-user-attribute-idp-mapper", identityProvider.ProviderId)
 	rec.Config.UserAttribute = data.Get("user_attribute").(string)
 
+	// syncMode used to be configured through extra_config, which keeps working when sync_mode isn't set. A syncMode removed
+	// from extra_config is sent as an empty string, which doesn't conflict with sync_mode.
+	if syncMode, ok := data.GetOk("sync_mode"); ok {
+		if extraConfigSyncMode, ok := rec.Config.ExtraConfig["syncMode"]; ok && extraConfigSyncMode != "" {
+			return nil, fmt.Errorf(`provider.keycloak: keycloak_attribute_importer_identity_provider_mapper: %s: "sync_mode" and extra_config "syncMode" can't be set at the same time`, data.Get("name").(string))
+		}
+
+		rec.Config.ExtraConfig["syncMode"] = syncMode.(string)
+	}
+
 	if identityProvider.ProviderId == "saml" {
 		if attr, ok := data.GetOk("attribute_friendly_name"); ok {
 			rec.Config.AttributeFriendlyName = attr.(string)
@@ -61,7 +78,10 @@ func getAttributeImporterIdentityProviderMapperFromData(ctx context.Context, dat
 		} else {
 			return nil, fmt.Errorf(`provider.keycloak: keycloak_attribute_importer_identity_provider_mapper: %s: either "attribute_name" or "attribute_friendly_name" should be set for %s identity provider`, data.Get("name").(string), identityProvider.ProviderId)
 		}
-	} else if identityProvider.ProviderId == "oidc" {
+	} else if identityProvider.ProviderId == "oidc" || identityProvider.ProviderId == "keycloak-oidc" {
+		// keycloak-oidc identity providers share the mapper of oidc identity providers
+		rec.IdentityProviderMapper = "oidc-user-attribute-idp-mapper"
+
 		if _, ok := data.GetOk("claim_name"); !ok {
 			return nil, fmt.Errorf(`provider.keycloak: keycloak_attribute_importer_identity_provider_mapper: %s: "claim_name": should be set for %s identity provider`, data.Get("name").(string), identityProvider.ProviderId)
 		}
@@ -91,5 +111,11 @@ func setAttributeImporterIdentityProviderMapperData(data *schema.ResourceData, i
 	data.Set("attribute_friendly_name", identityProviderMapper.Config.AttributeFriendlyName)
 	data.Set("claim_name", claimName)
 
+	if _, ok := data.GetOk("sync_mode"); ok {
+		if syncMode, ok := identityProviderMapper.Config.ExtraConfig["syncMode"].(string); ok {
+			data.Set("sync_mode", syncMode)
+		}
+	}
+
 	return nil
 }
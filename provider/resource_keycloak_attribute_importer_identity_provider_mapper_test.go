@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -51,6 +52,70 @@ func TestAccKeycloakAttributeImporterIdentityProviderMapper_withExtraConfig(t *t
 	})
 }
 
+func TestAccKeycloakAttributeImporterIdentityProviderMapper_nestedClaimWithSyncMode(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	userAttribute := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_attribute_importer_identity_provider_mapper.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAttributeImporterIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAttributeImporterIdentityProviderMapper_withSyncMode(alias, mapperName, userAttribute, "identity.address.country", "IMPORT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAttributeImporterIdentityProviderMapperExists(resourceName),
+					testAccCheckKeycloakAttributeImporterIdentityProviderMapperSyncMode(resourceName, "IMPORT"),
+					resource.TestCheckResourceAttr(resourceName, "claim_name", "identity.address.country"),
+				),
+			},
+			{
+				Config: testKeycloakAttributeImporterIdentityProviderMapper_withSyncMode(alias, mapperName, userAttribute, "identity.address.country", "FORCE"),
+				Check:  testAccCheckKeycloakAttributeImporterIdentityProviderMapperSyncMode(resourceName, "FORCE"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAttributeImporterIdentityProviderMapper_syncModeInExtraConfig(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	userAttribute := acctest.RandomWithPrefix("tf-acc")
+	claimName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_attribute_importer_identity_provider_mapper.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAttributeImporterIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAttributeImporterIdentityProviderMapper_withExtraConfig(alias, mapperName, userAttribute, claimName, "IMPORT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAttributeImporterIdentityProviderMapperSyncMode(resourceName, "IMPORT"),
+					resource.TestCheckResourceAttr(resourceName, "extra_config.syncMode", "IMPORT"),
+					resource.TestCheckNoResourceAttr(resourceName, "sync_mode"),
+				),
+			},
+			{
+				Config: testKeycloakAttributeImporterIdentityProviderMapper_withSyncMode(alias, mapperName, userAttribute, claimName, "FORCE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAttributeImporterIdentityProviderMapperSyncMode(resourceName, "FORCE"),
+					resource.TestCheckResourceAttr(resourceName, "sync_mode", "FORCE"),
+				),
+			},
+			{
+				Config:      testKeycloakAttributeImporterIdentityProviderMapper_withSyncModeAndExtraConfig(alias, mapperName, userAttribute, claimName, "FORCE"),
+				ExpectError: regexp.MustCompile(`"sync_mode" and extra_config "syncMode" can't be set at the same time`),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakAttributeImporterIdentityProviderMapper_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 	var mapper = &keycloak.IdentityProviderMapper{}
@@ -183,6 +248,21 @@ func testAccCheckKeycloakAttributeImporterIdentityProviderMapperFetch(resourceNa
 	}
 }
 
+func testAccCheckKeycloakAttributeImporterIdentityProviderMapperSyncMode(resourceName, syncMode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mapper, err := getKeycloakAttributeImporterIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if mapper.Config.ExtraConfig["syncMode"] != syncMode {
+			return fmt.Errorf("expected mapper to have sync mode %s, got %v", syncMode, mapper.Config.ExtraConfig["syncMode"])
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakAttributeImporterIdentityProviderMapperDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -275,6 +355,61 @@ resource keycloak_attribute_importer_identity_provider_mapper oidc {
 	`, testAccRealm.Realm, alias, name, userAttribute, claimName, syncMode)
 }
 
+func testKeycloakAttributeImporterIdentityProviderMapper_withSyncMode(alias, name, userAttribute, claimName, syncMode string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource keycloak_attribute_importer_identity_provider_mapper oidc {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	user_attribute          = "%s"
+	claim_name              = "%s"
+	sync_mode               = "%s"
+}
+	`, testAccRealm.Realm, alias, name, userAttribute, claimName, syncMode)
+}
+
+func testKeycloakAttributeImporterIdentityProviderMapper_withSyncModeAndExtraConfig(alias, name, userAttribute, claimName, syncMode string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource keycloak_attribute_importer_identity_provider_mapper oidc {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	user_attribute          = "%s"
+	claim_name              = "%s"
+	sync_mode               = "%s"
+	extra_config            = {
+		syncMode = "%s"
+	}
+}
+	`, testAccRealm.Realm, alias, name, userAttribute, claimName, syncMode, syncMode)
+}
+
 func testKeycloakAttributeImporterIdentityProviderMapper_basicFromInterface(mapper *keycloak.IdentityProviderMapper) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -40,14 +40,31 @@ func resourceKeycloakAuthenticationExecution() *schema.Resource {
 			"requirement": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"REQUIRED", "ALTERNATIVE", "OPTIONAL", "CONDITIONAL", "DISABLED"}, false), //OPTIONAL is removed from 8.0.0 onwards
+				ValidateFunc: validation.StringInSlice(keycloakAuthenticationRequirements, false),
 				Default:      "DISABLED",
 			},
 			"priority": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
+			},
+			"config_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the config attached to this execution, managed with keycloak_authentication_execution_config.",
+			},
+			"config_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:      schema.TypeMap,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Computed:  true,
+				Sensitive: true,
 			},
 		},
+		CustomizeDiff: validateAuthenticationExecutionRequirement,
 	}
 }
 
@@ -103,12 +120,37 @@ func mapFromAuthenticationExecutionInfoToData(ctx context.Context, keycloakClien
 	return nil
 }
 
+// isBuiltInAuthenticationFlow reports whether the given top level flow is one of the flows Keycloak creates for every realm.
+// Subflows aren't listed by Keycloak, so they are never reported as built-in.
+func isBuiltInAuthenticationFlow(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, alias string) (bool, error) {
+	authenticationFlows, err := keycloakClient.ListAuthenticationFlows(ctx, realmId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, authenticationFlow := range authenticationFlows {
+		if authenticationFlow.Alias == alias {
+			return authenticationFlow.BuiltIn, nil
+		}
+	}
+
+	return false, nil
+}
+
 func resourceKeycloakAuthenticationExecutionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	authenticationExecution := mapFromDataToAuthenticationExecution(data)
 
-	err := keycloakClient.NewAuthenticationExecution(ctx, authenticationExecution)
+	builtIn, err := isBuiltInAuthenticationFlow(ctx, keycloakClient, authenticationExecution.RealmId, authenticationExecution.ParentFlowAlias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if builtIn {
+		return diag.Errorf("validation error: Keycloak doesn't allow adding executions to the built-in flow %s, copy it with keycloak_authentication_flow and bind the copy with keycloak_authentication_bindings instead", authenticationExecution.ParentFlowAlias)
+	}
+
+	err = keycloakClient.NewAuthenticationExecution(ctx, authenticationExecution)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -118,7 +160,14 @@ func resourceKeycloakAuthenticationExecutionCreate(ctx context.Context, data *sc
 		return diag.FromErr(err)
 	}
 
-	return resourceKeycloakAuthenticationExecutionRead(ctx, data, meta)
+	diags := resourceKeycloakAuthenticationExecutionRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, getAuthenticationRequirementWarnings(ctx, keycloakClient, authenticationExecution.RealmId, authenticationExecution.ParentFlowAlias)...)
+
+	return append(diags, getAuthenticationPriorityWarnings(ctx, keycloakClient, authenticationExecution.ParentFlowAlias, authenticationExecution.Priority)...)
 }
 
 func resourceKeycloakAuthenticationExecutionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -138,7 +187,12 @@ func resourceKeycloakAuthenticationExecutionRead(ctx context.Context, data *sche
 		return diag.FromErr(err)
 	}
 
-	return nil
+	err = setAttachedAuthenticationExecutionConfigData(ctx, keycloakClient, data, realmId, authenticationExecution.Id, authenticationExecution.AuthenticationConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return getAuthenticationSharedPriorityWarnings(ctx, keycloakClient, realmId, parentFlowAlias, authenticationExecution.Id, authenticationExecution.Priority)
 }
 
 func resourceKeycloakAuthenticationExecutionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -151,20 +205,39 @@ func resourceKeycloakAuthenticationExecutionUpdate(ctx context.Context, data *sc
 		return diag.FromErr(err)
 	}
 
-	err = mapFromAuthenticationExecutionToData(ctx, keycloakClient, data, authenticationExecution)
-	if err != nil {
-		return diag.FromErr(err)
+	diags := resourceKeycloakAuthenticationExecutionRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
 	}
 
-	return nil
+	diags = append(diags, getAuthenticationRequirementWarnings(ctx, keycloakClient, authenticationExecution.RealmId, authenticationExecution.ParentFlowAlias)...)
+
+	return append(diags, getAuthenticationPriorityWarnings(ctx, keycloakClient, authenticationExecution.ParentFlowAlias, authenticationExecution.Priority)...)
 }
 
 func resourceKeycloakAuthenticationExecutionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
+	parentFlowAlias := data.Get("parent_flow_alias").(string)
 	id := data.Id()
 
+	// executions of built-in flows can only be managed after importing them, and Keycloak refuses to remove them again.
+	// There is no API to reset a built-in flow either, so the execution is left as it is.
+	builtIn, err := isBuiltInAuthenticationFlow(ctx, keycloakClient, realmId, parentFlowAlias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if builtIn {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("execution %s of the built-in flow %s was not removed", data.Get("authenticator").(string), parentFlowAlias),
+				Detail:   fmt.Sprintf("Keycloak doesn't allow removing executions from built-in flows, and can't reset them to their defaults. The execution keeps its requirement %s, change it back from the admin console if the built-in flow is still used.", data.Get("requirement").(string)),
+			},
+		}
+	}
+
 	return diag.FromErr(keycloakClient.DeleteAuthenticationExecution(ctx, realmId, id))
 }
 
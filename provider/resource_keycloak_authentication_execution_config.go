@@ -37,40 +37,139 @@ func resourceKeycloakAuthenticationExecutionConfig() *schema.Resource {
 				ForceNew: true,
 			},
 			"config": {
-				Type:     schema.TypeMap,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Required: true,
+				Type:         schema.TypeMap,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				AtLeastOneOf: []string{"config", "sensitive_config"},
+			},
+			"sensitive_config": {
+				Type:         schema.TypeMap,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				Sensitive:    true,
+				AtLeastOneOf: []string{"config", "sensitive_config"},
+				Description:  "Config keys whose values are secrets, such as the secret of the registration-recaptcha-action authenticator.",
 			},
 		},
 	}
 }
 
-func getAuthenticationExecutionConfigFromData(data *schema.ResourceData) *keycloak.AuthenticationExecutionConfig {
+func getAuthenticationExecutionConfigFromData(data *schema.ResourceData) (*keycloak.AuthenticationExecutionConfig, error) {
 	config := make(map[string]string)
 	for key, value := range data.Get("config").(map[string]interface{}) {
 		config[key] = value.(string)
 	}
+	for key, value := range data.Get("sensitive_config").(map[string]interface{}) {
+		if _, ok := config[key]; ok {
+			return nil, fmt.Errorf("validation error: config key %s can't be set in both config and sensitive_config", key)
+		}
+		config[key] = value.(string)
+	}
+
 	return &keycloak.AuthenticationExecutionConfig{
 		Id:          data.Id(),
 		RealmId:     data.Get("realm_id").(string),
 		ExecutionId: data.Get("execution_id").(string),
 		Alias:       data.Get("alias").(string),
 		Config:      config,
-	}
+	}, nil
 }
 
+// setAuthenticationExecutionConfigData keeps the keys of sensitive_config out of config, Keycloak stores both in the same map.
 func setAuthenticationExecutionConfigData(data *schema.ResourceData, config *keycloak.AuthenticationExecutionConfig) {
+	sensitiveKeys := data.Get("sensitive_config").(map[string]interface{})
+
+	publicConfig := make(map[string]string)
+	sensitiveConfig := make(map[string]string)
+	for key, value := range config.Config {
+		if _, ok := sensitiveKeys[key]; ok {
+			sensitiveConfig[key] = value
+		} else {
+			publicConfig[key] = value
+		}
+	}
+
 	data.SetId(config.Id)
 	data.Set("realm_id", config.RealmId)
 	data.Set("execution_id", config.ExecutionId)
 	data.Set("alias", config.Alias)
+	data.Set("config", publicConfig)
+	data.Set("sensitive_config", sensitiveConfig)
+}
+
+// setAttachedAuthenticationExecutionConfigData reads the config attached to an execution or subflow, so it's known after
+// importing a flow even when the config itself isn't managed by this provider.
+func setAttachedAuthenticationExecutionConfigData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, realmId, executionId, configId string) error {
+	if configId == "" {
+		data.Set("config_id", "")
+		data.Set("config_alias", "")
+		data.Set("config", nil)
+
+		return nil
+	}
+
+	config := &keycloak.AuthenticationExecutionConfig{
+		RealmId:     realmId,
+		ExecutionId: executionId,
+		Id:          configId,
+	}
+
+	err := keycloakClient.GetAuthenticationExecutionConfig(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	data.Set("config_id", config.Id)
+	data.Set("config_alias", config.Alias)
 	data.Set("config", config.Config)
+
+	return nil
+}
+
+// getAuthenticationExecutionConfigWarnings warns about config keys the authenticator of the execution doesn't declare.
+// Keycloak stores any key, but silently ignores the ones its authenticator doesn't know about.
+func getAuthenticationExecutionConfigWarnings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, config *keycloak.AuthenticationExecutionConfig) diag.Diagnostics {
+	execution, err := keycloakClient.GetAuthenticationExecution(ctx, config.RealmId, "", config.ExecutionId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	description, err := keycloakClient.GetAuthenticatorConfigDescription(ctx, config.RealmId, execution.Authenticator)
+	if err != nil {
+		// custom authenticators don't necessarily describe their config
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	properties := make(map[string]bool)
+	for _, property := range description.Properties {
+		properties[property.Name] = true
+	}
+
+	var diags diag.Diagnostics
+	for key := range config.Config {
+		if !properties[key] {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("config key %s isn't supported by the %s authenticator", key, execution.Authenticator),
+				Detail:   "Keycloak ignores config keys its authenticators don't know about. Check the key against the config of the execution in the admin console.",
+			})
+		}
+	}
+
+	return diags
 }
 
 func resourceKeycloakAuthenticationExecutionConfigCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	config := getAuthenticationExecutionConfigFromData(data)
+	config, err := getAuthenticationExecutionConfigFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	id, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
@@ -79,7 +178,12 @@ func resourceKeycloakAuthenticationExecutionConfigCreate(ctx context.Context, da
 
 	data.SetId(id)
 
-	return resourceKeycloakAuthenticationExecutionConfigRead(ctx, data, meta)
+	diags := resourceKeycloakAuthenticationExecutionConfigRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, getAuthenticationExecutionConfigWarnings(ctx, keycloakClient, config)...)
 }
 
 func resourceKeycloakAuthenticationExecutionConfigRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -104,14 +208,23 @@ func resourceKeycloakAuthenticationExecutionConfigRead(ctx context.Context, data
 func resourceKeycloakAuthenticationExecutionConfigUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	config := getAuthenticationExecutionConfigFromData(data)
+	config, err := getAuthenticationExecutionConfigFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err := keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
+	// the config entity is updated in place, so the execution keeps referencing it
+	err = keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return resourceKeycloakAuthenticationExecutionConfigRead(ctx, data, meta)
+	diags := resourceKeycloakAuthenticationExecutionConfigRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, getAuthenticationExecutionConfigWarnings(ctx, keycloakClient, config)...)
 }
 
 func resourceKeycloakAuthenticationExecutionConfigDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -130,22 +243,33 @@ func resourceKeycloakAuthenticationExecutionConfigImport(ctx context.Context, da
 
 	parts := strings.Split(data.Id(), "/")
 
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return nil, fmt.Errorf("invalid import. Supported import formats: {{realm}}/{{authenticationExecutionId}}/{{authenticationExecutionConfigId}}")
-	}
+	var config *keycloak.AuthenticationExecutionConfig
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		// the config is looked up through the execution it's attached to
+		var err error
+		config, err = keycloakClient.GetAuthenticationExecutionConfigForExecution(ctx, parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+	case len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		config = &keycloak.AuthenticationExecutionConfig{
+			RealmId:     parts[0],
+			ExecutionId: parts[1],
+			Id:          parts[2],
+		}
 
-	err := keycloakClient.GetAuthenticationExecutionConfig(ctx, &keycloak.AuthenticationExecutionConfig{
-		RealmId:     parts[0],
-		ExecutionId: parts[1],
-		Id:          parts[2],
-	})
-	if err != nil {
-		return nil, err
+		err := keycloakClient.GetAuthenticationExecutionConfig(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid import. Supported import formats: {{realm}}/{{authenticationExecutionId}}/{{authenticationExecutionConfigId}}, {{realm}}/{{authenticationExecutionId}}")
 	}
 
 	data.Set("realm_id", parts[0])
 	data.Set("execution_id", parts[1])
-	data.SetId(parts[2])
+	data.SetId(config.Id)
 
 	diagnostics := resourceKeycloakAuthenticationExecutionConfigRead(ctx, data, meta)
 	if diagnostics.HasError() {
@@ -115,6 +115,116 @@ func TestAccKeycloakAuthenticationExecutionConfig_import(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationExecutionConfig_importByExecution(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	configAlias := acctest.RandomWithPrefix("tf-acc")
+	configProvider := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig(flowAlias, configAlias, configProvider),
+			},
+			// the execution only knows about its config once it's refreshed
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig(flowAlias, configAlias, configProvider),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("keycloak_authentication_execution.execution", "config_id", "keycloak_authentication_execution_config.config", "id"),
+					resource.TestCheckResourceAttr("keycloak_authentication_execution.execution", "config_alias", configAlias),
+					resource.TestCheckResourceAttr("keycloak_authentication_execution.execution", "config.defaultProvider", configProvider),
+				),
+			},
+			{
+				ResourceName:      "keycloak_authentication_execution_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["keycloak_authentication_execution_config.config"]
+					if !ok {
+						return "", fmt.Errorf("resource keycloak_authentication_execution_config.config not found")
+					}
+
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["execution_id"]), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationExecutionConfig_firstBrokerLoginAutoLink(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig_firstBrokerLoginAutoLink(realmName, flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_authentication_execution_config.create_user_if_unique", "config.require.password.update.after.registration", "false"),
+					resource.TestCheckResourceAttr("keycloak_authentication_bindings.bindings", "first_broker_login_flow", flowAlias),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "first_broker_login_flow_alias", flowAlias),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationExecutionConfig_recaptcha(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_execution_config.recaptcha"
+
+	var config1, config2 keycloak.AuthenticationExecutionConfig
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig_recaptcha(flowAlias, "site-key-one", "secret-one", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationExecutionConfigExists(resourceName, &config1),
+					testAccCheckKeycloakAuthenticationExecutionConfigAttached(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "config.site.key", "site-key-one"),
+					resource.TestCheckResourceAttr(resourceName, "config.useRecaptchaNet", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_config.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_config.secret", "secret-one"),
+				),
+			},
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig_recaptcha(flowAlias, "site-key-two", "secret-two", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationExecutionConfigExists(resourceName, &config2),
+					testAccCheckKeycloakAuthenticationExecutionConfigForceNew(&config1, &config2, false),
+					testAccCheckKeycloakAuthenticationExecutionConfigAttached(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config.site.key", "site-key-two"),
+					resource.TestCheckResourceAttr(resourceName, "config.useRecaptchaNet", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_config.secret", "secret-two"),
+					func(s *terraform.State) error {
+						if config2.Config["secret"] != "secret-two" {
+							return fmt.Errorf("expected the secret to be updated in Keycloak, got %s", config2.Config["secret"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func getExecutionConfigImportId(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -149,6 +259,27 @@ func testAccCheckKeycloakAuthenticationExecutionConfigExists(resourceName string
 	}
 }
 
+// checks that the execution references the config of the resource, rather than another config created for it
+func testAccCheckKeycloakAuthenticationExecutionConfigAttached(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		config, err := keycloakClient.GetAuthenticationExecutionConfigForExecution(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["execution_id"])
+		if err != nil {
+			return err
+		}
+
+		if config.Id != rs.Primary.ID {
+			return fmt.Errorf("expected the execution to reference config %s, got %s", rs.Primary.ID, config.Id)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakAuthenticationExecutionConfigDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "keycloak_authentication_execution_config" {
@@ -210,3 +341,97 @@ resource "keycloak_authentication_execution_config" "config" {
 	}
 }`, testAccRealm.Realm, flowAlias, configAlias, configProvider)
 }
+
+func testAccKeycloakAuthenticationExecutionConfig_firstBrokerLoginAutoLink(realm, flowAlias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "create_user_if_unique" {
+	realm_id          = keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "idp-create-user-if-unique"
+	requirement       = "ALTERNATIVE"
+}
+
+resource "keycloak_authentication_execution_config" "create_user_if_unique" {
+	realm_id     = keycloak_realm.realm.id
+	execution_id = keycloak_authentication_execution.create_user_if_unique.id
+	alias        = "create-user-if-unique"
+	config = {
+		"require.password.update.after.registration" = "false"
+	}
+}
+
+resource "keycloak_authentication_execution" "auto_link" {
+	realm_id          = keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "idp-auto-link"
+	requirement       = "ALTERNATIVE"
+
+	depends_on = [keycloak_authentication_execution.create_user_if_unique]
+}
+
+resource "keycloak_authentication_bindings" "bindings" {
+	realm_id                = keycloak_realm.realm.id
+	first_broker_login_flow = keycloak_authentication_flow.flow.alias
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm                         = keycloak_realm.realm.id
+	alias                         = "oidc"
+	authorization_url             = "https://example.com/auth"
+	token_url                     = "https://example.com/token"
+	client_id                     = "example_id"
+	client_secret                 = "example_token"
+	trust_email                   = true
+	first_broker_login_flow_alias = keycloak_authentication_flow.flow.alias
+}`, realm, flowAlias)
+}
+
+func testAccKeycloakAuthenticationExecutionConfig_recaptcha(flowAlias, siteKey, secret, useRecaptchaNet string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_subflow" "registration_form" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	alias             = "%s-form"
+	provider_id       = "form-flow"
+	authenticator     = "registration-page-form"
+	requirement       = "REQUIRED"
+}
+
+resource "keycloak_authentication_execution" "recaptcha" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_subflow.registration_form.alias
+	authenticator     = "registration-recaptcha-action"
+	requirement       = "REQUIRED"
+}
+
+resource "keycloak_authentication_execution_config" "recaptcha" {
+	realm_id     = data.keycloak_realm.realm.id
+	execution_id = keycloak_authentication_execution.recaptcha.id
+	alias        = "recaptcha"
+	config = {
+		"site.key"      = "%s"
+		useRecaptchaNet = "%s"
+	}
+	sensitive_config = {
+		secret = "%s"
+	}
+}`, testAccRealm.Realm, flowAlias, flowAlias, siteKey, useRecaptchaNet, secret)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -99,6 +100,70 @@ func TestAccKeycloakAuthenticationExecution_updateAuthenticationExecutionRequire
 	})
 }
 
+func TestAccKeycloakAuthenticationExecution_keepOrderOnUpdate(t *testing.T) {
+	t.Parallel()
+	authParentFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	checkOrder := resource.ComposeTestCheckFunc(
+		testAccCheckKeycloakAuthenticationExecutionIndex("keycloak_authentication_execution.cookie_execution", 0),
+		testAccCheckKeycloakAuthenticationSubFlowIndex("keycloak_authentication_subflow.subflow", 1),
+		testAccCheckKeycloakAuthenticationExecutionIndex("keycloak_authentication_execution.kerberos_execution", 2),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationExecution_orderedExecutions(authParentFlowAlias, "DISABLED"),
+				Check:  checkOrder,
+			},
+			{
+				Config: testKeycloakAuthenticationExecution_orderedExecutions(authParentFlowAlias, "ALTERNATIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					checkOrder,
+					resource.TestCheckResourceAttr("keycloak_authentication_execution.cookie_execution", "requirement", "ALTERNATIVE"),
+					resource.TestCheckResourceAttr("keycloak_authentication_subflow.subflow", "requirement", "ALTERNATIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationExecution_conditionalRequirement(t *testing.T) {
+	t.Parallel()
+	authParentFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationExecution_basicWithRequirement(authParentFlowAlias, "CONDITIONAL"),
+				ExpectError: regexp.MustCompile("requirement CONDITIONAL is only supported for subflows"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationExecution_builtInFlow(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationExecution_builtInFlow("browser"),
+				ExpectError: regexp.MustCompile("Keycloak doesn't allow adding executions to the built-in flow browser"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakAuthenticationExecution_createAuthenticationExecutionPriority(t *testing.T) {
 	t.Parallel()
 
@@ -323,6 +388,20 @@ resource "keycloak_authentication_execution" "execution" {
 	`, testAccRealm.Realm, parentAlias)
 }
 
+func testKeycloakAuthenticationExecution_builtInFlow(parentAlias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = "%s"
+	authenticator     = "auth-cookie"
+}
+	`, testAccRealm.Realm, parentAlias)
+}
+
 func testKeycloakAuthenticationExecution_basicWithRequirement(parentAlias, requirement string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -369,6 +448,44 @@ resource "keycloak_authentication_execution" "kerberos_execution" {
 	`, testAccRealm.Realm, parentAlias)
 }
 
+func testKeycloakAuthenticationExecution_orderedExecutions(parentAlias, requirement string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "cookie_execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "auth-cookie"
+	requirement       = "%s"
+}
+
+resource "keycloak_authentication_subflow" "subflow" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	alias             = "%s-subflow"
+	provider_id       = "basic-flow"
+	requirement       = "%s"
+
+	depends_on = [keycloak_authentication_execution.cookie_execution]
+}
+
+resource "keycloak_authentication_execution" "kerberos_execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "auth-spnego"
+
+	depends_on = [keycloak_authentication_subflow.subflow]
+}
+	`, testAccRealm.Realm, parentAlias, requirement, parentAlias, requirement)
+}
+
 func testKeycloakAuthenticationExecution_multipleExecutionsWithKerberosPriority(parentAlias string, priorityKerberos int) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 	"strings"
@@ -40,6 +42,21 @@ func resourceKeycloakAuthenticationFlow() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"copy_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The alias of an existing flow, ex. browser, which is copied with its executions and subflows when this flow is created.",
+			},
+			"import_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"copy_from"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The structure of a flow, as exported by the export_json attribute of the keycloak_authentication_flow data source, whose executions, subflows and configs are created when this flow is created.",
+			},
 		},
 	}
 }
@@ -75,9 +92,25 @@ func resourceKeycloakAuthenticationFlowCreate(ctx context.Context, data *schema.
 
 	authenticationFlow := mapFromDataToAuthenticationFlow(data)
 
-	err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
-	if err != nil {
-		return diag.FromErr(err)
+	if copyFrom := data.Get("copy_from").(string); copyFrom != "" {
+		err := copyAuthenticationFlow(ctx, keycloakClient, authenticationFlow, copyFrom)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if importJson := data.Get("import_json").(string); importJson != "" {
+		err := importAuthenticationFlow(ctx, keycloakClient, authenticationFlow, importJson)
+		if authenticationFlow.Id != "" {
+			// the flow is kept in state when importing its executions fails, so it's removed again on the next apply
+			mapFromAuthenticationFlowToData(data, authenticationFlow)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
@@ -85,6 +118,48 @@ func resourceKeycloakAuthenticationFlowCreate(ctx context.Context, data *schema.
 	return resourceKeycloakAuthenticationFlowRead(ctx, data, meta)
 }
 
+// copyAuthenticationFlow creates the flow as a copy of another one. The copy keeps the description of the original, so the
+// configured description is applied afterwards.
+func copyAuthenticationFlow(ctx context.Context, keycloakClient *keycloak.KeycloakClient, authenticationFlow *keycloak.AuthenticationFlow, copyFrom string) error {
+	sourceAuthenticationFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, authenticationFlow.RealmId, copyFrom)
+	if err != nil {
+		return err
+	}
+
+	if sourceAuthenticationFlow.ProviderId != authenticationFlow.ProviderId {
+		return fmt.Errorf("validation error: provider_id %s doesn't match provider_id %s of authentication flow %s", authenticationFlow.ProviderId, sourceAuthenticationFlow.ProviderId, copyFrom)
+	}
+
+	copiedAuthenticationFlow, err := keycloakClient.CopyAuthenticationFlow(ctx, authenticationFlow.RealmId, copyFrom, authenticationFlow.Alias)
+	if err != nil {
+		return err
+	}
+
+	authenticationFlow.Id = copiedAuthenticationFlow.Id
+
+	return keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
+}
+
+// importAuthenticationFlow creates the flow along with the executions, subflows and configs of an export.
+func importAuthenticationFlow(ctx context.Context, keycloakClient *keycloak.KeycloakClient, authenticationFlow *keycloak.AuthenticationFlow, importJson string) error {
+	var export keycloak.AuthenticationFlowExport
+	err := json.Unmarshal([]byte(importJson), &export)
+	if err != nil {
+		return fmt.Errorf("validation error: import_json isn't a valid authentication flow export: %s", err)
+	}
+
+	if export.ProviderId != "" && export.ProviderId != authenticationFlow.ProviderId {
+		return fmt.Errorf("validation error: provider_id %s doesn't match provider_id %s of import_json", authenticationFlow.ProviderId, export.ProviderId)
+	}
+
+	err = keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.ImportAuthenticationFlowExecutions(ctx, authenticationFlow.RealmId, authenticationFlow.Alias, export.Executions)
+}
+
 func resourceKeycloakAuthenticationFlowRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -120,7 +195,41 @@ func resourceKeycloakAuthenticationFlowDelete(ctx context.Context, data *schema.
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
-	return diag.FromErr(keycloakClient.DeleteAuthenticationFlow(ctx, realmId, id))
+	if data.Get("copy_from").(string) == "" && data.Get("import_json").(string) == "" {
+		return diag.FromErr(keycloakClient.DeleteAuthenticationFlow(ctx, realmId, id))
+	}
+
+	// the subflows of a copy or an import aren't managed by terraform, older versions of Keycloak keep them when their flow is deleted
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, data.Get("alias").(string))
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.DeleteAuthenticationFlow(ctx, realmId, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, execution := range executions {
+		if !execution.AuthenticationFlow {
+			continue
+		}
+
+		_, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, execution.FlowId)
+		if keycloak.ErrorIs404(err) {
+			continue
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = keycloakClient.DeleteAuthenticationFlow(ctx, realmId, execution.FlowId)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
 }
 
 func resourceKeycloakAuthenticationFlowImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -121,6 +123,178 @@ func TestAccKeycloakAuthenticationFlow_updateRealm(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationFlow_copyFrom(t *testing.T) {
+	t.Parallel()
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	var subFlowIds []string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckKeycloakAuthenticationFlowDestroy(),
+			func(s *terraform.State) error {
+				for _, subFlowId := range subFlowIds {
+					if subFlow, _ := keycloakClient.GetAuthenticationFlow(testCtx, testAccRealm.Realm, subFlowId); subFlow != nil {
+						return fmt.Errorf("subflow %s of copied authentication flow %s still exists", subFlow.Alias, authFlowAlias)
+					}
+				}
+
+				return nil
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_copyFrom(authFlowAlias, "browser"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowExists("keycloak_authentication_flow.flow"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow.flow", "description", "copy of the browser flow"),
+					func(s *terraform.State) error {
+						executions, err := keycloakClient.ListAuthenticationExecutions(testCtx, testAccRealm.Realm, authFlowAlias)
+						if err != nil {
+							return err
+						}
+
+						hasCookie := false
+						for _, execution := range executions {
+							if execution.ProviderId == "auth-cookie" {
+								hasCookie = true
+							}
+							if execution.AuthenticationFlow {
+								subFlowIds = append(subFlowIds, execution.FlowId)
+							}
+						}
+
+						if !hasCookie || len(subFlowIds) == 0 {
+							return fmt.Errorf("expected authentication flow %s to be a copy of the browser flow", authFlowAlias)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "keycloak_authentication_flow.flow",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"copy_from"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_copyFromWithExecutionInCopiedSubFlow(t *testing.T) {
+	t.Parallel()
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_copyFromWithExecutionInCopiedSubFlow(authFlowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowExists("keycloak_authentication_flow.flow"),
+					resource.TestCheckResourceAttr("keycloak_authentication_execution.execution", "parent_flow_alias", authFlowAlias+" forms"),
+					func(s *terraform.State) error {
+						executions, err := keycloakClient.ListAuthenticationExecutions(testCtx, testAccRealm.Realm, authFlowAlias+" forms")
+						if err != nil {
+							return err
+						}
+
+						for _, execution := range executions {
+							if execution.ProviderId == "auth-spnego" {
+								return nil
+							}
+						}
+
+						return fmt.Errorf("expected the copied subflow %s forms to contain the added execution", authFlowAlias)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_copyFromAliasTaken(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationFlow_copyFrom("direct grant", "browser"),
+				ExpectError: regexp.MustCompile("can't copy authentication flow browser to direct grant, a flow with alias direct grant already exists"),
+			},
+			{
+				Config:      testKeycloakAuthenticationFlow_copyFrom(acctest.RandomWithPrefix("tf-acc"), "tf-acc-missing"),
+				ExpectError: regexp.MustCompile("no authentication flow found for alias tf-acc-missing"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_importJson(t *testing.T) {
+	t.Parallel()
+	sourceAlias := acctest.RandomWithPrefix("tf-acc")
+	importAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_importJson(sourceAlias, importAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowExists("keycloak_authentication_flow.imported"),
+					testAccCheckKeycloakAuthenticationFlowBelongsToRealm("keycloak_authentication_flow.imported", testAccRealmTwo.Realm),
+					func(s *terraform.State) error {
+						executions, err := keycloakClient.ListAuthenticationExecutions(testCtx, testAccRealmTwo.Realm, importAlias)
+						if err != nil {
+							return err
+						}
+
+						var providers []string
+						for _, execution := range executions {
+							if execution.AuthenticationFlow {
+								providers = append(providers, execution.DisplayName)
+							} else {
+								providers = append(providers, execution.ProviderId)
+							}
+						}
+
+						// the executions of the subflow follow the subflow
+						expected := []string{"auth-cookie", sourceAlias + "-forms", "auth-username-password-form", "identity-provider-redirector"}
+						if strings.Join(providers, ",") != strings.Join(expected, ",") {
+							return fmt.Errorf("expected imported authentication flow %s to have executions %v, got %v", importAlias, expected, providers)
+						}
+
+						if executions[2].Level != 1 {
+							return fmt.Errorf("expected auth-username-password-form to be imported into subflow %s-forms", sourceAlias)
+						}
+
+						config, err := keycloakClient.GetAuthenticationExecutionConfigForExecution(testCtx, testAccRealmTwo.Realm, executions[3].Id)
+						if err != nil {
+							return err
+						}
+						if config.Config["defaultProvider"] != "example" {
+							return fmt.Errorf("expected config of imported execution to have defaultProvider example, got %s", config.Config["defaultProvider"])
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakAuthenticationFlowExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getAuthenticationFlowFromState(s, resourceName)
@@ -211,6 +385,42 @@ resource "keycloak_authentication_flow" "flow" {
 	`, testAccRealm.Realm, alias)
 }
 
+func testKeycloakAuthenticationFlow_copyFrom(alias, copyFrom string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "copy of the %s flow"
+	copy_from   = "%s"
+}
+	`, testAccRealm.Realm, alias, copyFrom, copyFrom)
+}
+
+func testKeycloakAuthenticationFlow_copyFromWithExecutionInCopiedSubFlow(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id  = data.keycloak_realm.realm.id
+	alias     = "%s"
+	copy_from = "browser"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = "${keycloak_authentication_flow.flow.alias} forms"
+	authenticator     = "auth-spnego"
+	requirement       = "DISABLED"
+}
+	`, testAccRealm.Realm, alias)
+}
+
 func testKeycloakAuthenticationFlow_updateRealmBefore(alias string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm_1" {
@@ -244,3 +454,77 @@ resource "keycloak_authentication_flow" "flow" {
 }
 	`, testAccRealm.Realm, testAccRealmTwo.Realm, alias)
 }
+
+func testKeycloakAuthenticationFlow_importJson(sourceAlias, importAlias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_realm" "realm_2" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "source" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "cookie" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.source.alias
+	authenticator     = "auth-cookie"
+	requirement       = "ALTERNATIVE"
+}
+
+resource "keycloak_authentication_subflow" "forms" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.source.alias
+	alias             = "${keycloak_authentication_flow.source.alias}-forms"
+	requirement       = "ALTERNATIVE"
+
+	depends_on = [keycloak_authentication_execution.cookie]
+}
+
+resource "keycloak_authentication_execution" "password" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_subflow.forms.alias
+	authenticator     = "auth-username-password-form"
+	requirement       = "REQUIRED"
+}
+
+resource "keycloak_authentication_execution" "redirector" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.source.alias
+	authenticator     = "identity-provider-redirector"
+	requirement       = "ALTERNATIVE"
+
+	depends_on = [keycloak_authentication_subflow.forms]
+}
+
+resource "keycloak_authentication_execution_config" "redirector" {
+	realm_id     = data.keycloak_realm.realm.id
+	execution_id = keycloak_authentication_execution.redirector.id
+	alias        = "${keycloak_authentication_flow.source.alias}-redirector"
+	config = {
+		defaultProvider = "example"
+	}
+}
+
+data "keycloak_authentication_flow" "source" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = keycloak_authentication_flow.source.alias
+
+	depends_on = [
+		keycloak_authentication_execution.password,
+		keycloak_authentication_execution_config.redirector,
+	]
+}
+
+resource "keycloak_authentication_flow" "imported" {
+	realm_id    = data.keycloak_realm.realm_2.id
+	alias       = "%s"
+	import_json = data.keycloak_authentication_flow.source.export_json
+}
+	`, testAccRealm.Realm, testAccRealmTwo.Realm, sourceAlias, importAlias)
+}
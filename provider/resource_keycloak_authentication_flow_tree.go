@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// the built-in first broker login flow nests subflows five levels deep, which is the deepest Keycloak ships with
+const authenticationFlowTreeMaxDepth = 6
+
+// Manages a flow along with all of its executions, subflows and configs, so that their order and requirements are
+// reconciled as a whole instead of through separate resources.
+func resourceKeycloakAuthenticationFlowTree() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAuthenticationFlowTreeCreate,
+		ReadContext:   resourceKeycloakAuthenticationFlowTreeRead,
+		UpdateContext: resourceKeycloakAuthenticationFlowTreeUpdate,
+		DeleteContext: resourceKeycloakAuthenticationFlowTreeDelete,
+		// This resource can be imported using {{realmId}}/{{authenticationFlowId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAuthenticationFlowTreeImport,
+		},
+		CustomizeDiff: validateAuthenticationFlowTree,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "basic-flow",
+				ValidateFunc: validation.StringInSlice([]string{"basic-flow", "client-flow"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"execution": authenticationFlowTreeExecutionSchema(authenticationFlowTreeMaxDepth),
+		},
+	}
+}
+
+// authenticationFlowTreeExecutionSchema returns the schema of the executions of a level, whose subflows can nest the given
+// number of levels
+func authenticationFlowTreeExecutionSchema(depth int) *schema.Schema {
+	subFlowSchema := map[string]*schema.Schema{
+		"alias": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"provider_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "basic-flow",
+			ValidateFunc: validation.StringInSlice([]string{"basic-flow", "form-flow", "client-flow"}, false),
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	if depth > 1 {
+		subFlowSchema["execution"] = authenticationFlowTreeExecutionSchema(depth - 1)
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"authenticator": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The authenticator of the execution. Subflows only have one when they're form subflows, ex. registration-page-form.",
+				},
+				"requirement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "DISABLED",
+					ValidateFunc: validation.StringInSlice(keycloakAuthenticationRequirements, false),
+				},
+				"config": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"alias": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"config": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"subflow": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: subFlowSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getAuthenticationFlowTreeExecutionsFromData(data []interface{}) []*keycloak.AuthenticationFlowExportExecution {
+	executions := []*keycloak.AuthenticationFlowExportExecution{}
+	for _, d := range data {
+		executionData := d.(map[string]interface{})
+
+		execution := &keycloak.AuthenticationFlowExportExecution{
+			Authenticator: executionData["authenticator"].(string),
+			Requirement:   executionData["requirement"].(string),
+		}
+
+		if v := executionData["config"].([]interface{}); len(v) == 1 && v[0] != nil {
+			configData := v[0].(map[string]interface{})
+			execution.Config = &keycloak.AuthenticationFlowExportConfig{
+				Alias:  configData["alias"].(string),
+				Config: map[string]string{},
+			}
+			for key, value := range configData["config"].(map[string]interface{}) {
+				execution.Config.Config[key] = value.(string)
+			}
+		}
+
+		if v := executionData["subflow"].([]interface{}); len(v) == 1 && v[0] != nil {
+			subFlowData := v[0].(map[string]interface{})
+			execution.SubFlow = &keycloak.AuthenticationFlowExportSubFlow{
+				Alias:       subFlowData["alias"].(string),
+				ProviderId:  subFlowData["provider_id"].(string),
+				Description: subFlowData["description"].(string),
+				Executions:  []*keycloak.AuthenticationFlowExportExecution{},
+			}
+			if executionsData, ok := subFlowData["execution"].([]interface{}); ok {
+				execution.SubFlow.Executions = getAuthenticationFlowTreeExecutionsFromData(executionsData)
+			}
+		}
+
+		executions = append(executions, execution)
+	}
+
+	return executions
+}
+
+func getAuthenticationFlowTreeExecutionsData(executions []*keycloak.AuthenticationFlowExportExecution, depth int) ([]interface{}, error) {
+	var data []interface{}
+	for _, execution := range executions {
+		executionData := map[string]interface{}{
+			"authenticator": execution.Authenticator,
+			"requirement":   execution.Requirement,
+		}
+
+		if execution.Config != nil {
+			config := map[string]interface{}{}
+			for key, value := range execution.Config.Config {
+				config[key] = value
+			}
+
+			executionData["config"] = []interface{}{
+				map[string]interface{}{
+					"alias":  execution.Config.Alias,
+					"config": config,
+				},
+			}
+		}
+
+		if execution.SubFlow != nil {
+			subFlowData := map[string]interface{}{
+				"alias":       execution.SubFlow.Alias,
+				"provider_id": execution.SubFlow.ProviderId,
+				"description": execution.SubFlow.Description,
+			}
+
+			if depth > 1 {
+				executionsData, err := getAuthenticationFlowTreeExecutionsData(execution.SubFlow.Executions, depth-1)
+				if err != nil {
+					return nil, err
+				}
+				subFlowData["execution"] = executionsData
+			} else if len(execution.SubFlow.Executions) != 0 {
+				return nil, fmt.Errorf("subflow %s is nested deeper than the %d levels keycloak_authentication_flow_tree supports", execution.SubFlow.Alias, authenticationFlowTreeMaxDepth)
+			}
+
+			executionData["subflow"] = []interface{}{subFlowData}
+		}
+
+		data = append(data, executionData)
+	}
+
+	return data, nil
+}
+
+// validateAuthenticationFlowTree catches executions Keycloak would refuse, before anything in the tree is changed
+func validateAuthenticationFlowTree(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("execution") {
+		return nil
+	}
+
+	return validateAuthenticationFlowTreeExecutions(getAuthenticationFlowTreeExecutionsFromData(d.Get("execution").([]interface{})), d.Get("alias").(string))
+}
+
+func validateAuthenticationFlowTreeExecutions(executions []*keycloak.AuthenticationFlowExportExecution, parentFlowAlias string) error {
+	for _, execution := range executions {
+		if execution.SubFlow == nil {
+			if execution.Authenticator == "" {
+				return fmt.Errorf("validation error: executions of flow %s need either an authenticator or a subflow", parentFlowAlias)
+			}
+
+			if execution.Requirement == "CONDITIONAL" {
+				return fmt.Errorf("validation error: requirement CONDITIONAL of execution %s is only supported for subflows, use REQUIRED for the condition executions within a CONDITIONAL subflow", execution.Authenticator)
+			}
+
+			continue
+		}
+
+		if execution.Requirement == "CONDITIONAL" && execution.SubFlow.ProviderId != "basic-flow" {
+			return fmt.Errorf("validation error: requirement CONDITIONAL of subflow %s is only supported for basic-flow subflows, got %s", execution.SubFlow.Alias, execution.SubFlow.ProviderId)
+		}
+
+		if err := validateAuthenticationFlowTreeExecutions(execution.SubFlow.Executions, execution.SubFlow.Alias); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowTreeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	authenticationFlow := mapFromDataToAuthenticationFlow(data)
+
+	err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the flow is kept in state when reconciling its executions fails, so the next apply picks up where this one stopped
+	data.SetId(authenticationFlow.Id)
+
+	err = keycloakClient.ReconcileAuthenticationFlowExecutions(ctx, authenticationFlow.RealmId, authenticationFlow.Alias, getAuthenticationFlowTreeExecutionsFromData(data.Get("execution").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAuthenticationFlowTreeRead(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowTreeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	export, err := keycloakClient.ExportAuthenticationFlow(ctx, realmId, authenticationFlow.Alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	executions, err := getAuthenticationFlowTreeExecutionsData(export.Executions, authenticationFlowTreeMaxDepth)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromAuthenticationFlowToData(data, authenticationFlow)
+	data.Set("execution", executions)
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowTreeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	authenticationFlow := mapFromDataToAuthenticationFlow(data)
+
+	if data.HasChange("description") {
+		err := keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := keycloakClient.ReconcileAuthenticationFlowExecutions(ctx, authenticationFlow.RealmId, authenticationFlow.Alias, getAuthenticationFlowTreeExecutionsFromData(data.Get("execution").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAuthenticationFlowTreeRead(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowTreeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	// older versions of Keycloak keep the subflows when their flow is deleted, so they're removed afterwards
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, data.Get("alias").(string))
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.DeleteAuthenticationFlow(ctx, realmId, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, execution := range executions {
+		if !execution.AuthenticationFlow {
+			continue
+		}
+
+		err = keycloakClient.DeleteAuthenticationFlow(ctx, realmId, execution.FlowId)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowTreeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{authenticationFlowId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakAuthenticationFlowTreeRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("authentication flow %s doesn't exist in realm %s", parts[1], parts[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
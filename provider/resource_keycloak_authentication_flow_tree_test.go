@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakAuthenticationFlowTree_basic(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_basic(flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeExecutions(resourceName, []string{
+						"0 auth-cookie ALTERNATIVE",
+						"0 identity-provider-redirector ALTERNATIVE",
+						fmt.Sprintf("0 %s-forms ALTERNATIVE", flowAlias),
+						"1 auth-username-password-form REQUIRED",
+						fmt.Sprintf("1 %s-otp CONDITIONAL", flowAlias),
+						"2 conditional-user-configured REQUIRED",
+						"2 auth-otp-form REQUIRED",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "execution.1.config.0.config.defaultProvider", "my-idp"),
+				),
+			},
+			{
+				Config: testKeycloakAuthenticationFlowTree_reordered(flowAlias),
+				Check: testAccCheckKeycloakAuthenticationFlowTreeExecutions(resourceName, []string{
+					fmt.Sprintf("0 %s-forms ALTERNATIVE", flowAlias),
+					"1 auth-username-password-form REQUIRED",
+					fmt.Sprintf("1 %s-otp DISABLED", flowAlias),
+					"2 conditional-user-configured REQUIRED",
+					"2 auth-otp-form REQUIRED",
+					"0 auth-cookie ALTERNATIVE",
+				}),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_driftIsReconciled(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	expectedExecutions := []string{
+		"0 auth-cookie ALTERNATIVE",
+		"0 identity-provider-redirector ALTERNATIVE",
+		fmt.Sprintf("0 %s-forms ALTERNATIVE", flowAlias),
+		"1 auth-username-password-form REQUIRED",
+		fmt.Sprintf("1 %s-otp CONDITIONAL", flowAlias),
+		"2 conditional-user-configured REQUIRED",
+		"2 auth-otp-form REQUIRED",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_basic(flowAlias),
+				Check:  testAccCheckKeycloakAuthenticationFlowTreeExecutions(resourceName, expectedExecutions),
+			},
+			{
+				PreConfig: func() {
+					execution := &keycloak.AuthenticationExecution{
+						RealmId:         testAccRealm.Realm,
+						ParentFlowAlias: flowAlias + "-forms",
+						Authenticator:   "auth-spnego",
+						Requirement:     "DISABLED",
+					}
+
+					err := keycloakClient.NewAuthenticationExecution(testCtx, execution)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.RaiseAuthenticationExecutionPriority(testCtx, testAccRealm.Realm, execution.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAuthenticationFlowTree_basic(flowAlias),
+				Check:  testAccCheckKeycloakAuthenticationFlowTreeExecutions(resourceName, expectedExecutions),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakAuthenticationFlowTreeExecutions compares the executions of the flow, as listed by Keycloak, with the
+// expected ones given as "level authenticator-or-subflow-alias requirement"
+func testAccCheckKeycloakAuthenticationFlowTreeExecutions(resourceName string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		executions, err := keycloakClient.ListAuthenticationExecutions(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["alias"])
+		if err != nil {
+			return err
+		}
+
+		var actual []string
+		for _, execution := range executions {
+			name := execution.ProviderId
+			if execution.AuthenticationFlow {
+				name = execution.DisplayName
+			}
+
+			actual = append(actual, fmt.Sprintf("%d %s %s", execution.Level, name, execution.Requirement))
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("expected executions %v, got %v", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAuthenticationFlowTreeDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_flow_tree" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+
+			authenticationFlow, _ := keycloakClient.GetAuthenticationFlow(testCtx, realmId, rs.Primary.ID)
+			if authenticationFlow != nil {
+				return fmt.Errorf("authentication flow %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationFlowTree_basic(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "browser flow managed as a whole"
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "ALTERNATIVE"
+	}
+
+	execution {
+		authenticator = "identity-provider-redirector"
+		requirement   = "ALTERNATIVE"
+
+		config {
+			alias  = "%s-idp-redirector"
+			config = {
+				defaultProvider = "my-idp"
+			}
+		}
+	}
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s-forms"
+
+			execution {
+				authenticator = "auth-username-password-form"
+				requirement   = "REQUIRED"
+			}
+
+			execution {
+				requirement = "CONDITIONAL"
+
+				subflow {
+					alias = "%s-otp"
+
+					execution {
+						authenticator = "conditional-user-configured"
+						requirement   = "REQUIRED"
+					}
+
+					execution {
+						authenticator = "auth-otp-form"
+						requirement   = "REQUIRED"
+					}
+				}
+			}
+		}
+	}
+}
+	`, testAccRealm.Realm, alias, alias, alias, alias)
+}
+
+func testKeycloakAuthenticationFlowTree_reordered(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "browser flow managed as a whole"
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s-forms"
+
+			execution {
+				authenticator = "auth-username-password-form"
+				requirement   = "REQUIRED"
+			}
+
+			execution {
+				requirement = "DISABLED"
+
+				subflow {
+					alias = "%s-otp"
+
+					execution {
+						authenticator = "conditional-user-configured"
+						requirement   = "REQUIRED"
+					}
+
+					execution {
+						authenticator = "auth-otp-form"
+						requirement   = "REQUIRED"
+					}
+				}
+			}
+		}
+	}
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "ALTERNATIVE"
+	}
+}
+	`, testAccRealm.Realm, alias, alias, alias)
+}
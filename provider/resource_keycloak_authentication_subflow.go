@@ -56,14 +56,31 @@ func resourceKeycloakAuthenticationSubFlow() *schema.Resource {
 			"requirement": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"REQUIRED", "ALTERNATIVE", "OPTIONAL", "CONDITIONAL", "DISABLED"}, false), //OPTIONAL is removed from 8.0.0 onwards
+				ValidateFunc: validation.StringInSlice(keycloakAuthenticationRequirements, false),
 				Default:      "DISABLED",
 			},
 			"priority": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
+			},
+			"config_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the config attached to this subflow, managed with keycloak_authentication_execution_config.",
+			},
+			"config_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:      schema.TypeMap,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Computed:  true,
+				Sensitive: true,
 			},
 		},
+		CustomizeDiff: validateAuthenticationSubFlowRequirement,
 	}
 }
 
@@ -119,7 +136,14 @@ func resourceKeycloakAuthenticationSubFlowCreate(ctx context.Context, data *sche
 		return diag.FromErr(err)
 	}
 
-	return resourceKeycloakAuthenticationSubFlowRead(ctx, data, meta)
+	diags := resourceKeycloakAuthenticationSubFlowRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, getAuthenticationRequirementWarnings(ctx, keycloakClient, authenticationFlow.RealmId, authenticationFlow.ParentFlowAlias)...)
+
+	return append(diags, getAuthenticationPriorityWarnings(ctx, keycloakClient, authenticationFlow.ParentFlowAlias, authenticationFlow.Priority)...)
 }
 
 func resourceKeycloakAuthenticationSubFlowRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -139,7 +163,12 @@ func resourceKeycloakAuthenticationSubFlowRead(ctx context.Context, data *schema
 		return diag.FromErr(err)
 	}
 
-	return nil
+	err = setAttachedAuthenticationExecutionConfigData(ctx, keycloakClient, data, realmId, authenticationFlow.ExecutionId, authenticationFlow.AuthenticationConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return getAuthenticationSharedPriorityWarnings(ctx, keycloakClient, realmId, parentFlowAlias, authenticationFlow.ExecutionId, authenticationFlow.Priority)
 }
 
 func resourceKeycloakAuthenticationSubFlowUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -152,12 +181,14 @@ func resourceKeycloakAuthenticationSubFlowUpdate(ctx context.Context, data *sche
 		return diag.FromErr(err)
 	}
 
-	err = mapFromAuthenticationSubFlowToData(ctx, keycloakClient, data, authenticationFlow)
-	if err != nil {
-		return diag.FromErr(err)
+	diags := resourceKeycloakAuthenticationSubFlowRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
 	}
 
-	return nil
+	diags = append(diags, getAuthenticationRequirementWarnings(ctx, keycloakClient, authenticationFlow.RealmId, authenticationFlow.ParentFlowAlias)...)
+
+	return append(diags, getAuthenticationPriorityWarnings(ctx, keycloakClient, authenticationFlow.ParentFlowAlias, authenticationFlow.Priority)...)
 }
 
 func resourceKeycloakAuthenticationSubFlowDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -170,23 +201,38 @@ func resourceKeycloakAuthenticationSubFlowDelete(ctx context.Context, data *sche
 	return diag.FromErr(keycloakClient.DeleteAuthenticationSubFlow(ctx, realmId, parentFlowAlias, id))
 }
 
+// parseAuthenticationSubFlowImportId splits {{realmId}}/{{parentFlowAlias}}/{{authenticationSubFlowId}}. Neither realm names
+// nor ids contain slashes, so the alias of the parent flow is everything in between.
+func parseAuthenticationSubFlowImportId(id string) (string, string, string, error) {
+	first := strings.Index(id, "/")
+	last := strings.LastIndex(id, "/")
+
+	if first == -1 || first == last || first == 0 || last == first+1 || last == len(id)-1 {
+		return "", "", "", fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationSubFlowId}}")
+	}
+
+	return id[:first], id[first+1 : last], id[last+1:], nil
+}
+
 func resourceKeycloakAuthenticationSubFlowImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	parts := strings.Split(d.Id(), "/")
-
-	if len(parts) != 3 {
-		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{parentFlowAlias}}/{{authenticationSubFlowId}}")
+	realmId, parentFlowAlias, id, err := parseAuthenticationSubFlowImportId(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	_, err := keycloakClient.GetAuthenticationSubFlow(ctx, parts[0], parts[1], parts[2])
+	_, err = keycloakClient.GetAuthenticationSubFlow(ctx, realmId, parentFlowAlias, id)
 	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil, fmt.Errorf("authentication subflow %s does not exist in flow %s of realm %s", id, parentFlowAlias, realmId)
+		}
 		return nil, err
 	}
 
-	d.Set("realm_id", parts[0])
-	d.Set("parent_flow_alias", parts[1])
-	d.SetId(parts[2])
+	d.Set("realm_id", realmId)
+	d.Set("parent_flow_alias", parentFlowAlias)
+	d.SetId(id)
 
 	diagnostics := resourceKeycloakAuthenticationSubFlowRead(ctx, d, meta)
 	if diagnostics.HasError() {
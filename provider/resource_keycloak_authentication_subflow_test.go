@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"regexp"
 	"testing"
 )
 
@@ -34,6 +35,88 @@ func TestAccKeycloakAuthenticationSubFlow_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationSubFlow_parentFlowAliasWithSlash(t *testing.T) {
+	t.Parallel()
+
+	parentAuthFlowAlias := acctest.RandomWithPrefix("tf-acc") + "/a/b"
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationSubFlow_basicWithRequirement(parentAuthFlowAlias, authFlowAlias, "ALTERNATIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationSubFlowExists("keycloak_authentication_subflow.subflow"),
+					resource.TestCheckResourceAttr("keycloak_authentication_subflow.subflow", "parent_flow_alias", parentAuthFlowAlias),
+					resource.TestCheckResourceAttr("keycloak_authentication_subflow.subflow", "requirement", "ALTERNATIVE"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_authentication_subflow.subflow",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getSubFlowImportId("keycloak_authentication_subflow.subflow"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationSubFlow_importWrongParentFlow(t *testing.T) {
+	t.Parallel()
+
+	parentAuthFlowAlias := acctest.RandomWithPrefix("tf-acc")
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationSubFlow_basic(parentAuthFlowAlias, authFlowAlias),
+				Check:  testAccCheckKeycloakAuthenticationSubFlowExists("keycloak_authentication_subflow.subflow"),
+			},
+			{
+				ResourceName: "keycloak_authentication_subflow.subflow",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/browser/%s", testAccRealm.Realm, s.RootModule().Resources["keycloak_authentication_subflow.subflow"].Primary.ID), nil
+				},
+				ExpectError: regexp.MustCompile("authentication subflow .+ does not exist in flow browser of realm " + testAccRealm.Realm),
+			},
+		},
+	})
+}
+
+func TestAuthenticationSubFlowImportId(t *testing.T) {
+	t.Parallel()
+
+	for id, expected := range map[string][]string{
+		"realm/parent/id":          {"realm", "parent", "id"},
+		"realm/parent/flow/id":     {"realm", "parent/flow", "id"},
+		"realm/parent flow 2/id-1": {"realm", "parent flow 2", "id-1"},
+	} {
+		realmId, parentFlowAlias, subFlowId, err := parseAuthenticationSubFlowImportId(id)
+		if err != nil {
+			t.Errorf("expected import id %s to be valid, got %s", id, err)
+			continue
+		}
+
+		if realmId != expected[0] || parentFlowAlias != expected[1] || subFlowId != expected[2] {
+			t.Errorf("expected import id %s to be parsed as %v, got [%s %s %s]", id, expected, realmId, parentFlowAlias, subFlowId)
+		}
+	}
+
+	for _, id := range []string{"", "realm", "realm/id", "/parent/id", "realm//id", "realm/parent/", "realm/parent"} {
+		if _, _, _, err := parseAuthenticationSubFlowImportId(id); err == nil {
+			t.Errorf("expected import id %q to be invalid", id)
+		}
+	}
+}
+
 func TestAccKeycloakAuthenticationSubFlow_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 
@@ -68,6 +151,52 @@ func TestAccKeycloakAuthenticationSubFlow_createAfterManualDestroy(t *testing.T)
 	})
 }
 
+func TestAccKeycloakAuthenticationSubFlow_deleteAfterReorderWithNestedExecutions(t *testing.T) {
+	t.Parallel()
+
+	var authenticationSubFlow = &keycloak.AuthenticationSubFlow{}
+
+	authParentFlowAlias := acctest.RandomWithPrefix("tf-acc")
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationSubFlow_nested(authParentFlowAlias, authFlowAlias),
+				Check:  testAccCheckKeycloakAuthenticationSubFlowFetch("keycloak_authentication_subflow.subflow", authenticationSubFlow),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.RaiseAuthenticationSubFlowPriority(testCtx, authenticationSubFlow.RealmId, authenticationSubFlow.ParentFlowAlias, authenticationSubFlow.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.DeleteAuthenticationSubFlow(testCtx, authenticationSubFlow.RealmId, authenticationSubFlow.ParentFlowAlias, authenticationSubFlow.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					// deleting a subflow which is already gone succeeds
+					err = keycloakClient.DeleteAuthenticationSubFlow(testCtx, authenticationSubFlow.RealmId, authenticationSubFlow.ParentFlowAlias, authenticationSubFlow.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAuthenticationSubFlow_nested(authParentFlowAlias, authFlowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationSubFlowExists("keycloak_authentication_subflow.subflow"),
+					testAccCheckKeycloakAuthenticationExecutionExists("keycloak_authentication_execution.otp-form"),
+					testAccCheckKeycloakAuthenticationExecutionExists("keycloak_authentication_execution.username-password-form"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakAuthenticationSubFlow_updateAuthenticationSubFlow(t *testing.T) {
 	t.Parallel()
 
@@ -134,6 +263,31 @@ func TestAccKeycloakAuthenticationSubFlow_updateAuthenticationSubFlowRequirement
 	})
 }
 
+func TestAccKeycloakAuthenticationSubFlow_conditionalRequirement(t *testing.T) {
+	t.Parallel()
+	authParentFlowAlias := acctest.RandomWithPrefix("tf-acc")
+	authSubFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationSubFlow_withProviderIdAndRequirement(authParentFlowAlias, authSubFlowAlias, "form-flow", "CONDITIONAL"),
+				ExpectError: regexp.MustCompile("requirement CONDITIONAL is only supported for basic-flow subflows"),
+			},
+			{
+				Config: testKeycloakAuthenticationSubFlow_withProviderIdAndRequirement(authParentFlowAlias, authSubFlowAlias, "basic-flow", "CONDITIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationSubFlowExists("keycloak_authentication_subflow.subflow"),
+					resource.TestCheckResourceAttr("keycloak_authentication_subflow.subflow", "requirement", "CONDITIONAL"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakAuthenticationSubFlow_updateAuthenticationSubFlowPriority(t *testing.T) {
 	t.Parallel()
 
@@ -449,6 +603,28 @@ resource "keycloak_authentication_subflow" "subflow" {
 	`, testAccRealm.Realm, parentAlias, alias, requirement)
 }
 
+func testKeycloakAuthenticationSubFlow_withProviderIdAndRequirement(parentAlias, alias, providerId, requirement string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_subflow" "subflow" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+
+	alias       = "%s"
+	provider_id = "%s"
+	requirement = "%s"
+}
+	`, testAccRealm.Realm, parentAlias, alias, providerId, requirement)
+}
+
 func testKeycloakAuthenticationSubFlow_basicWithPriority(parentAlias, alias string, priority int) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
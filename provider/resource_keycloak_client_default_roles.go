@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakClientDefaultRoles() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientDefaultRolesReconcile,
+		ReadContext:   resourceKeycloakClientDefaultRolesRead,
+		DeleteContext: resourceKeycloakClientDefaultRolesDelete,
+		UpdateContext: resourceKeycloakClientDefaultRolesReconcile,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakClientDefaultRolesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID (not the client_id) of the client whose roles are assigned to new users.",
+			},
+			"default_roles": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Client level roles assigned to new users.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func clientDefaultRolesId(realmId, clientId string) string {
+	return fmt.Sprintf("%s/%s", realmId, clientId)
+}
+
+func getRealmDefaultRole(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string) (*keycloak.Role, error) {
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	if realm.DefaultRole == nil || realm.DefaultRole.Id == "" {
+		return nil, fmt.Errorf("realm %s does not have a default role", realmId)
+	}
+
+	return &keycloak.Role{
+		RealmId: realmId,
+		Id:      realm.DefaultRole.Id,
+	}, nil
+}
+
+func resourceKeycloakClientDefaultRolesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	defaultRole, err := getRealmDefaultRole(ctx, keycloakClient, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	composites, err := keycloakClient.GetDefaultClientRoles(ctx, realmId, defaultRole.Id, clientId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("default_roles", getDefaultRoleNames(composites))
+	data.SetId(clientDefaultRolesId(realmId, clientId))
+
+	return nil
+}
+
+func resourceKeycloakClientDefaultRolesReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	if ok, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_13); !ok && err == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "this resource requires Keycloak v13 or higher",
+		}}
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	tfDefaultRoles := data.Get("default_roles").(*schema.Set)
+
+	defaultRole, err := getRealmDefaultRole(ctx, keycloakClient, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	composites, err := keycloakClient.GetDefaultClientRoles(ctx, realmId, defaultRole.Id, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientRoles, err := keycloakClient.GetClientRoles(ctx, realmId, []*keycloak.OpenidClient{{Id: clientId}})
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return diag.FromErr(fmt.Errorf("validation error: client with id %s does not exist", clientId))
+		}
+		return diag.FromErr(err)
+	}
+
+	var putList, deleteList []*keycloak.Role
+	for _, composite := range composites {
+		// if this role is a default in keycloak and tf state, no update is required
+		// remove it from the set so we can look at roles that need to be added later
+		if tfDefaultRoles.Contains(composite.Name) {
+			tfDefaultRoles.Remove(composite.Name)
+		} else {
+			deleteList = append(deleteList, composite)
+		}
+	}
+
+	for _, roleName := range interfaceSliceToStringSlice(tfDefaultRoles.List()) {
+		role, err := getRoleByNameFromList(clientRoles, roleName)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("validation error: client %s does not have a role named %s", clientId, roleName))
+		}
+		putList = append(putList, role)
+	}
+
+	if len(putList) > 0 {
+		err := keycloakClient.AddCompositesToRole(ctx, defaultRole, putList)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if len(deleteList) > 0 {
+		err := keycloakClient.RemoveCompositesFromRole(ctx, defaultRole, deleteList)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(clientDefaultRolesId(realmId, clientId))
+
+	return resourceKeycloakClientDefaultRolesRead(ctx, data, meta)
+}
+
+// remove this client's roles from the realm's default role, leaving realm roles and other clients untouched
+func resourceKeycloakClientDefaultRolesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	defaultRole, err := getRealmDefaultRole(ctx, keycloakClient, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	composites, err := keycloakClient.GetDefaultClientRoles(ctx, realmId, defaultRole.Id, clientId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if len(composites) > 0 {
+		err := keycloakClient.RemoveCompositesFromRole(ctx, defaultRole, composites)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakClientDefaultRolesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realmId}}/{{clientId}}.")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+
+	diagnostics := resourceKeycloakClientDefaultRolesRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakClientDefaultRoles_basic(t *testing.T) {
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientDefaultRoles_basic(clientId, []string{"role-one"}),
+				Check:  testAccCheckKeycloakClientDefaultRoles("keycloak_client_default_roles.default_roles", []string{"role-one"}),
+			},
+			{
+				Config: testKeycloakClientDefaultRoles_basic(clientId, []string{"role-one", "role-two"}),
+				Check:  testAccCheckKeycloakClientDefaultRoles("keycloak_client_default_roles.default_roles", []string{"role-one", "role-two"}),
+			},
+			{
+				Config: testKeycloakClientDefaultRoles_basic(clientId, []string{"role-two"}),
+				Check:  testAccCheckKeycloakClientDefaultRoles("keycloak_client_default_roles.default_roles", []string{"role-two"}),
+			},
+			{
+				ResourceName:      "keycloak_client_default_roles.default_roles",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientDefaultRoles_doesNotAffectRealmDefaults(t *testing.T) {
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientDefaultRoles_basic(clientId, []string{"role-one"}),
+				Check:  testAccCheckKeycloakRealmDefaultRolesContain(testAccRealm.Realm, "offline_access"),
+			},
+			{
+				Config: testKeycloakClientDefaultRoles_noDefaults(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmDefaultRolesContain(testAccRealm.Realm, "offline_access"),
+					testAccCheckKeycloakClientDefaultRolesEmpty("keycloak_openid_client.client"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakClientDefaultRoles(resourceName string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		clientId := rs.Primary.Attributes["client_id"]
+
+		realm, err := keycloakClient.GetRealm(testCtx, realmId)
+		if err != nil {
+			return err
+		}
+
+		composites, err := keycloakClient.GetDefaultClientRoles(testCtx, realmId, realm.DefaultRole.Id, clientId)
+		if err != nil {
+			return err
+		}
+
+		actual := getDefaultRoleNames(composites)
+		sort.Strings(actual)
+		sort.Strings(expected)
+
+		if !roleListsEqual(actual, expected) {
+			return fmt.Errorf("expected client %s default roles to be %v, got %v", clientId, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakClientDefaultRolesEmpty(clientResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[clientResourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", clientResourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+
+		realm, err := keycloakClient.GetRealm(testCtx, realmId)
+		if err != nil {
+			return err
+		}
+
+		composites, err := keycloakClient.GetDefaultClientRoles(testCtx, realmId, realm.DefaultRole.Id, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(composites) != 0 {
+			return fmt.Errorf("expected client %s to have no default roles, got %d", rs.Primary.ID, len(composites))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmDefaultRolesContain(realmId, roleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := keycloakClient.GetRealm(testCtx, realmId)
+		if err != nil {
+			return err
+		}
+
+		composites, err := keycloakClient.GetDefaultRoles(testCtx, realmId, realm.DefaultRole.Id)
+		if err != nil {
+			return err
+		}
+
+		if !roleListContains(getDefaultRoleNames(composites), roleName) {
+			return fmt.Errorf("expected realm %s default roles to contain %s", realmId, roleName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakClientDefaultRoles_noDefaults(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+}
+
+resource "keycloak_role" "role_one" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "role-one"
+}
+
+resource "keycloak_role" "role_two" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+	name      = "role-two"
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakClientDefaultRoles_basic(clientId string, defaultRoles []string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_client_default_roles" "default_roles" {
+	realm_id      = data.keycloak_realm.realm.id
+	client_id     = keycloak_openid_client.client.id
+	default_roles = %s
+
+	depends_on = [
+		keycloak_role.role_one,
+		keycloak_role.role_two,
+	]
+}
+	`, testKeycloakClientDefaultRoles_noDefaults(clientId), arrayOfStringsForTerraformResource(defaultRoles))
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakClientInitialAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientInitialAccessTokenCreate,
+		ReadContext:   resourceKeycloakClientInitialAccessTokenRead,
+		DeleteContext: resourceKeycloakClientInitialAccessTokenDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of clients which can be registered with the token.",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Time in seconds after which the token expires, 0 for a token which doesn't expire.",
+			},
+			"remaining_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of clients which can still be registered with the token.",
+			},
+			"timestamp": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the token was created at, in seconds since the epoch.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The initial access token, which Keycloak only returns when it's created.",
+			},
+		},
+	}
+}
+
+func setClientInitialAccessTokenData(data *schema.ResourceData, token *keycloak.ClientInitialAccessToken) {
+	data.SetId(token.Id)
+
+	data.Set("realm_id", token.RealmId)
+	data.Set("client_count", token.Count)
+	data.Set("expiration", token.Expiration)
+	data.Set("remaining_count", token.RemainingCount)
+	data.Set("timestamp", token.Timestamp)
+}
+
+func resourceKeycloakClientInitialAccessTokenCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	token := &keycloak.ClientInitialAccessToken{
+		RealmId:    data.Get("realm_id").(string),
+		Count:      data.Get("client_count").(int),
+		Expiration: data.Get("expiration").(int),
+	}
+
+	err := keycloakClient.NewClientInitialAccessToken(ctx, token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the token can't be read back, so it's only set here and kept in state by reads
+	data.Set("token", token.Token)
+	setClientInitialAccessTokenData(data, token)
+
+	return resourceKeycloakClientInitialAccessTokenRead(ctx, data, meta)
+}
+
+func resourceKeycloakClientInitialAccessTokenRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// tokens which expired or were used up are deleted by Keycloak, so they are created again like tokens deleted by hand
+	token, err := keycloakClient.GetClientInitialAccessToken(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setClientInitialAccessTokenData(data, token)
+
+	return nil
+}
+
+func resourceKeycloakClientInitialAccessTokenDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DeleteClientInitialAccessToken(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
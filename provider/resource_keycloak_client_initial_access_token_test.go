@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakClientInitialAccessToken_basic(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_initial_access_token.token"
+
+	var token string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientInitialAccessTokenDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientInitialAccessToken_basic(realmName, 2, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "expiration", "3600"),
+					resource.TestMatchResourceAttr(resourceName, "token", regexp.MustCompile(`.+`)),
+					func(s *terraform.State) error {
+						token = s.RootModule().Resources[resourceName].Primary.Attributes["token"]
+						return nil
+					},
+				),
+			},
+			{
+				// registering a client uses up the token once, the value of the token must be kept in state by the refresh
+				PreConfig: func() {
+					err := registerKeycloakClientWithInitialAccessToken(realmName, acctest.RandomWithPrefix("tf-acc"), token)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakClientInitialAccessToken_basic(realmName, 2, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "client_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_count", "1"),
+					func(s *terraform.State) error {
+						if actual := s.RootModule().Resources[resourceName].Primary.Attributes["token"]; actual != token {
+							return fmt.Errorf("expected the token to be kept in state")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testKeycloakClientInitialAccessToken_basic(realmName, 5, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "remaining_count", "5"),
+					resource.TestCheckResourceAttr(resourceName, "expiration", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientInitialAccessToken_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_initial_access_token.token"
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientInitialAccessTokenDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientInitialAccessToken_basic(realmName, 1, 3600),
+				Check: func(s *terraform.State) error {
+					id = s.RootModule().Resources[resourceName].Primary.ID
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteClientInitialAccessToken(testCtx, realmName, id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakClientInitialAccessToken_basic(realmName, 1, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "remaining_count", "1"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[resourceName].Primary.ID == id {
+							return fmt.Errorf("expected a new token to be created")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientInitialAccessToken_createAfterUsedUp(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_initial_access_token.token"
+
+	var id, token string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientInitialAccessTokenDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientInitialAccessToken_basic(realmName, 1, 3600),
+				Check: func(s *terraform.State) error {
+					id = s.RootModule().Resources[resourceName].Primary.ID
+					token = s.RootModule().Resources[resourceName].Primary.Attributes["token"]
+					return nil
+				},
+			},
+			{
+				// Keycloak deletes the token once it registered its only client
+				PreConfig: func() {
+					err := registerKeycloakClientWithInitialAccessToken(realmName, acctest.RandomWithPrefix("tf-acc"), token)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakClientInitialAccessToken_basic(realmName, 1, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "remaining_count", "1"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources[resourceName]
+						if rs.Primary.ID == id || rs.Primary.Attributes["token"] == token {
+							return fmt.Errorf("expected a new token to be created")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// registers a client the way a downstream pipeline would, with the client registration service of the realm
+func registerKeycloakClientWithInitialAccessToken(realm, clientId, token string) error {
+	resourceUrl := fmt.Sprintf("%s/realms/%s/clients-registrations/default", os.Getenv("KEYCLOAK_URL"), realm)
+
+	request, err := http.NewRequest(http.MethodPost, resourceUrl, strings.NewReader(fmt.Sprintf(`{"clientId": "%s"}`, clientId)))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Content-Type", "application/json")
+	request.Header.Add("Authorization", "Bearer "+token)
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("client %s couldn't be registered with the initial access token\n body: %s", clientId, string(body))
+	}
+
+	return nil
+}
+
+func testAccCheckKeycloakClientInitialAccessTokenDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_client_initial_access_token" {
+				continue
+			}
+
+			token, _ := keycloakClient.GetClientInitialAccessToken(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if token != nil {
+				return fmt.Errorf("client initial access token with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakClientInitialAccessToken_basic(realm string, count, expiration int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_initial_access_token" "token" {
+	realm_id     = keycloak_realm.realm.id
+	client_count = %d
+	expiration   = %d
+}
+	`, realm, count, expiration)
+}
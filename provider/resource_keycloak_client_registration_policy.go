@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakClientRegistrationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientRegistrationPolicyCreate,
+		ReadContext:   resourceKeycloakClientRegistrationPolicyRead,
+		UpdateContext: resourceKeycloakClientRegistrationPolicyUpdate,
+		DeleteContext: resourceKeycloakClientRegistrationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			// {{realmId}}/{{policyId}}, same as the keystores, as both are realm components
+			StateContext: resourceKeycloakRealmKeystoreGenericImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the policy in the admin console.",
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.All(validation.StringIsNotEmpty, validateClientRegistrationPolicyProviderId),
+				Description:  "The type of the policy, ex. trusted-hosts, max-clients or allowed-protocol-mappers.",
+			},
+			"sub_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloakClientRegistrationPolicySubTypes, false),
+				Description:  "Whether the policy applies to anonymous or authenticated client registration requests.",
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The configuration of the policy. Multiple values are separated with ##.",
+			},
+		},
+	}
+}
+
+// validateClientRegistrationPolicyProviderId rejects the policies which have their own resource, as both resources would
+// manage the same component.
+func validateClientRegistrationPolicyProviderId(i interface{}, k string) ([]string, []error) {
+	if i.(string) == "allowed-client-templates" {
+		return nil, []error{fmt.Errorf("%s allowed-client-templates is managed with keycloak_realm_allowed_client_scopes_policy", k)}
+	}
+
+	return nil, nil
+}
+
+func getClientRegistrationPolicyFromData(data *schema.ResourceData) *keycloak.ClientRegistrationPolicy {
+	config := map[string][]string{}
+	for key, value := range data.Get("config").(map[string]interface{}) {
+		config[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	return &keycloak.ClientRegistrationPolicy{
+		Id:         data.Id(),
+		Name:       data.Get("name").(string),
+		RealmId:    data.Get("realm_id").(string),
+		ProviderId: data.Get("provider_id").(string),
+		SubType:    data.Get("sub_type").(string),
+		Config:     config,
+	}
+}
+
+func setClientRegistrationPolicyData(data *schema.ResourceData, policy *keycloak.ClientRegistrationPolicy) {
+	config := map[string]string{}
+	for key, value := range policy.Config {
+		config[key] = strings.Join(value, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	data.SetId(policy.Id)
+
+	data.Set("name", policy.Name)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("provider_id", policy.ProviderId)
+	data.Set("sub_type", policy.SubType)
+	data.Set("config", config)
+}
+
+func resourceKeycloakClientRegistrationPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getClientRegistrationPolicyFromData(data)
+
+	err := keycloakClient.NewClientRegistrationPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setClientRegistrationPolicyData(data, policy)
+
+	return resourceKeycloakClientRegistrationPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakClientRegistrationPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	policy, err := keycloakClient.GetClientRegistrationPolicy(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setClientRegistrationPolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakClientRegistrationPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getClientRegistrationPolicyFromData(data)
+
+	err := keycloakClient.UpdateClientRegistrationPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setClientRegistrationPolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakClientRegistrationPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteClientRegistrationPolicy(ctx, realmId, id))
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakClientRegistrationPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_client_registration_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckClientRegistrationPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationPolicy_trustedHosts(realmName, policyName, "example.com##example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientRegistrationPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config.trusted-hosts", "example.com##example.org"),
+					resource.TestCheckResourceAttr(resourceName, "config.client-uris-must-match", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+			{
+				Config: testKeycloakClientRegistrationPolicy_trustedHosts(realmName, policyName, "example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientRegistrationPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config.trusted-hosts", "example.net"),
+				),
+			},
+			{
+				Config: testKeycloakClientRegistrationPolicy_maxClients(realmName, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClientRegistrationPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provider_id", "max-clients"),
+					resource.TestCheckResourceAttr(resourceName, "config.max-clients", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationPolicy_subTypeValidation(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckClientRegistrationPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "keycloak_client_registration_policy" "policy" {
+	name        = "%s"
+	realm_id    = "%s"
+	provider_id = "consent-required"
+	sub_type    = "%s"
+}
+				`, acctest.RandomWithPrefix("tf-acc"), testAccRealm.Realm, acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("expected sub_type to be one of .+ got .+"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationPolicy_allowedClientTemplatesRejected(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckClientRegistrationPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "keycloak_client_registration_policy" "policy" {
+	name        = "%s"
+	realm_id    = "%s"
+	provider_id = "allowed-client-templates"
+	sub_type    = "anonymous"
+}
+				`, acctest.RandomWithPrefix("tf-acc"), testAccRealm.Realm),
+				ExpectError: regexp.MustCompile("allowed-client-templates is managed with keycloak_realm_allowed_client_scopes_policy"),
+			},
+		},
+	})
+}
+
+func testAccCheckClientRegistrationPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakClientRegistrationPolicyFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckClientRegistrationPolicyDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_client_registration_policy" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			policy, _ := keycloakClient.GetClientRegistrationPolicy(testCtx, realm, id)
+			if policy != nil {
+				return fmt.Errorf("client registration policy with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakClientRegistrationPolicyFromState(s *terraform.State, resourceName string) (*keycloak.ClientRegistrationPolicy, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	policy, err := keycloakClient.GetClientRegistrationPolicy(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client registration policy with id %s: %s", id, err)
+	}
+
+	return policy, nil
+}
+
+func testKeycloakClientRegistrationPolicy_trustedHosts(realm, policyName, trustedHosts string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_policy" "policy" {
+	realm_id    = keycloak_realm.realm.id
+	name        = "%s"
+	provider_id = "trusted-hosts"
+	sub_type    = "anonymous"
+
+	config = {
+		trusted-hosts                                = "%s"
+		host-sending-registration-request-must-match = "true"
+		client-uris-must-match                       = "true"
+	}
+}
+	`, realm, policyName, trustedHosts)
+}
+
+func testKeycloakClientRegistrationPolicy_maxClients(realm, policyName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_policy" "policy" {
+	realm_id    = keycloak_realm.realm.id
+	name        = "%s"
+	provider_id = "max-clients"
+	sub_type    = "anonymous"
+
+	config = {
+		max-clients = "50"
+	}
+}
+	`, realm, policyName)
+}
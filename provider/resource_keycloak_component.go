@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakComponentCreate,
+		ReadContext:   resourceKeycloakComponentRead,
+		UpdateContext: resourceKeycloakComponentUpdate,
+		DeleteContext: resourceKeycloakComponentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakComponentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the component.",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the provider implementing this component, for example rsa-generated.",
+			},
+			"provider_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The provider type of this component, for example org.keycloak.keys.KeyProvider.",
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the parent of this component. Defaults to the realm's internal ID.",
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Configuration of the component. Multiple values for a key can be separated with ##.",
+			},
+			"sensitive_config": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Configuration of the component that Keycloak treats as secret, such as private keys or bind credentials. Multiple values for a key can be separated with ##.",
+			},
+		},
+	}
+}
+
+func getComponentFromData(data *schema.ResourceData, realmInternalId string) (*keycloak.GenericComponent, error) {
+	config := map[string][]string{}
+	for _, attribute := range []string{"config", "sensitive_config"} {
+		for key, value := range data.Get(attribute).(map[string]interface{}) {
+			if _, ok := config[key]; ok {
+				return nil, fmt.Errorf("config key %s can't be set in both config and sensitive_config", key)
+			}
+			config[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+	}
+
+	// explicitly clear config keys that were removed from the configuration
+	if !data.IsNewResource() {
+		for _, attribute := range []string{"config", "sensitive_config"} {
+			oldConfig, _ := data.GetChange(attribute)
+			for key := range oldConfig.(map[string]interface{}) {
+				if _, ok := config[key]; !ok {
+					config[key] = []string{}
+				}
+			}
+		}
+	}
+
+	parentId := data.Get("parent_id").(string)
+	if parentId == "" {
+		parentId = realmInternalId
+	}
+
+	return &keycloak.GenericComponent{
+		Id:           data.Id(),
+		RealmId:      data.Get("realm_id").(string),
+		Name:         data.Get("name").(string),
+		ProviderId:   data.Get("provider_id").(string),
+		ProviderType: data.Get("provider_type").(string),
+		ParentId:     parentId,
+		Config:       config,
+	}, nil
+}
+
+func setComponentData(data *schema.ResourceData, genericComponent *keycloak.GenericComponent) {
+	data.SetId(genericComponent.Id)
+
+	data.Set("realm_id", genericComponent.RealmId)
+	data.Set("name", genericComponent.Name)
+	data.Set("provider_id", genericComponent.ProviderId)
+	data.Set("provider_type", genericComponent.ProviderType)
+	data.Set("parent_id", genericComponent.ParentId)
+
+	data.Set("config", getComponentConfigData(data, "config", genericComponent))
+	data.Set("sensitive_config", getComponentConfigData(data, "sensitive_config", genericComponent))
+}
+
+// keycloak fills in defaults for config keys that weren't specified, so only keys from the configuration are kept. Secret values
+// are masked by keycloak, so the value from the configuration is kept for those.
+func getComponentConfigData(data *schema.ResourceData, attribute string, genericComponent *keycloak.GenericComponent) map[string]string {
+	configFromState := data.Get(attribute).(map[string]interface{})
+	config := map[string]string{}
+	for key, valueFromState := range configFromState {
+		value, ok := genericComponent.Config[key]
+		if !ok || len(value) == 0 {
+			continue
+		}
+
+		if len(value) == 1 && value[0] == "**********" {
+			config[key] = valueFromState.(string)
+		} else {
+			config[key] = strings.Join(value, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+	}
+
+	return config
+}
+
+func resourceKeycloakComponentCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	genericComponent, err := getComponentFromData(data, realm.Id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewGenericComponent(ctx, genericComponent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(genericComponent.Id)
+
+	return resourceKeycloakComponentRead(ctx, data, meta)
+}
+
+func resourceKeycloakComponentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	genericComponent, err := keycloakClient.GetGenericComponent(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setComponentData(data, genericComponent)
+
+	return nil
+}
+
+func resourceKeycloakComponentUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	genericComponent, err := getComponentFromData(data, realm.Id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateGenericComponent(ctx, genericComponent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakComponentRead(ctx, data, meta)
+}
+
+func resourceKeycloakComponentDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteGenericComponent(ctx, realmId, id))
+}
+
+func resourceKeycloakComponentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{componentId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakComponentRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakComponent_keyProvider(t *testing.T) {
+	t.Parallel()
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakComponentDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakComponent_keyProvider(name, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakComponentConfig("keycloak_component.component", "priority", "100"),
+					resource.TestCheckResourceAttr("keycloak_component.component", "config.%", "2"),
+					resource.TestCheckResourceAttrPair("keycloak_component.component", "parent_id", "data.keycloak_realm.realm", "internal_id"),
+				),
+			},
+			{
+				Config: testKeycloakComponent_keyProvider(name, "200"),
+				Check:  testAccCheckKeycloakComponentConfig("keycloak_component.component", "priority", "200"),
+			},
+			{
+				ResourceName:            "keycloak_component.component",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakComponent_sensitiveConfig(t *testing.T) {
+	t.Parallel()
+	name := acctest.RandomWithPrefix("tf-acc")
+	privateKey, certificate := generateKeyAndCert(2048)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakComponentDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakComponent_sensitiveConfig(name, privateKey, certificate, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakComponentConfig("keycloak_component.component", "priority", "100"),
+					// keycloak masks the private key, so the configured value has to be kept
+					resource.TestCheckResourceAttr("keycloak_component.component", "sensitive_config.privateKey", privateKey),
+				),
+			},
+			{
+				Config: testKeycloakComponent_sensitiveConfig(name, privateKey, certificate, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakComponentConfig("keycloak_component.component", "priority", "200"),
+					resource.TestCheckResourceAttr("keycloak_component.component", "sensitive_config.privateKey", privateKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakComponentConfig(resourceName, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		component, err := keycloakClient.GetGenericComponent(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting component with id %s: %s", rs.Primary.ID, err)
+		}
+
+		if len(component.Config[key]) != 1 || component.Config[key][0] != value {
+			return fmt.Errorf("expected component config %s to be %s, got %v", key, value, component.Config[key])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakComponentDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_component" {
+				continue
+			}
+
+			component, _ := keycloakClient.GetGenericComponent(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if component != nil {
+				return fmt.Errorf("component with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakComponent_keyProvider(name, priority string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_component" "component" {
+	realm_id      = data.keycloak_realm.realm.id
+	name          = "%s"
+	provider_id   = "rsa-generated"
+	provider_type = "org.keycloak.keys.KeyProvider"
+
+	config = {
+		priority = "%s"
+		keySize  = "2048"
+	}
+}
+	`, testAccRealm.Realm, name, priority)
+}
+
+func testKeycloakComponent_sensitiveConfig(name, privateKey, certificate, priority string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_component" "component" {
+	realm_id      = data.keycloak_realm.realm.id
+	name          = "%s"
+	provider_id   = "rsa"
+	provider_type = "org.keycloak.keys.KeyProvider"
+
+	config = {
+		priority    = "%s"
+		certificate = "%s"
+	}
+
+	sensitive_config = {
+		privateKey = "%s"
+	}
+}
+	`, testAccRealm.Realm, name, priority, certificate, privateKey)
+}
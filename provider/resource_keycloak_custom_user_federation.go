@@ -63,11 +63,14 @@ func resourceKeycloakCustomUserFederation() *schema.Resource {
 			},
 
 			"cache_policy": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice(keycloakUserFederationCachePolicies, false),
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "DEFAULT",
+				ValidateFunc:  validation.StringInSlice(keycloakUserFederationCachePolicies, false),
+				ConflictsWith: []string{"cache"},
+				Deprecated:    "use the policy of the cache block instead",
 			},
+			"cache": userFederationCacheSchema(),
 
 			"full_sync_period": {
 				Type:         schema.TypeInt,
@@ -85,13 +88,44 @@ func resourceKeycloakCustomUserFederation() *schema.Resource {
 			},
 
 			"config": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateCustomUserFederationConfig,
 			},
 		},
 	}
 }
 
+// customUserFederationConfigArguments maps the config keys of the component which are managed by arguments of the
+// resource to these arguments, they would be overwritten by the arguments when given in config.
+var customUserFederationConfigArguments = map[string]string{
+	"enabled":           "enabled",
+	"priority":          "priority",
+	"cachePolicy":       "cache",
+	"maxLifespan":       "cache",
+	"evictionDay":       "cache",
+	"evictionHour":      "cache",
+	"evictionMinute":    "cache",
+	"fullSyncPeriod":    "full_sync_period",
+	"changedSyncPeriod": "changed_sync_period",
+}
+
+func validateCustomUserFederationConfig(i interface{}, k string) ([]string, []error) {
+	config, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be a map", k)}
+	}
+
+	var errs []error
+	for key := range config {
+		if argument, ok := customUserFederationConfigArguments[key]; ok {
+			errs = append(errs, fmt.Errorf("%s can't contain %s, it's managed by the %s argument", k, key, argument))
+		}
+	}
+
+	return nil, errs
+}
+
 func getCustomUserFederationFromData(data *schema.ResourceData, realmInternalId string) *keycloak.CustomUserFederation {
 	config := map[string][]string{}
 	if v, ok := data.GetOk("config"); ok {
@@ -108,7 +142,7 @@ func getCustomUserFederationFromData(data *schema.ResourceData, realmInternalId
 		parentId = realmInternalId
 	}
 
-	return &keycloak.CustomUserFederation{
+	custom := &keycloak.CustomUserFederation{
 		Id:         data.Id(),
 		Name:       data.Get("name").(string),
 		RealmId:    data.Get("realm_id").(string),
@@ -118,13 +152,19 @@ func getCustomUserFederationFromData(data *schema.ResourceData, realmInternalId
 		Enabled:  data.Get("enabled").(bool),
 		Priority: data.Get("priority").(int),
 
-		CachePolicy: data.Get("cache_policy").(string),
-
 		FullSyncPeriod:    data.Get("full_sync_period").(int),
 		ChangedSyncPeriod: data.Get("changed_sync_period").(int),
 
 		Config: config,
 	}
+
+	if cache, ok := getUserFederationCacheFromData(data); ok {
+		custom.UserFederationCache = *cache
+	} else {
+		custom.CachePolicy = data.Get("cache_policy").(string)
+	}
+
+	return custom
 }
 
 func setCustomUserFederationData(data *schema.ResourceData, custom *keycloak.CustomUserFederation, realmId string) {
@@ -142,7 +182,11 @@ func setCustomUserFederationData(data *schema.ResourceData, custom *keycloak.Cus
 	data.Set("full_sync_period", custom.FullSyncPeriod)
 	data.Set("changed_sync_period", custom.ChangedSyncPeriod)
 
-	data.Set("cache_policy", custom.CachePolicy)
+	if _, ok := data.GetOk("cache"); ok {
+		setUserFederationCacheData(data, &custom.UserFederationCache)
+	} else {
+		data.Set("cache_policy", custom.CachePolicy)
+	}
 
 	config := map[string]string{}
 	for k, v := range custom.Config {
@@ -74,6 +74,51 @@ func TestAccKeycloakCustomUserFederation_customConfig(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakCustomUserFederation_cachePolicy(t *testing.T) {
+	t.Parallel()
+
+	skipIfEnvSet(t, "CI") // temporary while I figure out how to load this custom provider in CI
+
+	name := acctest.RandomWithPrefix("tf-acc")
+	providerId := "custom"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakCustomUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakCustomUserFederation_cachePolicy(name, providerId, "EVICT_WEEKLY", `
+		eviction_day    = 2
+		eviction_hour   = 4
+		eviction_minute = 30`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakCustomUserFederationExists("keycloak_custom_user_federation.custom"),
+					resource.TestCheckResourceAttr("keycloak_custom_user_federation.custom", "cache.0.eviction_day", "2"),
+					resource.TestCheckResourceAttr("keycloak_custom_user_federation.custom", "cache.0.eviction_hour", "4"),
+					resource.TestCheckResourceAttr("keycloak_custom_user_federation.custom", "cache.0.eviction_minute", "30"),
+				),
+			},
+			{
+				Config: testKeycloakCustomUserFederation_cachePolicy(name, providerId, "MAX_LIFESPAN", `
+		max_lifespan = "1h"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_custom_user_federation.custom", "cache.0.max_lifespan", "1h0m0s"),
+					resource.TestCheckResourceAttr("keycloak_custom_user_federation.custom", "cache.0.eviction_day", "-1"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_custom_user_federation.custom",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+				// like for ldap user federation, imported cache settings are read into cache_policy
+				ImportStateVerifyIgnore: []string{"cache", "cache_policy"},
+			},
+		},
+	})
+}
+
 func TestAccKeycloakCustomUserFederation_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 
@@ -122,6 +167,10 @@ func TestAccKeycloakCustomUserFederation_validation(t *testing.T) {
 				Config:      testKeycloakCustomUserFederation_basic(name, providerId),
 				ExpectError: regexp.MustCompile("custom user federation provider with id .+ is not installed on the server"),
 			},
+			{
+				Config:      testKeycloakCustomUserFederation_configManagedByArgument(name, providerId),
+				ExpectError: regexp.MustCompile("config can't contain cachePolicy, it's managed by the cache argument"),
+			},
 		},
 	})
 }
@@ -259,6 +308,43 @@ resource "keycloak_custom_user_federation" "custom" {
 	`, testAccRealm.Realm, name, providerId)
 }
 
+func testKeycloakCustomUserFederation_cachePolicy(name, providerId, cachePolicy, cacheSettings string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_custom_user_federation" "custom" {
+	name        = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	provider_id = "%s"
+
+	cache {
+		policy = "%s"
+%s
+	}
+}
+	`, testAccRealm.Realm, name, providerId, cachePolicy, cacheSettings)
+}
+
+func testKeycloakCustomUserFederation_configManagedByArgument(name, providerId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_custom_user_federation" "custom" {
+	name        = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	provider_id = "%s"
+
+	config = {
+		cachePolicy = "NO_CACHE"
+	}
+}
+	`, testAccRealm.Realm, name, providerId)
+}
+
 func testKeycloakCustomUserFederation_customConfig(name, providerId, customConfigValue string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
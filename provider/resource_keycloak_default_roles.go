@@ -11,6 +11,10 @@ import (
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
+// keycloakDefaultRolesBaseline are the realm roles Keycloak adds to the default role of every new realm. offline_access
+// allows users to request offline tokens and uma_authorization allows them to use the authorization services.
+var keycloakDefaultRolesBaseline = []string{"offline_access", "uma_authorization"}
+
 func resourceKeycloakDefaultRoles() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKeycloakDefaultRolesReconcile,
@@ -117,6 +121,10 @@ func resourceKeycloakDefaultRolesReconcile(ctx context.Context, data *schema.Res
 
 	}
 
+	if roleListContains(defaultRoles.DefaultRoles, realm.DefaultRole.Name) {
+		return diag.Errorf("validation error: %s is the default role of realm %s, so it can't be one of its own default roles", realm.DefaultRole.Name, defaultRoles.RealmId)
+	}
+
 	data.SetId(realm.DefaultRole.Id)
 
 	composites, err := keycloakClient.GetDefaultRoles(ctx, defaultRoles.RealmId, realm.DefaultRole.Id)
@@ -138,20 +146,31 @@ func resourceKeycloakDefaultRolesReconcile(ctx context.Context, data *schema.Res
 	var putList, deleteList []*keycloak.Role
 	for _, roleName := range defaultRoles.DefaultRoles {
 		if !roleListContains(defaultRoleNamesList, roleName) {
-			defaultRoles, err := getRoleByNameFromList(rolesList, roleName)
+			defaultRole, err := getRoleByNameFromList(rolesList, roleName)
 			if err != nil {
-				return diag.FromErr(err)
+				return diag.Errorf("validation error: default role %s doesn't exist in realm %s", roleName, defaultRoles.RealmId)
 			}
-			putList = append(putList, defaultRoles)
+			putList = append(putList, defaultRole)
 		}
 	}
 	for _, roleName := range defaultRoleNamesList {
 		if !roleListContains(defaultRoles.DefaultRoles, roleName) {
-			defaultRoles, err := getRoleByNameFromList(rolesList, roleName)
+			defaultRole, err := getRoleByNameFromList(rolesList, roleName)
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			deleteList = append(deleteList, defaultRoles)
+			deleteList = append(deleteList, defaultRole)
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, role := range deleteList {
+		if roleListContains(keycloakDefaultRolesBaseline, role.Name) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%s is no longer a default role of realm %s", role.Name, defaultRoles.RealmId),
+				Detail:   "Keycloak grants this role to new users by default, users created from now on won't have it unless it is added to default_roles.",
+			})
 		}
 	}
 
@@ -177,10 +196,10 @@ func resourceKeycloakDefaultRolesReconcile(ctx context.Context, data *schema.Res
 		}
 	}
 
-	return resourceKeycloakDefaultRolesRead(ctx, data, meta)
+	return append(diags, resourceKeycloakDefaultRolesRead(ctx, data, meta)...)
 }
 
-// remove all roles from default
+// resets the default roles to the ones of a new realm, rather than leaving new users without any role
 func resourceKeycloakDefaultRolesDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -195,12 +214,36 @@ func resourceKeycloakDefaultRolesDelete(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	if len(defaultRoles) > 0 {
-		role := &keycloak.Role{
-			RealmId: realmId,
-			Id:      realm.DefaultRole.Id,
+	rolesList, err := keycloakClient.GetRealmRoles(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var putList, deleteList []*keycloak.Role
+	for _, roleName := range keycloakDefaultRolesBaseline {
+		// the baseline roles may have been deleted from the realm
+		if baselineRole, err := getRoleByNameFromList(rolesList, roleName); err == nil && !roleListContains(getDefaultRoleNames(defaultRoles), roleName) {
+			putList = append(putList, baselineRole)
 		}
-		err := keycloakClient.RemoveCompositesFromRole(ctx, role, defaultRoles)
+	}
+	for _, defaultRole := range defaultRoles {
+		if !roleListContains(keycloakDefaultRolesBaseline, defaultRole.Name) {
+			deleteList = append(deleteList, defaultRole)
+		}
+	}
+
+	role := &keycloak.Role{
+		RealmId: realmId,
+		Id:      realm.DefaultRole.Id,
+	}
+	if len(putList) > 0 {
+		err := keycloakClient.AddCompositesToRole(ctx, role, putList)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if len(deleteList) > 0 {
+		err := keycloakClient.RemoveCompositesFromRole(ctx, role, deleteList)
 		if err != nil {
 			return diag.FromErr(err)
 		}
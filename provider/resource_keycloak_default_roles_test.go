@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"regexp"
 	"testing"
 )
 
@@ -61,6 +62,25 @@ func TestAccKeycloakDefaultRoles_updateDefaultRoles(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDefaultRoles_invalidRoles(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakDefaultRoles_basicFromInterface(realmName, &keycloak.DefaultRoles{DefaultRoles: []string{"\"does-not-exist\""}}),
+				ExpectError: regexp.MustCompile("default role does-not-exist doesn't exist in realm " + realmName),
+			},
+			{
+				Config:      testKeycloakDefaultRoles_basicFromInterface(realmName, &keycloak.DefaultRoles{DefaultRoles: []string{fmt.Sprintf("\"default-roles-%s\"", realmName)}}),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("default-roles-%s is the default role of realm %s", realmName, realmName)),
+			},
+		},
+	})
+}
+
 func testAccCheckDefaultRolesExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getKeycloakDefaultRolesFromState(s, resourceName)
@@ -85,11 +105,13 @@ func testAccCheckKeycloakDefaultRolesDestroy(realmId string) resource.TestCheckF
 		}
 
 		defaultRoles := getDefaultRoleNames(composites)
-		if err != nil {
-			return err
+		if len(defaultRoles) != len(keycloakDefaultRolesBaseline) {
+			return fmt.Errorf("expected realm %s to have the default roles %v, got %v", realmId, keycloakDefaultRolesBaseline, defaultRoles)
 		}
-		if len(defaultRoles) != 0 {
-			return fmt.Errorf("realm %s still has %d default roles, expected zero", realmId, len(defaultRoles))
+		for _, roleName := range keycloakDefaultRolesBaseline {
+			if !roleListContains(defaultRoles, roleName) {
+				return fmt.Errorf("expected realm %s to have the default roles %v, got %v", realmId, keycloakDefaultRolesBaseline, defaultRoles)
+			}
 		}
 
 		return nil
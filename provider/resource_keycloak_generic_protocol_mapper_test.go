@@ -76,6 +76,15 @@ func TestAccKeycloakGenericProtocolMapper_import(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
 			},
+			// clients can also be referenced by their client id
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/client/%s/%s", testAccRealm.Realm, clientId, s.RootModule().Resources[resourceName].Primary.ID), nil
+				},
+			},
 		},
 	})
 }
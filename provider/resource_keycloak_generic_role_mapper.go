@@ -114,26 +114,34 @@ func resourceKeycloakGenericRoleMapperDelete(ctx context.Context, data *schema.R
 	return diag.FromErr(keycloakClient.DeleteRoleScopeMapping(ctx, realmId, clientId, clientScopeId, role))
 }
 
-func resourceKeycloakGenericRoleMapperImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
+func resourceKeycloakGenericRoleMapperImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	if len(parts) != 6 {
+	// client ids may contain slashes, ex. when they are URLs, so the parent resource id is everything up to /scope-mappings/
+	parts := strings.SplitN(d.Id(), "/", 3)
+	separator := strings.LastIndex(parts[len(parts)-1], "/scope-mappings/")
+	if len(parts) != 3 || separator == -1 || len(strings.Split(parts[2][separator+1:], "/")) != 3 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/client/{{clientId}}/scope-mappings/{{roleClientId}}/{{roleId}}, {{realmId}}/client-scope/{{clientScopeId}}/scope-mappings/{{roleClientId}}/{{roleId}}")
 	}
 
 	parentResourceType := parts[1]
-	parentResourceId := parts[2]
+	parentResourceId := parts[2][:separator]
+	roleParts := strings.Split(parts[2][separator+1:], "/")
 
 	d.Set("realm_id", parts[0])
 
 	if parentResourceType == "client" {
-		d.Set("client_id", parentResourceId)
+		clientId, err := getClientUuid(ctx, keycloakClient, parts[0], parentResourceId)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("client_id", clientId)
 	} else if parentResourceType == "client-scope" {
 		d.Set("client_scope_id", parentResourceId)
 	} else {
 		return nil, fmt.Errorf("the associated parent resource must be either a client or a client-scope")
 	}
 
-	d.Set("role_id", parts[5])
+	d.Set("role_id", roleParts[2])
 	return []*schema.ResourceData{d}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
@@ -40,11 +41,28 @@ func resourceKeycloakGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"sub_group_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"count_members": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the number of members of this group is fetched on every read and exposed as member_count.",
+			},
+			"member_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"attributes": {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
 		},
+		CustomizeDiff: customdiff.ComputedIf("path", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("name")
+		}),
 	}
 }
 
@@ -76,6 +94,8 @@ func mapFromGroupToData(data *schema.ResourceData, group *keycloak.Group) {
 	data.Set("realm_id", group.RealmId)
 	data.Set("name", group.Name)
 	data.Set("path", group.Path)
+	// newer versions of Keycloak only return the number of subgroups, while older versions return the subgroups themselves
+	data.Set("sub_group_count", max(group.SubGroupCount, len(group.SubGroups)))
 	data.Set("attributes", attributes)
 	if group.ParentId != "" {
 		data.Set("parent_id", group.ParentId)
@@ -110,6 +130,16 @@ func resourceKeycloakGroupRead(ctx context.Context, data *schema.ResourceData, m
 
 	mapFromGroupToData(data, group)
 
+	if data.Get("count_members").(bool) {
+		memberCount, err := keycloakClient.GetGroupMembersCount(ctx, realmId, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		data.Set("member_count", memberCount)
+	} else {
+		data.Set("member_count", nil)
+	}
+
 	return nil
 }
 
@@ -123,9 +153,8 @@ func resourceKeycloakGroupUpdate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	mapFromGroupToData(data, group)
-
-	return nil
+	// the group's path depends on its name, so it needs to be read back from Keycloak
+	return resourceKeycloakGroupRead(ctx, data, meta)
 }
 
 func resourceKeycloakGroupDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -151,6 +180,7 @@ func resourceKeycloakGroupImport(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set("realm_id", parts[0])
+	d.Set("count_members", false)
 	d.SetId(parts[1])
 
 	diagnostics := resourceKeycloakGroupRead(ctx, d, meta)
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// Unlike keycloak_group_memberships, this resource only manages the membership of a single user, so other members of the
+// group can be managed elsewhere.
+func resourceKeycloakGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakGroupMemberCreate,
+		ReadContext:   resourceKeycloakGroupMemberRead,
+		DeleteContext: resourceKeycloakGroupMemberDelete,
+		// This resource can be imported using {{realm}}/{{groupId}}/{{userId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGroupMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func groupMemberId(realmId, groupId, userId string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, groupId, userId)
+}
+
+func resourceKeycloakGroupMemberCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	groupId := data.Get("group_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := keycloakClient.AddUserToGroup(ctx, realmId, userId, groupId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(groupMemberId(realmId, groupId, userId))
+
+	return resourceKeycloakGroupMemberRead(ctx, data, meta)
+}
+
+func resourceKeycloakGroupMemberRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	groupId := data.Get("group_id").(string)
+	userId := data.Get("user_id").(string)
+
+	isMember, err := keycloakClient.IsUserMemberOfGroup(ctx, realmId, userId, groupId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if !isMember {
+		tflog.Warn(ctx, "Removing resource from state as the user is no longer a member of the group", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+		return nil
+	}
+
+	data.SetId(groupMemberId(realmId, groupId, userId))
+
+	return nil
+}
+
+func resourceKeycloakGroupMemberDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	groupId := data.Get("group_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// Keycloak doesn't fail when the user already left the group, only when the user or the group no longer exists
+	err := keycloakClient.RemoveUserFromGroup(ctx, &keycloak.User{Id: userId, RealmId: realmId}, groupId)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakGroupMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{groupId}}/{{userId}}.")
+	}
+
+	isMember, err := keycloakClient.IsUserMemberOfGroup(ctx, parts[0], parts[2], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if !isMember {
+		return nil, fmt.Errorf("user %s is not a member of group %s in realm %s", parts[2], parts[1], parts[0])
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("group_id", parts[1])
+	d.Set("user_id", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakGroupMember_basic(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	otherUsername := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupMember_basic(groupName, username, otherUsername, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserBelongsToGroup("keycloak_group.group", username),
+					testAccCheckUsersDontBelongToGroup("keycloak_group.group", []string{otherUsername}),
+				),
+			},
+			{
+				ResourceName:      "keycloak_group_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the other user joins the group outside of this resource, which must not be considered drift
+				PreConfig: func() {
+					addKeycloakGroupMemberOutsideOfTerraform(t, groupName, otherUsername)
+				},
+				Config:             testKeycloakGroupMember_basic(groupName, username, otherUsername, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				// we need a separate step for destroy instead of using CheckDestroy because this resource is implicitly
+				// destroyed at the end of each test via destroying users or groups they're tied to
+				Config: testKeycloakGroupMember_basic(groupName, username, otherUsername, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersDontBelongToGroup("keycloak_group.group", []string{username}),
+					testAccCheckUserBelongsToGroup("keycloak_group.group", otherUsername),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroupMember_createAfterManualRemoval(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	otherUsername := acctest.RandomWithPrefix("tf-acc")
+
+	var realmId, groupId, userId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupMember_basic(groupName, username, otherUsername, true),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["keycloak_group_member.member"]
+					if !ok {
+						return fmt.Errorf("resource not found: keycloak_group_member.member")
+					}
+
+					realmId = rs.Primary.Attributes["realm_id"]
+					groupId = rs.Primary.Attributes["group_id"]
+					userId = rs.Primary.Attributes["user_id"]
+
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.RemoveUserFromGroups(testCtx, []string{groupId}, userId, realmId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakGroupMember_basic(groupName, username, otherUsername, true),
+				Check:  testAccCheckUserBelongsToGroup("keycloak_group.group", username),
+			},
+		},
+	})
+}
+
+func addKeycloakGroupMemberOutsideOfTerraform(t *testing.T, groupName, username string) {
+	group, err := keycloakClient.GetGroupByName(testCtx, testAccRealm.Realm, groupName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = keycloakClient.AddUsersToGroup(testCtx, testAccRealm.Realm, group.Id, []interface{}{username})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testKeycloakGroupMember_basic(group, username, otherUsername string, member bool) string {
+	memberResource := ""
+	if member {
+		memberResource = `
+resource "keycloak_group_member" "member" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+	user_id  = keycloak_user.user.id
+}`
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user" "other_user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+%s
+	`, testAccRealm.Realm, group, username, otherUsername, memberResource)
+}
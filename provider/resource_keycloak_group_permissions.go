@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strings"
 
@@ -11,6 +12,15 @@ import (
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
+// the blocks of the resource, by the name of the scope permission Keycloak creates for them
+var keycloakGroupPermissionsScopes = map[string]string{
+	"view_scope":              "view",
+	"manage_scope":            "manage",
+	"view_members_scope":      "view-members",
+	"manage_members_scope":    "manage-members",
+	"manage_membership_scope": "manage-membership",
+}
+
 func resourceKeycloakGroupPermissions() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKeycloakGroupPermissionsCreate,
@@ -40,6 +50,12 @@ func resourceKeycloakGroupPermissions() *schema.Resource {
 				Computed:    true,
 				Description: "Resource server id representing the realm management client on which this permission is managed",
 			},
+			"scope_permission_ids": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Ids of the scope permissions Keycloak created for the group, by scope",
+			},
 			"view_scope":              scopePermissionsSchema(),
 			"manage_scope":            scopePermissionsSchema(),
 			"view_members_scope":      scopePermissionsSchema(),
@@ -80,32 +96,8 @@ func resourceKeycloakGroupPermissionsUpdate(ctx context.Context, data *schema.Re
 		return diag.FromErr(err)
 	}
 
-	if viewScope, ok := data.GetOk("view_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["view"].(string), viewScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if manageScope, ok := data.GetOk("manage_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["manage"].(string), manageScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if viewMembersScope, ok := data.GetOk("view_members_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["view-members"].(string), viewMembersScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if manageMembersScope, ok := data.GetOk("manage_members_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["manage-members"].(string), manageMembersScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if manageMembershipScope, ok := data.GetOk("manage_membership_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["manage-membership"].(string), manageMembershipScope.(*schema.Set))
+	for key, scope := range keycloakGroupPermissionsScopes {
+		err := reconcileOpenidClientScopePermissionPolicy(ctx, keycloakClient, data, key, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions[scope].(string))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -115,6 +107,11 @@ func resourceKeycloakGroupPermissionsUpdate(ctx context.Context, data *schema.Re
 }
 
 func resourceKeycloakGroupPermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readGroupPermissions(ctx, data, meta, false)
+}
+
+// readGroupPermissions reads the resource, the scope blocks which aren't part of the state are only read on import
+func readGroupPermissions(ctx context.Context, data *schema.ResourceData, meta interface{}, importing bool) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	realmId := data.Get("realm_id").(string)
 	groupId := data.Get("group_id").(string)
@@ -129,40 +126,26 @@ func resourceKeycloakGroupPermissionsRead(ctx context.Context, data *schema.Reso
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	if !groupPermissions.Enabled {
+		tflog.Warn(ctx, "Removing resource from state as it is no longer enabled", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+		return nil
+	}
+
 	data.SetId(groupPermissionsId(groupPermissions.RealmId, groupPermissions.GroupId))
 	data.Set("realm_id", groupPermissions.RealmId)
 	data.Set("group_id", groupPermissions.GroupId)
 	data.Set("enabled", groupPermissions.Enabled)
 	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+	data.Set("scope_permission_ids", groupPermissions.ScopePermissions)
 
-	if viewScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["view"].(string)); err == nil && viewScope != nil {
-		data.Set("view_scope", []interface{}{viewScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if manageScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["manage"].(string)); err == nil && manageScope != nil {
-		data.Set("manage_scope", []interface{}{manageScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if viewMembersScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["view-members"].(string)); err == nil && viewMembersScope != nil {
-		data.Set("view_members_scope", []interface{}{viewMembersScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if manageMembersScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["manage-members"].(string)); err == nil && manageMembersScope != nil {
-		data.Set("manage_members_scope", []interface{}{manageMembersScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if manageMembershipScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions["manage-membership"].(string)); err == nil && manageMembershipScope != nil {
-		data.Set("manage_membership_scope", []interface{}{manageMembershipScope})
-	} else if err != nil {
-		return diag.FromErr(err)
+	for key, scope := range keycloakGroupPermissionsScopes {
+		err := setOpenidClientScopePermissionPolicyData(ctx, keycloakClient, data, key, realmId, realmManagementClient.Id, groupPermissions.ScopePermissions[scope].(string), importing)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return nil
@@ -195,7 +178,7 @@ func resourceKeycloakGroupPermissionsImport(ctx context.Context, d *schema.Resou
 
 	d.SetId(groupPermissionsId(parts[0], parts[1]))
 
-	diagnostics := resourceKeycloakGroupPermissionsRead(ctx, d, meta)
+	diagnostics := readGroupPermissions(ctx, d, meta, true)
 	if diagnostics.HasError() {
 		return nil, errors.New(diagnostics[0].Summary)
 	}
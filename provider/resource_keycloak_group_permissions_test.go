@@ -30,6 +30,82 @@ func TestAccKeycloakGroupPermission_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakGroupPermission_moveScope(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupPermission_scope(groupName, "manage_members_scope"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGroupPermissionScopePolicies("keycloak_group_permissions.test", "manage-members", 1),
+					testAccCheckKeycloakGroupPermissionScopePolicies("keycloak_group_permissions.test", "view-members", 0),
+				),
+			},
+			{
+				Config: testKeycloakGroupPermission_scope(groupName, "view_members_scope"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGroupPermissionScopePolicies("keycloak_group_permissions.test", "manage-members", 0),
+					testAccCheckKeycloakGroupPermissionScopePolicies("keycloak_group_permissions.test", "view-members", 1),
+					resource.TestCheckNoResourceAttr("keycloak_group_permissions.test", "manage_members_scope.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroupPermission_defaultScope(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupPermission_defaultScope(groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGroupPermissionScopePolicies("keycloak_group_permissions.test", "view-members", 0),
+					resource.TestCheckResourceAttr("keycloak_group_permissions.test", "view_members_scope.#", "1"),
+				),
+			},
+			// a block with the settings Keycloak creates the scope permission with is kept in state
+			{
+				Config:   testKeycloakGroupPermission_defaultScope(groupName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// checks the number of policies of the scope permission, as well as its id in scope_permission_ids
+func testAccCheckKeycloakGroupPermissionScopePolicies(resourceName, scope string, policyCount int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		permissions, err := getGroupPermissionsFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+		rs := s.RootModule().Resources[resourceName]
+
+		scopePermissionId := permissions.ScopePermissions[scope].(string)
+		if id := rs.Primary.Attributes["scope_permission_ids."+scope]; id != scopePermissionId {
+			return fmt.Errorf("expected scope_permission_ids.%s to be %s, got %s", scope, scopePermissionId, id)
+		}
+
+		scopePermission, err := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, permissions.RealmId, rs.Primary.Attributes["authorization_resource_server_id"], scopePermissionId)
+		if err != nil {
+			return err
+		}
+
+		if len(scopePermission.Policies) != policyCount {
+			return fmt.Errorf("expected scope permission %s to have %d policies, got %v", scope, policyCount, scopePermission.Policies)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakGroupPermissionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		permissions, err := getGroupPermissionsFromState(s, resourceName)
@@ -158,3 +234,76 @@ resource "keycloak_group_permissions" "test" {
 }
 	`, testAccRealm.Realm, groupName)
 }
+
+func testKeycloakGroupPermission_scope(groupName, scope string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_openid_client" "realm_management" {
+  realm_id  = data.keycloak_realm.realm.id
+  client_id = "realm-management"
+}
+
+resource "keycloak_openid_client_permissions" "realm-management_permission" {
+	realm_id   = data.keycloak_realm.realm.id
+	client_id  = data.keycloak_openid_client.realm_management.id
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_openid_client_group_policy" "test" {
+	realm_id           = data.keycloak_realm.realm.id
+	resource_server_id = data.keycloak_openid_client.realm_management.id
+	name               = "client_group_policy_move_scope"
+	groups {
+		id              = keycloak_group.group.id
+		path            = keycloak_group.group.path
+		extend_children = false
+	}
+	logic             = "POSITIVE"
+	decision_strategy = "UNANIMOUS"
+	depends_on = [
+		keycloak_openid_client_permissions.realm-management_permission,
+	]
+}
+
+resource "keycloak_group_permissions" "test" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+
+	%s {
+		policies          = [
+			keycloak_openid_client_group_policy.test.id
+		]
+		decision_strategy = "UNANIMOUS"
+	}
+}
+	`, testAccRealm.Realm, groupName, scope)
+}
+
+func testKeycloakGroupPermission_defaultScope(groupName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group_permissions" "test" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.group.id
+
+	view_members_scope {
+		decision_strategy = "UNANIMOUS"
+	}
+}
+	`, testAccRealm.Realm, groupName)
+}
@@ -103,32 +103,27 @@ func resourceKeycloakGroupRolesReconcile(ctx context.Context, data *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	if data.HasChange("role_ids") {
-		o, n := data.GetChange("role_ids")
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-		remove := interfaceSliceToStringSlice(os.Difference(ns).List())
-
-		tfRolesToRemove, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, remove)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		if err = removeRolesFromGroup(ctx, keycloakClient, tfRolesToRemove.clientRoles, tfRolesToRemove.realmRoles, group); err != nil {
-			return diag.FromErr(err)
-		}
+	// get the list of currently assigned roles. Due to default realm and client roles
+	// (e.g. roles of the account client) this is probably not empty upon resource creation
+	roleMappings, err := keycloakClient.GetGroupRoleMappings(ctx, realmId, groupId)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	existingRoles := intoRoleMapping(roleMappings)
 
-	tfRoles, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
+	tfRoles, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds, existingRoles)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	// get the list of currently assigned roles. Due to default realm and client roles
-	roleMappings, err := keycloakClient.GetGroupRoleMappings(ctx, realmId, groupId)
-
 	// sort into roles we need to add and roles we need to remove
-	updates := calculateRoleMappingUpdates(tfRoles, intoRoleMapping(roleMappings))
+	updates := calculateRoleMappingUpdates(tfRoles, existingRoles)
+
+	// if not exhaustive, only remove the roles which were removed from role_ids
+	if !exhaustive {
+		o, n := data.GetChange("role_ids")
+		updates.onlyRemove(interfaceSliceToStringSlice(o.(*schema.Set).Difference(n.(*schema.Set)).List()))
+	}
 
 	// add roles
 	err = addRolesToGroup(ctx, keycloakClient, updates.clientRolesToAdd, updates.realmRolesToAdd, group)
@@ -136,12 +131,10 @@ func resourceKeycloakGroupRolesReconcile(ctx context.Context, data *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	// remove roles if exhaustive (authoritative)
-	if exhaustive {
-		err = removeRolesFromGroup(ctx, keycloakClient, updates.clientRolesToRemove, updates.realmRolesToRemove, group)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	// remove roles
+	err = removeRolesFromGroup(ctx, keycloakClient, updates.clientRolesToRemove, updates.realmRolesToRemove, group)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	data.SetId(groupRolesId(realmId, groupId))
@@ -196,13 +189,24 @@ func resourceKeycloakGroupRolesDelete(ctx context.Context, data *schema.Resource
 	groupId := data.Get("group_id").(string)
 
 	group, err := keycloakClient.GetGroup(ctx, realmId, groupId)
+	if err != nil {
+		// the roles are removed along with the group
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
 
-	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
-	rolesToRemove, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
+		return diag.FromErr(err)
+	}
+
+	roleMappings, err := keycloakClient.GetGroupRoleMappings(ctx, realmId, groupId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// only roles which are still assigned are removed, roles which were deleted meanwhile are skipped
+	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
+	rolesToRemove := filterRoleMapping(intoRoleMapping(roleMappings), roleIds)
+
 	err = removeRolesFromGroup(ctx, keycloakClient, rolesToRemove.clientRoles, rolesToRemove.realmRoles, group)
 	if err != nil {
 		return diag.FromErr(err)
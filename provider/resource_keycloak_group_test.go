@@ -188,10 +188,11 @@ func runTestNestedGroup(t *testing.T, parentGroupName, firstChildGroupName, seco
 				),
 			},
 			{
-				ResourceName:        parentGroupResource,
-				ImportState:         true,
-				ImportStateVerify:   true,
-				ImportStateIdPrefix: testAccRealm.Realm + "/",
+				ResourceName:            parentGroupResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"sub_group_count"},
 			},
 			{
 				ResourceName:        firstChildGroupResource,
@@ -218,10 +219,11 @@ func runTestNestedGroup(t *testing.T, parentGroupName, firstChildGroupName, seco
 				),
 			},
 			{
-				ResourceName:        parentGroupResource,
-				ImportState:         true,
-				ImportStateVerify:   true,
-				ImportStateIdPrefix: testAccRealm.Realm + "/",
+				ResourceName:            parentGroupResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"sub_group_count"},
 			},
 			{
 				ResourceName:        firstChildGroupResource,
@@ -239,6 +241,41 @@ func runTestNestedGroup(t *testing.T, parentGroupName, firstChildGroupName, seco
 	})
 }
 
+func TestAccKeycloakGroup_counts(t *testing.T) {
+	t.Parallel()
+
+	parentGroupName := acctest.RandomWithPrefix("tf-acc")
+	childGroupName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_counts(parentGroupName, childGroupName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group.child_group", "path", fmt.Sprintf("/%s/%s", parentGroupName, childGroupName)),
+					resource.TestCheckResourceAttr("keycloak_group.child_group", "sub_group_count", "0"),
+				),
+			},
+			// the parent group is read before its subgroup and members are created, so the counts show up on refresh
+			{
+				Config: testKeycloakGroup_counts(parentGroupName, childGroupName, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group.parent_group", "sub_group_count", "1"),
+					resource.TestCheckResourceAttr("keycloak_group.parent_group", "member_count", "1"),
+				),
+			},
+			{
+				Config: testKeycloakGroup_counts(parentGroupName+"-renamed", childGroupName, username),
+				Check:  resource.TestCheckResourceAttr("keycloak_group.parent_group", "path", fmt.Sprintf("/%s-renamed", parentGroupName)),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakGroup_unsetOptionalAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -428,6 +465,40 @@ resource "keycloak_group" "second_child_group" {
 	`, testAccRealm.Realm, parentGroup, firstChildGroup, secondChildGroup, secondChildGroupParent)
 }
 
+func testKeycloakGroup_counts(parentGroup, childGroup, username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "parent_group" {
+	name          = "%s"
+	realm_id      = data.keycloak_realm.realm.id
+	count_members = true
+}
+
+resource "keycloak_group" "child_group" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.parent_group.id
+}
+
+resource "keycloak_user" "user" {
+	username = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_group_memberships" "memberships" {
+	realm_id = data.keycloak_realm.realm.id
+	group_id = keycloak_group.parent_group.id
+
+	members = [
+		keycloak_user.user.username,
+	]
+}
+	`, testAccRealm.Realm, parentGroup, childGroup, username)
+}
+
 func testKeycloakGroup_fromInterface(group *keycloak.Group) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
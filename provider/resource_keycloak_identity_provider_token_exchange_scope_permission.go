@@ -98,7 +98,7 @@ func setIdentityProviderTokenExchangeScopePermissionClientPolicy(ctx context.Con
 	}
 
 	if len(permission.Policies) == 0 {
-		policyId, err := createClientPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, providerAlias, clients)
+		policyId, err := createClientPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, providerAlias, "idp_client_policy", clients)
 		if err != nil {
 			return err
 		}
@@ -118,11 +118,13 @@ func setIdentityProviderTokenExchangeScopePermissionClientPolicy(ctx context.Con
 	}
 }
 
-func createClientPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, realmManagementClientId, providerAlias string, clients []string) (string, error) {
+// createClientPolicy creates a client policy named {{namePrefix}}_{{nameSuffix}}, adding a random part to the name when
+// a policy with this name already exists.
+func createClientPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, realmManagementClientId, namePrefix, nameSuffix string, clients []string) (string, error) {
 	openidClientAuthorizationClientPolicy := &keycloak.OpenidClientAuthorizationClientPolicy{
 		RealmId:          realmId,
 		ResourceServerId: realmManagementClientId,
-		Name:             providerAlias + "_idp_client_policy",
+		Name:             namePrefix + "_" + nameSuffix,
 		DecisionStrategy: "UNANIMOUS",
 		Logic:            "POSITIVE",
 		Type:             "client",
@@ -134,7 +136,7 @@ func createClientPolicy(ctx context.Context, keycloakClient *keycloak.KeycloakCl
 			b := make([]byte, 4)
 			rand.Read(b)
 			suffix := hex.EncodeToString(b)
-			openidClientAuthorizationClientPolicy.Name = providerAlias + "_" + suffix + "_idp_client_policy"
+			openidClientAuthorizationClientPolicy.Name = namePrefix + "_" + suffix + "_" + nameSuffix
 			err = keycloakClient.NewOpenidClientAuthorizationClientPolicy(ctx, openidClientAuthorizationClientPolicy)
 		}
 	}
@@ -253,7 +255,7 @@ func resourceKeycloakIdentityProviderTokenExchangeScopePermissionRead(ctx contex
 	if len(permission.Policies) >= 1 {
 		openidClientAuthorizationClientPolicyId = permission.Policies[0]
 	} else {
-		openidClientAuthorizationClientPolicyId, err = createClientPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, providerAlias, data.Get("clients").([]string))
+		openidClientAuthorizationClientPolicyId, err = createClientPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, providerAlias, "idp_client_policy", data.Get("clients").([]string))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -39,13 +39,11 @@ func resourceKeycloakLdapHardcodedAttributeMapper() *schema.Resource {
 			"attribute_name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Name of the LDAP attribute",
 			},
 			"attribute_value": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Value of the LDAP attribute. You can hardcode any value like 'foo'",
 			},
 		},
@@ -67,6 +67,49 @@ func TestAccKeycloakLdapHardcodedAttributeMapper_createAfterManualDestroy(t *tes
 	})
 }
 
+func TestAccKeycloakLdapHardcodedAttributeMapper_updateInPlace(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.LdapHardcodedAttributeMapper{}
+
+	attributeMapperName := acctest.RandomWithPrefix("tf-acc")
+	attributeValue := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapHardcodedAttributeMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapHardcodedAttributeMapper(attributeMapperName, "description", "before"),
+				Check:  testAccCheckKeycloakLdapHardcodedAttributeMapperFetch("keycloak_ldap_hardcoded_attribute_mapper.hardcoded_attribute_mapper", mapper),
+			},
+			{
+				Config: testKeycloakLdapHardcodedAttributeMapper(attributeMapperName, "title", attributeValue),
+				Check:  testAccCheckKeycloakLdapHardcodedAttributeMapperUpdatedInPlace("keycloak_ldap_hardcoded_attribute_mapper.hardcoded_attribute_mapper", mapper, "title", attributeValue),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakLdapHardcodedAttributeMapperUpdatedInPlace(resourceName string, mapper *keycloak.LdapHardcodedAttributeMapper, attributeName, attributeValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedMapper, err := getLdapHardcodedAttributeMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if fetchedMapper.Id != mapper.Id {
+			return fmt.Errorf("expected ldap hardcoded attribute mapper %s to be updated in place, but it was recreated as %s", mapper.Id, fetchedMapper.Id)
+		}
+
+		if fetchedMapper.AttributeName != attributeName || fetchedMapper.AttributeValue != attributeValue {
+			return fmt.Errorf("expected ldap hardcoded attribute mapper to set %s to %s, but it sets %s to %s", attributeName, attributeValue, fetchedMapper.AttributeName, fetchedMapper.AttributeValue)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakLdapHardcodedAttributeMapperExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getLdapHardcodedAttributeMapperFromState(s, resourceName)
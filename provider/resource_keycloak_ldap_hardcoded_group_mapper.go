@@ -38,7 +38,6 @@ func resourceKeycloakLdapHardcodedGroupMapper() *schema.Resource {
 			"group": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Group to grant to user.",
 			},
 		},
@@ -64,6 +64,50 @@ func TestAccKeycloakLdapHardcodedGroupMapper_createAfterManualDestroy(t *testing
 	})
 }
 
+func TestAccKeycloakLdapHardcodedGroupMapper_updateInPlace(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.LdapHardcodedGroupMapper{}
+
+	groupNameBefore := acctest.RandomWithPrefix("tf-acc")
+	groupNameAfter := acctest.RandomWithPrefix("tf-acc")
+	groupMapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapHardcodedGroupMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapHardcodedGroupMapper(groupNameBefore, groupMapperName),
+				Check:  testAccCheckKeycloakLdapHardcodedGroupMapperFetch("keycloak_ldap_hardcoded_group_mapper.hardcoded_group_mapper", mapper),
+			},
+			{
+				Config: testKeycloakLdapHardcodedGroupMapper(groupNameAfter, groupMapperName),
+				Check:  testAccCheckKeycloakLdapHardcodedGroupMapperUpdatedInPlace("keycloak_ldap_hardcoded_group_mapper.hardcoded_group_mapper", mapper, groupNameAfter),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakLdapHardcodedGroupMapperUpdatedInPlace(resourceName string, mapper *keycloak.LdapHardcodedGroupMapper, group string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedMapper, err := getLdapHardcodedGroupMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if fetchedMapper.Id != mapper.Id {
+			return fmt.Errorf("expected ldap hardcoded group mapper %s to be updated in place, but it was recreated as %s", mapper.Id, fetchedMapper.Id)
+		}
+
+		if fetchedMapper.Group != group {
+			return fmt.Errorf("expected ldap hardcoded group mapper to grant group %s, but it grants %s", group, fetchedMapper.Group)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakLdapHardcodedGroupMapperExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getLdapHardcodedGroupMapperFromState(s, resourceName)
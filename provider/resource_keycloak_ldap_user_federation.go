@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -129,9 +130,10 @@ func resourceKeycloakLdapUserFederation() *schema.Resource {
 				Description: "Password of LDAP admin.",
 			},
 			"custom_user_search_filter": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Additional LDAP filter for filtering searched users. Must begin with '(' and end with ')'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\(.+\)$`), "validation error: custom user search filter must start with '(' and end with ')'"),
+				Description:  "Additional LDAP filter for filtering searched users. Must begin with '(' and end with ')'.",
 			},
 			"search_scope": {
 				Type:         schema.TypeString,
@@ -242,49 +244,7 @@ func resourceKeycloakLdapUserFederation() *schema.Resource {
 					},
 				},
 			},
-			"cache": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Description: "Settings regarding cache policy for this realm.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"policy": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Default:      "DEFAULT",
-							ValidateFunc: validation.StringInSlice(keycloakUserFederationCachePolicies, false),
-						},
-						"max_lifespan": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							DiffSuppressFunc: suppressDurationStringDiff,
-							Description:      "Max lifespan of cache entry (duration string).",
-						},
-						"eviction_day": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      "-1",
-							ValidateFunc: validation.All(validation.IntAtLeast(0), validation.IntAtMost(6)),
-							Description:  "Day of the week the entry will become invalid on.",
-						},
-						"eviction_hour": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      "-1",
-							ValidateFunc: validation.All(validation.IntAtLeast(0), validation.IntAtMost(23)),
-							Description:  "Hour of day the entry will become invalid on.",
-						},
-						"eviction_minute": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      "-1",
-							ValidateFunc: validation.All(validation.IntAtLeast(0), validation.IntAtMost(59)),
-							Description:  "Minute of day the entry will become invalid on.",
-						},
-					},
-				},
-			},
+			"cache": userFederationCacheSchema(),
 			"delete_default_mappers": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -354,20 +314,8 @@ func getLdapUserFederationFromData(data *schema.ResourceData, realmInternalId st
 		ChangedSyncPeriod: data.Get("changed_sync_period").(int),
 	}
 
-	if cache, ok := data.GetOk("cache"); ok {
-		cache := cache.([]interface{})
-		cacheData := cache[0].(map[string]interface{})
-
-		evictionDay := cacheData["eviction_day"].(int)
-		evictionHour := cacheData["eviction_hour"].(int)
-		evictionMinute := cacheData["eviction_minute"].(int)
-
-		ldapUserFederation.MaxLifespan = cacheData["max_lifespan"].(string)
-
-		ldapUserFederation.EvictionDay = &evictionDay
-		ldapUserFederation.EvictionHour = &evictionHour
-		ldapUserFederation.EvictionMinute = &evictionMinute
-		ldapUserFederation.CachePolicy = cacheData["policy"].(string)
+	if cache, ok := getUserFederationCacheFromData(data); ok {
+		ldapUserFederation.UserFederationCache = *cache
 	}
 
 	if kerberos, ok := data.GetOk("kerberos"); ok {
@@ -438,25 +386,7 @@ func setLdapUserFederationData(data *schema.ResourceData, ldap *keycloak.LdapUse
 	data.Set("changed_sync_period", ldap.ChangedSyncPeriod)
 
 	if _, ok := data.GetOk("cache"); ok {
-		cachePolicySettings := make(map[string]interface{})
-
-		if ldap.MaxLifespan != "" {
-			cachePolicySettings["max_lifespan"] = ldap.MaxLifespan
-		}
-
-		if ldap.EvictionDay != nil {
-			cachePolicySettings["eviction_day"] = *ldap.EvictionDay
-		}
-		if ldap.EvictionHour != nil {
-			cachePolicySettings["eviction_hour"] = *ldap.EvictionHour
-		}
-		if ldap.EvictionMinute != nil {
-			cachePolicySettings["eviction_minute"] = *ldap.EvictionMinute
-		}
-
-		cachePolicySettings["policy"] = ldap.CachePolicy
-
-		data.Set("cache", []interface{}{cachePolicySettings})
+		setUserFederationCacheData(data, &ldap.UserFederationCache)
 	}
 }
 
@@ -170,17 +170,19 @@ func generateRandomLdapKerberos(enabled bool) *keycloak.LdapUserFederation {
 		BatchSizeForSync:                     acctest.RandIntRange(50, 10000),
 		FullSyncPeriod:                       acctest.RandIntRange(1, 3600),
 		ChangedSyncPeriod:                    acctest.RandIntRange(1, 3600),
-		CachePolicy:                          randomStringInSlice([]string{"DEFAULT", "EVICT_DAILY", "EVICT_WEEKLY", "MAX_LIFESPAN", "NO_CACHE"}),
 		ServerPrincipal:                      acctest.RandString(10),
 		UseKerberosForPasswordAuthentication: randomBool(),
 		AllowKerberosAuthentication:          true,
 		KeyTab:                               acctest.RandString(10),
 		KerberosRealm:                        acctest.RandString(10),
-		MaxLifespan:                          randomStringInSlice([]string{"1h", "2h", "3h"}),
-		EvictionDay:                          &evictionDay,
-		EvictionHour:                         &evictionHour,
-		EvictionMinute:                       &evictionMinute,
 		EditMode:                             "WRITABLE",
+		UserFederationCache: keycloak.UserFederationCache{
+			CachePolicy:    randomStringInSlice([]string{"DEFAULT", "EVICT_DAILY", "EVICT_WEEKLY", "MAX_LIFESPAN", "NO_CACHE"}),
+			MaxLifespan:    randomStringInSlice([]string{"1h", "2h", "3h"}),
+			EvictionDay:    &evictionDay,
+			EvictionHour:   &evictionHour,
+			EvictionMinute: &evictionMinute,
+		},
 	}
 }
 
@@ -285,17 +287,19 @@ func TestAccKeycloakLdapUserFederation_basicUpdateAll(t *testing.T) {
 		BatchSizeForSync:                     acctest.RandIntRange(50, 10000),
 		FullSyncPeriod:                       acctest.RandIntRange(1, 3600),
 		ChangedSyncPeriod:                    acctest.RandIntRange(1, 3600),
-		CachePolicy:                          randomStringInSlice([]string{"DEFAULT", "EVICT_DAILY", "EVICT_WEEKLY", "MAX_LIFESPAN", "NO_CACHE"}),
 		ServerPrincipal:                      acctest.RandString(10),
 		UseKerberosForPasswordAuthentication: randomBool(),
 		AllowKerberosAuthentication:          randomBool(),
 		KeyTab:                               acctest.RandString(10),
 		KerberosRealm:                        acctest.RandString(10),
-		MaxLifespan:                          randomStringInSlice([]string{"1h", "2h", "3h"}),
-		EvictionDay:                          &evictionDay,
-		EvictionHour:                         &evictionHour,
-		EvictionMinute:                       &evictionMinute,
 		EditMode:                             "WRITABLE",
+		UserFederationCache: keycloak.UserFederationCache{
+			CachePolicy:    randomStringInSlice([]string{"DEFAULT", "EVICT_DAILY", "EVICT_WEEKLY", "MAX_LIFESPAN", "NO_CACHE"}),
+			MaxLifespan:    randomStringInSlice([]string{"1h", "2h", "3h"}),
+			EvictionDay:    &evictionDay,
+			EvictionHour:   &evictionHour,
+			EvictionMinute: &evictionMinute,
+		},
 	}
 
 	evictionDay = acctest.RandIntRange(0, 6)
@@ -324,17 +328,19 @@ func TestAccKeycloakLdapUserFederation_basicUpdateAll(t *testing.T) {
 		BatchSizeForSync:                     acctest.RandIntRange(50, 10000),
 		FullSyncPeriod:                       acctest.RandIntRange(1, 3600),
 		ChangedSyncPeriod:                    acctest.RandIntRange(1, 3600),
-		CachePolicy:                          randomStringInSlice([]string{"DEFAULT", "EVICT_DAILY", "EVICT_WEEKLY", "MAX_LIFESPAN", "NO_CACHE"}),
 		ServerPrincipal:                      acctest.RandString(10),
 		UseKerberosForPasswordAuthentication: randomBool(),
 		AllowKerberosAuthentication:          randomBool(),
 		KeyTab:                               acctest.RandString(10),
 		KerberosRealm:                        acctest.RandString(10),
-		MaxLifespan:                          randomStringInSlice([]string{"1h", "2h", "3h"}),
-		EvictionDay:                          &evictionDay,
-		EvictionHour:                         &evictionHour,
-		EvictionMinute:                       &evictionMinute,
 		EditMode:                             "WRITABLE",
+		UserFederationCache: keycloak.UserFederationCache{
+			CachePolicy:    randomStringInSlice([]string{"DEFAULT", "EVICT_DAILY", "EVICT_WEEKLY", "MAX_LIFESPAN", "NO_CACHE"}),
+			MaxLifespan:    randomStringInSlice([]string{"1h", "2h", "3h"}),
+			EvictionDay:    &evictionDay,
+			EvictionHour:   &evictionHour,
+			EvictionMinute: &evictionMinute,
+		},
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -441,6 +447,27 @@ func TestAccKeycloakLdapUserFederation_searchScopeValidation(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakLdapUserFederation_customUserSearchFilterValidation(t *testing.T) {
+	t.Parallel()
+	ldapName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakLdapUserFederation_basicWithAttrValidation("custom_user_search_filter", ldapName, "objectClass=person"),
+				ExpectError: regexp.MustCompile("validation error: custom user search filter must start with '\\(' and end with '\\)'"),
+			},
+			{
+				Config: testKeycloakLdapUserFederation_basicWithAttrValidation("custom_user_search_filter", ldapName, "(!(memberOf=cn=service-accounts,dc=example,dc=org))"),
+				Check:  resource.TestCheckResourceAttr("keycloak_ldap_user_federation.openldap", "custom_user_search_filter", "(!(memberOf=cn=service-accounts,dc=example,dc=org))"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakLdapUserFederation_useTrustStoreValidation(t *testing.T) {
 	t.Parallel()
 	ldapName := acctest.RandomWithPrefix("tf-acc")
@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
 	"dario.cat/mergo"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
 )
@@ -46,9 +52,21 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 		},
 		"client_secret": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
 			Sensitive:   true,
-			Description: "Client Secret.",
+			Description: "Client Secret. Required unless client_auth_method is private_key_jwt.",
+		},
+		"client_auth_method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(oidcIdentityProviderClientAuthMethods, false),
+			Description:  "How the client authenticates to the identity provider: client_secret_post, client_secret_basic, client_secret_jwt or private_key_jwt.",
+		},
+		"client_assertion_signing_alg": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(oidcIdentityProviderClientAssertionSigningAlgs, false),
+			Description:  "The algorithm the client assertion is signed with, when client_auth_method is client_secret_jwt or private_key_jwt.",
 		},
 		"user_info_url": {
 			Type:        schema.TypeString,
@@ -60,6 +78,12 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 			Optional:    true,
 			Description: "JSON Web Key Set URL",
 		},
+		"use_jwks_url": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the signatures of the identity provider are validated with the keys of jwks_url. Defaults to true when jwks_url is set.",
+		},
 		"hide_on_login_page": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -99,6 +123,25 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 			Default:     false,
 			Description: "This is just used together with Identity Provider Authenticator or when kc_idp_hint points to this identity provider. In case that client sends a request with prompt=none and user is not yet authenticated, the error will not be directly returned to client, but the request with prompt=none will be forwarded to this identity provider.",
 		},
+		"prompt": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"", "none", "consent", "login", "select_account"}, false),
+			Description:  "The prompt parameter sent to the identity provider: none, consent, login or select_account. Not sent when empty.",
+		},
+		"pkce_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether PKCE is used when authorizing with the identity provider.",
+		},
+		"pkce_method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "S256",
+			ValidateFunc: validation.StringInSlice([]string{"plain", "S256"}, false),
+			Description:  "The PKCE code challenge method, only sent when pkce_enabled is true.",
+		},
 		"disable_user_info": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -110,19 +153,73 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 			Optional:    true,
 			Description: "The issuer identifier for the issuer of the response. If not provided, no validation will be performed.",
 		},
+		"filtered_by_claim": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true, only users whose ID token or user info has a claim claim_filter_name matching claim_filter_value are allowed to log in.",
+		},
+		"claim_filter_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the essential claim. Nested claims are referenced using dots, ex. address.country.",
+		},
+		"claim_filter_value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A Java regular expression the value of the essential claim has to match.",
+		},
 	}
 	oidcResource := resourceKeycloakIdentityProvider()
 	oidcResource.Schema = mergeSchemas(oidcResource.Schema, oidcSchema)
 	oidcResource.CreateContext = resourceKeycloakIdentityProviderCreate(getOidcIdentityProviderFromData, setOidcIdentityProviderData)
 	oidcResource.ReadContext = resourceKeycloakIdentityProviderRead(setOidcIdentityProviderData)
 	oidcResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getOidcIdentityProviderFromData, setOidcIdentityProviderData)
+	oidcResource.CustomizeDiff = customizeOidcIdentityProviderUseJwksUrlDiff
 	return oidcResource
 }
 
+// customizeOidcIdentityProviderUseJwksUrlDiff keeps use_jwks_url in line with jwks_url while it isn't configured, so adding
+// or removing jwks_url later switches it as well.
+func customizeOidcIdentityProviderUseJwksUrlDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !useJwksUrlFromJwksUrl(d.GetRawConfig()) {
+		return nil
+	}
+
+	if !d.NewValueKnown("jwks_url") {
+		return d.SetNewComputed("use_jwks_url")
+	}
+
+	useJwksUrl := d.Get("jwks_url").(string) != ""
+	if d.Get("use_jwks_url").(bool) != useJwksUrl {
+		return d.SetNew("use_jwks_url", useJwksUrl)
+	}
+
+	return nil
+}
+
+// useJwksUrlFromJwksUrl tells whether use_jwks_url is left out of the configuration, in which case it follows jwks_url.
+func useJwksUrlFromJwksUrl(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	return rawConfig.GetAttr("use_jwks_url").IsNull()
+}
+
 func getOidcIdentityProviderFromData(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.IdentityProvider, error) {
 	rec, defaultConfig := getIdentityProviderFromData(data, keycloakVersion)
 	rec.ProviderId = data.Get("provider_id").(string)
-	_, useJwksUrl := data.GetOk("jwks_url")
+	useJwksUrl := data.Get("use_jwks_url").(bool)
+	// the planned value is unknown when jwks_url is only known once applied
+	if useJwksUrlFromJwksUrl(data.GetRawConfig()) {
+		_, useJwksUrl = data.GetOk("jwks_url")
+	}
+	// Keycloak ignores the url when the keys aren't fetched from it, so it isn't sent either
+	jwksUrl := ""
+	if useJwksUrl {
+		jwksUrl = data.Get("jwks_url").(string)
+	}
 
 	oidcIdentityProviderConfig := &keycloak.IdentityProviderConfig{
 		BackchannelSupported:        types.KeycloakBoolQuoted(data.Get("backchannel_supported").(bool)),
@@ -134,7 +231,7 @@ func getOidcIdentityProviderFromData(data *schema.ResourceData, keycloakVersion
 		LogoutUrl:                   data.Get("logout_url").(string),
 		UILocales:                   types.KeycloakBoolQuoted(data.Get("ui_locales").(bool)),
 		LoginHint:                   data.Get("login_hint").(string),
-		JwksUrl:                     data.Get("jwks_url").(string),
+		JwksUrl:                     jwksUrl,
 		UserInfoUrl:                 data.Get("user_info_url").(string),
 		UseJwksUrl:                  types.KeycloakBoolQuoted(useJwksUrl),
 		DisableUserInfo:             types.KeycloakBoolQuoted(data.Get("disable_user_info").(bool)),
@@ -150,15 +247,117 @@ func getOidcIdentityProviderFromData(data *schema.ResourceData, keycloakVersion
 		return nil, err
 	}
 
+	err := setOidcIdentityProviderClaimFilterConfig(data, oidcIdentityProviderConfig.ExtraConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setOidcIdentityProviderAdvancedConfig(data, oidcIdentityProviderConfig.ExtraConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	rec.Config = oidcIdentityProviderConfig
 
 	return rec, nil
 }
 
+var oidcIdentityProviderClaimFilterConfigKeys = map[string]string{
+	"filtered_by_claim":  "filteredByClaim",
+	"claim_filter_name":  "claimFilterName",
+	"claim_filter_value": "claimFilterValue",
+}
+
+// setOidcIdentityProviderClaimFilterConfig stores the essential claim configuration, which could previously only be set
+// through extra_config. Keycloak replaces the whole config of an identity provider on update, so unset keys are omitted.
+func setOidcIdentityProviderClaimFilterConfig(data *schema.ResourceData, extraConfig map[string]interface{}) error {
+	filteredByClaim := data.Get("filtered_by_claim").(bool)
+	claimFilterName := data.Get("claim_filter_name").(string)
+	claimFilterValue := data.Get("claim_filter_value").(string)
+
+	if !filteredByClaim && claimFilterName == "" && claimFilterValue == "" {
+		return nil
+	}
+
+	for field, key := range oidcIdentityProviderClaimFilterConfigKeys {
+		if _, ok := extraConfig[key]; ok {
+			return fmt.Errorf(`"%s" and extra_config "%s" can't be set at the same time`, field, key)
+		}
+	}
+
+	if filteredByClaim && claimFilterName == "" {
+		return fmt.Errorf("validation error: claim_filter_name is required when filtered_by_claim is true")
+	}
+
+	extraConfig["filteredByClaim"] = strconv.FormatBool(filteredByClaim)
+	if claimFilterName != "" {
+		extraConfig["claimFilterName"] = claimFilterName
+	}
+	if claimFilterValue != "" {
+		extraConfig["claimFilterValue"] = claimFilterValue
+	}
+
+	return nil
+}
+
+var (
+	oidcIdentityProviderClientAuthMethods          = []string{"client_secret_post", "client_secret_basic", "client_secret_jwt", "private_key_jwt"}
+	oidcIdentityProviderClientAssertionSigningAlgs = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "HS256", "HS384", "HS512"}
+)
+
+// oidcIdentityProviderAdvancedConfigKeys are only sent when set, like the essential claim configuration, as clientAuthMethod
+// was previously set through extra_config.
+var oidcIdentityProviderAdvancedConfigKeys = map[string]string{
+	"prompt":                       "prompt",
+	"client_auth_method":           "clientAuthMethod",
+	"client_assertion_signing_alg": "clientAssertionSigningAlg",
+}
+
+// setOidcIdentityProviderAdvancedConfig stores the settings which Keycloak only accepts in some combinations, so they're
+// omitted from the config when unset. The PKCE method is rejected unless PKCE is enabled.
+func setOidcIdentityProviderAdvancedConfig(data *schema.ResourceData, extraConfig map[string]interface{}) error {
+	for field, key := range oidcIdentityProviderAdvancedConfigKeys {
+		value := data.Get(field).(string)
+		if value == "" {
+			continue
+		}
+
+		if _, ok := extraConfig[key]; ok {
+			return fmt.Errorf(`"%s" and extra_config "%s" can't be set at the same time`, field, key)
+		}
+
+		extraConfig[key] = value
+	}
+
+	clientAuthMethod, _ := extraConfig["clientAuthMethod"].(string)
+	if data.Get("client_secret").(string) == "" && clientAuthMethod != "private_key_jwt" {
+		return fmt.Errorf("validation error: client_secret is required unless client_auth_method is private_key_jwt")
+	}
+	if data.Get("client_assertion_signing_alg").(string) != "" && clientAuthMethod != "client_secret_jwt" && clientAuthMethod != "private_key_jwt" {
+		return fmt.Errorf("validation error: client_assertion_signing_alg can only be set when client_auth_method is client_secret_jwt or private_key_jwt")
+	}
+
+	if data.Get("pkce_enabled").(bool) {
+		for _, key := range []string{"pkceEnabled", "pkceMethod"} {
+			if _, ok := extraConfig[key]; ok {
+				return fmt.Errorf(`"pkce_enabled" and extra_config "%s" can't be set at the same time`, key)
+			}
+		}
+
+		extraConfig["pkceEnabled"] = strconv.FormatBool(true)
+		extraConfig["pkceMethod"] = data.Get("pkce_method").(string)
+	}
+
+	return nil
+}
+
 func setOidcIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
 	setIdentityProviderData(data, identityProvider, keycloakVersion)
 	data.Set("backchannel_supported", identityProvider.Config.BackchannelSupported)
-	data.Set("jwks_url", identityProvider.Config.JwksUrl)
+	data.Set("use_jwks_url", identityProvider.Config.UseJwksUrl)
+	if identityProvider.Config.UseJwksUrl {
+		data.Set("jwks_url", identityProvider.Config.JwksUrl)
+	}
 	data.Set("logout_url", identityProvider.Config.LogoutUrl)
 	data.Set("validate_signature", identityProvider.Config.ValidateSignature)
 	data.Set("authorization_url", identityProvider.Config.AuthorizationUrl)
@@ -169,6 +368,36 @@ func setOidcIdentityProviderData(data *schema.ResourceData, identityProvider *ke
 	data.Set("login_hint", identityProvider.Config.LoginHint)
 	data.Set("ui_locales", identityProvider.Config.UILocales)
 	data.Set("issuer", identityProvider.Config.Issuer)
+	data.Set("accepts_prompt_none_forward_from_client", identityProvider.Config.AcceptsPromptNoneForwFrmClt)
+
+	// the essential claim configuration is only tracked when it isn't managed through extra_config
+	extraConfig := data.Get("extra_config").(map[string]interface{})
+	if _, ok := extraConfig["filteredByClaim"]; !ok {
+		filteredByClaim, _ := identityProvider.Config.ExtraConfig["filteredByClaim"].(string)
+		data.Set("filtered_by_claim", filteredByClaim == "true")
+	}
+	if _, ok := extraConfig["claimFilterName"]; !ok {
+		data.Set("claim_filter_name", identityProvider.Config.ExtraConfig["claimFilterName"])
+	}
+	if _, ok := extraConfig["claimFilterValue"]; !ok {
+		data.Set("claim_filter_value", identityProvider.Config.ExtraConfig["claimFilterValue"])
+	}
+
+	for field, key := range oidcIdentityProviderAdvancedConfigKeys {
+		if _, ok := extraConfig[key]; !ok {
+			value, _ := identityProvider.Config.ExtraConfig[key].(string)
+			data.Set(field, value)
+		}
+	}
+	if _, ok := extraConfig["pkceEnabled"]; !ok {
+		pkceEnabled, _ := identityProvider.Config.ExtraConfig["pkceEnabled"].(string)
+		data.Set("pkce_enabled", pkceEnabled == "true")
+
+		// the method is left as configured while PKCE is disabled, as it isn't sent then
+		if pkceMethod, ok := identityProvider.Config.ExtraConfig["pkceMethod"].(string); ok && pkceEnabled == "true" {
+			data.Set("pkce_method", pkceMethod)
+		}
+	}
 
 	if keycloakVersion.LessThan(keycloak.Version_26.AsVersion()) {
 		// Since keycloak v26 the attribute "hideOnLoginPage" is not part of the identity provider config anymore!
@@ -109,6 +109,214 @@ func TestAccKeycloakOidcIdentityProvider_extraConfigInvalid(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOidcIdentityProvider_claimFilter(t *testing.T) {
+	t.Parallel()
+
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOidcIdentityProvider_claimFilter(oidcName, true, "", ""),
+				ExpectError: regexp.MustCompile("claim_filter_name is required when filtered_by_claim is true"),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_claimFilter(oidcName, true, "groups", "^employees$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue("keycloak_oidc_identity_provider.oidc", "filteredByClaim", "true"),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue("keycloak_oidc_identity_provider.oidc", "claimFilterName", "groups"),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue("keycloak_oidc_identity_provider.oidc", "claimFilterValue", "^employees$"),
+				),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_claimFilter(oidcName, false, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue("keycloak_oidc_identity_provider.oidc", "claimFilterName", ""),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "filtered_by_claim", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcIdentityProvider_advancedSettings(t *testing.T) {
+	t.Parallel()
+
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_identity_provider.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_auth_method                      = "private_key_jwt"
+	client_assertion_signing_alg            = "PS256"
+	prompt                                  = "login"
+	pkce_enabled                            = true
+	pkce_method                             = "plain"
+	jwks_url                                = "https://example.com/certs"
+	use_jwks_url                            = true
+	disable_user_info                       = true
+	accepts_prompt_none_forward_from_client = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "clientAuthMethod", "private_key_jwt"),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "clientAssertionSigningAlg", "PS256"),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "prompt", "login"),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "pkceEnabled", "true"),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "pkceMethod", "plain"),
+					testAccCheckKeycloakOidcIdentityProviderHasJwksUrl(resourceName, true, "https://example.com/certs"),
+					resource.TestCheckResourceAttr(resourceName, "disable_user_info", "true"),
+					resource.TestCheckResourceAttr(resourceName, "accepts_prompt_none_forward_from_client", "true"),
+				),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_secret = "example_token"
+	pkce_enabled  = false
+	pkce_method   = "plain"
+	jwks_url      = "https://example.com/certs"
+	use_jwks_url  = false
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "clientAuthMethod", ""),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "clientAssertionSigningAlg", ""),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "prompt", ""),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "pkceEnabled", ""),
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, "pkceMethod", ""),
+					testAccCheckKeycloakOidcIdentityProviderHasJwksUrl(resourceName, false, ""),
+					resource.TestCheckResourceAttr(resourceName, "jwks_url", "https://example.com/certs"),
+				),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_secret                = "example_token"
+	client_auth_method           = "client_secret_post"
+	client_assertion_signing_alg = "RS256"
+`),
+				ExpectError: regexp.MustCompile("client_assertion_signing_alg can only be set when client_auth_method is client_secret_jwt or private_key_jwt"),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_auth_method = "client_secret_basic"
+`),
+				ExpectError: regexp.MustCompile("client_secret is required unless client_auth_method is private_key_jwt"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcIdentityProvider_useJwksUrlFollowsJwksUrl(t *testing.T) {
+	t.Parallel()
+
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_identity_provider.oidc"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_secret = "example_token"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasJwksUrl(resourceName, false, ""),
+					resource.TestCheckResourceAttr(resourceName, "use_jwks_url", "false"),
+				),
+			},
+			{
+				// use_jwks_url isn't configured, so adding jwks_url later switches it on
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_secret = "example_token"
+	jwks_url      = "https://example.com/certs"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasJwksUrl(resourceName, true, "https://example.com/certs"),
+					resource.TestCheckResourceAttr(resourceName, "use_jwks_url", "true"),
+				),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_secret = "example_token"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasJwksUrl(resourceName, false, ""),
+					resource.TestCheckResourceAttr(resourceName, "use_jwks_url", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcIdentityProvider_clientAuthMethodExtraConfig(t *testing.T) {
+	t.Parallel()
+
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_secret = "example_token"
+	extra_config = {
+		clientAuthMethod = "client_secret_basic"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderHasConfigValue("keycloak_oidc_identity_provider.oidc", "clientAuthMethod", "client_secret_basic"),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "client_auth_method", ""),
+				),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_advancedSettings(oidcName, `
+	client_secret      = "example_token"
+	client_auth_method = "client_secret_post"
+	extra_config = {
+		clientAuthMethod = "client_secret_basic"
+	}
+`),
+				ExpectError: regexp.MustCompile(`"client_auth_method" and extra_config "clientAuthMethod" can't be set at the same time`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcIdentityProvider_linkOnly(t *testing.T) {
+	t.Parallel()
+
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcIdentityProvider_linkOnly(oidcName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderLinkOnly("keycloak_oidc_identity_provider.oidc", true),
+					resource.TestMatchResourceAttr("keycloak_oidc_identity_provider.oidc", "account_linking_url", regexp.MustCompile(fmt.Sprintf("/realms/%s/broker/%s/link$", testAccRealm.Realm, oidcName))),
+				),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_linkOnly(oidcName, false),
+				Check:  testAccCheckKeycloakOidcIdentityProviderLinkOnly("keycloak_oidc_identity_provider.oidc", false),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOidcIdentityProvider_keyDefaultScopes(t *testing.T) {
 	t.Parallel()
 
@@ -160,6 +368,54 @@ func TestAccKeycloakOidcIdentityProvider_createAfterManualDestroy(t *testing.T)
 	})
 }
 
+func TestAccKeycloakOidcIdentityProvider_guiOrder(t *testing.T) {
+	t.Parallel()
+
+	newOidc := func(guiOrder string) *keycloak.IdentityProvider {
+		return &keycloak.IdentityProvider{
+			Realm:   testAccRealm.Realm,
+			Alias:   acctest.RandString(10),
+			Enabled: true,
+			Config: &keycloak.IdentityProviderConfig{
+				AuthorizationUrl: "https://example.com/auth",
+				TokenUrl:         "https://example.com/token",
+				ClientId:         acctest.RandString(10),
+				ClientSecret:     acctest.RandString(10),
+				GuiOrder:         guiOrder,
+				SyncMode:         "IMPORT",
+			},
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOidcIdentityProvider_basicFromInterface(newOidc(`"first"`)),
+				ExpectError: regexp.MustCompile("gui_order must be a whole number"),
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_basicFromInterface(newOidc("10")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "gui_order", "10"),
+					func(s *terraform.State) error {
+						idp, err := getKeycloakOidcIdentityProviderFromState(s, "keycloak_oidc_identity_provider.oidc")
+						if err != nil {
+							return err
+						}
+						if idp.Config.GuiOrder != "10" {
+							return fmt.Errorf("expected gui order to be stored as 10, got %s", idp.Config.GuiOrder)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOidcIdentityProvider_basicUpdateAll(t *testing.T) {
 	t.Parallel()
 
@@ -255,6 +511,65 @@ func testAccCheckKeycloakOidcIdentityProviderHasCustomConfigValue(resourceName,
 	}
 }
 
+func testAccCheckKeycloakOidcIdentityProviderHasConfigValue(resourceName, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedOidc, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if actual, _ := fetchedOidc.Config.ExtraConfig[key].(string); actual != value {
+			return fmt.Errorf("expected oidc provider to have config %s with value %s, but value was %s", key, value, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOidcIdentityProviderHasJwksUrl(resourceName string, useJwksUrl bool, jwksUrl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedOidc, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if bool(fetchedOidc.Config.UseJwksUrl) != useJwksUrl {
+			return fmt.Errorf("expected oidc provider to have useJwksUrl %t, but was %t", useJwksUrl, bool(fetchedOidc.Config.UseJwksUrl))
+		}
+
+		if fetchedOidc.Config.JwksUrl != jwksUrl {
+			return fmt.Errorf("expected oidc provider to have jwksUrl %s, but was %s", jwksUrl, fetchedOidc.Config.JwksUrl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOidcIdentityProviderLinkOnly(resourceName string, hideOnLoginPage bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedOidc, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !fetchedOidc.LinkOnly {
+			return fmt.Errorf("expected oidc provider %s to be link only", fetchedOidc.Alias)
+		}
+
+		// since keycloak v26 the identity provider is hidden through its representation instead of its config
+		hidden := bool(fetchedOidc.Config.HideOnLoginPage)
+		if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26); ok {
+			hidden = fetchedOidc.HideOnLogin
+		}
+
+		if hidden != hideOnLoginPage {
+			return fmt.Errorf("expected oidc provider %s to have hide on login page %t, but was %t", fetchedOidc.Alias, hideOnLoginPage, hidden)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOidcIdentityProviderDefaultScopes(resourceName, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		fetchedOidc, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
@@ -347,6 +662,64 @@ resource "keycloak_oidc_identity_provider" "oidc" {
 	`, testAccRealm.Realm, alias, configKey, configValue)
 }
 
+func testKeycloakOidcIdentityProvider_claimFilter(alias string, filteredByClaim bool, claimFilterName, claimFilterValue string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm              = data.keycloak_realm.realm.id
+	provider_id        = "oidc"
+	alias              = "%s"
+	authorization_url  = "https://example.com/auth"
+	token_url          = "https://example.com/token"
+	client_id          = "example_id"
+	client_secret      = "example_token"
+	filtered_by_claim  = %t
+	claim_filter_name  = "%s"
+	claim_filter_value = "%s"
+}
+	`, testAccRealm.Realm, alias, filteredByClaim, claimFilterName, claimFilterValue)
+}
+
+func testKeycloakOidcIdentityProvider_advancedSettings(alias, settings string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+%s
+}
+	`, testAccRealm.Realm, alias, settings)
+}
+
+func testKeycloakOidcIdentityProvider_linkOnly(alias string, hideOnLoginPage bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm              = data.keycloak_realm.realm.id
+	provider_id        = "oidc"
+	alias              = "%s"
+	authorization_url  = "https://example.com/auth"
+	token_url          = "https://example.com/token"
+	client_id          = "example_id"
+	client_secret      = "example_token"
+	link_only          = true
+	hide_on_login_page = %t
+}
+	`, testAccRealm.Realm, alias, hideOnLoginPage)
+}
+
 func testKeycloakOidcIdentityProvider_keyDefaultScopes(alias, value string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakOidcUsernameIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUsernameIdentityProviderMapperDestroy("keycloak_oidc_username_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, mapperName, "${CLAIM.preferred_username}", "LOCAL", "INHERIT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUsernameIdentityProviderMapper("keycloak_oidc_username_identity_provider_mapper.oidc", "oidc-username-idp-mapper", "${CLAIM.preferred_username}", "LOCAL", "INHERIT"),
+					resource.TestCheckResourceAttr("keycloak_oidc_username_identity_provider_mapper.oidc", "target", "LOCAL"),
+					resource.TestCheckResourceAttr("keycloak_oidc_username_identity_provider_mapper.oidc", "sync_mode", "INHERIT"),
+				),
+			},
+			{
+				Config: testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, mapperName, "${ALIAS}.${CLAIM.email}", "BROKER_USERNAME", "FORCE"),
+				Check:  testAccCheckKeycloakUsernameIdentityProviderMapper("keycloak_oidc_username_identity_provider_mapper.oidc", "oidc-username-idp-mapper", "${ALIAS}.${CLAIM.email}", "BROKER_USERNAME", "FORCE"),
+			},
+			{
+				ResourceName:      "keycloak_oidc_username_identity_provider_mapper.oidc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getUsernameIdentityProviderMapperImportId("keycloak_oidc_username_identity_provider_mapper.oidc"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcUsernameIdentityProviderMapper_validation(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, mapperName, "${CLAIM.sub}", "BROKER", "INHERIT"),
+				ExpectError: regexp.MustCompile(`expected target to be one of`),
+			},
+			{
+				Config:      testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, mapperName, "${CLAIM.sub}", "LOCAL", "ALWAYS"),
+				ExpectError: regexp.MustCompile(`expected sync_mode to be one of`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUsernameIdentityProviderMapper(resourceName, mapperType, template, target, syncMode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, rs.Primary.Attributes["realm"], rs.Primary.Attributes["identity_provider_alias"], rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting identity provider mapper with id %s: %s", rs.Primary.ID, err)
+		}
+
+		if mapper.IdentityProviderMapper != mapperType {
+			return fmt.Errorf("expected mapper type %s, got %s", mapperType, mapper.IdentityProviderMapper)
+		}
+		if mapper.Config.Template != template {
+			return fmt.Errorf("expected template %s, got %s", template, mapper.Config.Template)
+		}
+		if mapper.Config.ExtraConfig["target"] != target {
+			return fmt.Errorf("expected target %s, got %v", target, mapper.Config.ExtraConfig["target"])
+		}
+		if mapper.Config.ExtraConfig["syncMode"] != syncMode {
+			return fmt.Errorf("expected syncMode %s, got %v", syncMode, mapper.Config.ExtraConfig["syncMode"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUsernameIdentityProviderMapperDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("identity provider mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getUsernameIdentityProviderMapperImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm"], rs.Primary.Attributes["identity_provider_alias"], rs.Primary.ID), nil
+	}
+}
+
+func testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, name, template, target, syncMode string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_oidc_username_identity_provider_mapper" "oidc" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	template                = "%s"
+	target                  = "%s"
+	sync_mode               = "%s"
+}
+	`, testAccRealm.Realm, alias, name, strings.ReplaceAll(template, "${", "$${"), target, syncMode)
+}
@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"testing"
+)
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper_client"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_basic_client(clientId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "street_attribute", "street"),
+					resource.TestCheckResourceAttr(resourceName, "postal_code_attribute", "postal_code"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_basic_clientScope(clientScopeId, mapperName),
+				Check:  testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_openid_address_protocol_mapper.address_mapper_client"
+	clientScopeResourceName := "keycloak_openid_address_protocol_mapper.address_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAddressProtocolMapperExists(clientResourceName),
+					testKeycloakOpenIdAddressProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper"
+
+	mapperOne := &keycloak.OpenIdAddressProtocolMapper{
+		Name:                acctest.RandString(10),
+		ClientId:            "terraform-client-" + acctest.RandString(10),
+		AddToIdToken:        randomBool(),
+		AddToAccessToken:    randomBool(),
+		AddToUserInfo:       randomBool(),
+		FormattedAttribute:  "formatted",
+		StreetAttribute:     "street",
+		LocalityAttribute:   "locality",
+		RegionAttribute:     "region",
+		PostalCodeAttribute: "postal_code",
+		CountryAttribute:    "country",
+	}
+
+	mapperTwo := &keycloak.OpenIdAddressProtocolMapper{
+		Name:                mapperOne.Name,
+		ClientId:            mapperOne.ClientId,
+		AddToIdToken:        randomBool(),
+		AddToAccessToken:    randomBool(),
+		AddToUserInfo:       randomBool(),
+		FormattedAttribute:  acctest.RandString(10),
+		StreetAttribute:     acctest.RandString(10),
+		LocalityAttribute:   acctest.RandString(10),
+		RegionAttribute:     acctest.RandString(10),
+		PostalCodeAttribute: acctest.RandString(10),
+		CountryAttribute:    acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_fromInterface(mapperOne),
+				Check:  testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+			},
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_fromInterface(mapperTwo),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "street_attribute", mapperTwo.StreetAttribute),
+					resource.TestCheckResourceAttr(resourceName, "country_attribute", mapperTwo.CountryAttribute),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdAddressProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper_client"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_basic_client(clientId, mapperName),
+				Check:  testKeycloakOpenIdAddressProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenIdAddressProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakOpenIdAddressProtocolMapper_basic_client(clientId, mapperName),
+				Check:  testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenIdAddressProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_address_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getAddressMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid address protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAddressProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getAddressMapperUsingState(state, resourceName)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAddressProtocolMapperFetch(resourceName string, mapper *keycloak.OpenIdAddressProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getAddressMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getAddressMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdAddressProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdAddressProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdAddressProtocolMapper_basic_client(clientId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_address_protocol_mapper" "address_mapper_client" {
+	name       = "%s"
+	realm_id   = data.keycloak_realm.realm.id
+	client_id  = "${keycloak_openid_client.openid_client.id}"
+}`, testAccRealm.Realm, clientId, mapperName)
+}
+
+func testKeycloakOpenIdAddressProtocolMapper_basic_clientScope(clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_address_protocol_mapper" "address_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = "${keycloak_openid_client_scope.client_scope.id}"
+}`, testAccRealm.Realm, clientScopeId, mapperName)
+}
+
+func testKeycloakOpenIdAddressProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_address_protocol_mapper" "address_mapper_client" {
+	name       = "%s"
+	realm_id   = data.keycloak_realm.realm.id
+	client_id  = "${keycloak_openid_client.openid_client.id}"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_address_protocol_mapper" "address_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = "${keycloak_openid_client_scope.client_scope.id}"
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakOpenIdAddressProtocolMapper_fromInterface(mapper *keycloak.OpenIdAddressProtocolMapper) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_address_protocol_mapper" "address_mapper" {
+	name                = "%s"
+	realm_id            = data.keycloak_realm.realm.id
+	client_id           = "${keycloak_openid_client.openid_client.id}"
+	add_to_id_token     = %t
+	add_to_access_token = %t
+	add_to_userinfo     = %t
+
+	formatted_attribute   = "%s"
+	street_attribute      = "%s"
+	locality_attribute    = "%s"
+	region_attribute      = "%s"
+	postal_code_attribute = "%s"
+	country_attribute     = "%s"
+}`, testAccRealm.Realm, mapper.ClientId, mapper.Name, mapper.AddToIdToken, mapper.AddToAccessToken, mapper.AddToUserInfo,
+		mapper.FormattedAttribute, mapper.StreetAttribute, mapper.LocalityAttribute, mapper.RegionAttribute, mapper.PostalCodeAttribute, mapper.CountryAttribute)
+}
@@ -114,6 +114,35 @@ func TestAccKeycloakOpenIdAudienceProtocolMapper_update(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenIdAudienceProtocolMapper_lightweightAccessToken(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_openid_audience_protocol_mapper.audience_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAudienceProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAudienceProtocolMapper_lightweightAccessToken(clientId, mapperName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAudienceProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "true"),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdAudienceProtocolMapper_lightweightAccessToken(clientId, mapperName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAudienceProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_lightweight_claim", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenIdAudienceProtocolMapper_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 	var mapper = &keycloak.OpenIdAudienceProtocolMapper{}
@@ -475,3 +504,29 @@ resource "keycloak_openid_audience_protocol_mapper" "audience_mapper" {
 	depends_on = [ "keycloak_openid_client.openid_client" ]
 }`, testAccRealm.Realm, mapperName, clientId)
 }
+
+func testKeycloakOpenIdAudienceProtocolMapper_lightweightAccessToken(clientId, mapperName string, addToLightweightClaim bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+
+	access_type = "CONFIDENTIAL"
+
+	service_accounts_enabled     = true
+	use_lightweight_access_token = true
+}
+
+resource "keycloak_openid_audience_protocol_mapper" "audience_mapper" {
+	name                     = "%s"
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id                = "${keycloak_openid_client.openid_client.id}"
+
+	included_custom_audience = "foo"
+	add_to_lightweight_claim = %t
+}`, testAccRealm.Realm, clientId, mapperName, addToLightweightClaim)
+}
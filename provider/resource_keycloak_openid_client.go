@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"dario.cat/mergo"
@@ -21,8 +26,56 @@ var (
 	keycloakOpenidClientAuthorizationPolicyEnforcementMode   = []string{"ENFORCING", "PERMISSIVE", "DISABLED"}
 	keycloakOpenidClientResourcePermissionDecisionStrategies = []string{"UNANIMOUS", "AFFIRMATIVE", "CONSENSUS"}
 	keycloakOpenidClientPkceCodeChallengeMethod              = []string{"", "plain", "S256"}
+	keycloakOpenidClientCibaTokenDeliveryModes               = []string{"poll", "ping"}
+	keycloakOpenidClientVerifiableCredentialFormats          = []string{"jwt_vc", "vc+sd-jwt", "ldp_vc"}
+	keycloakOpenidClientVerifiableCredentialAttribute        = regexp.MustCompile(`^vc\.(.+)\.(format|scope)$`)
 )
 
+const tokenEndpointAuthSigningAlgAttribute = "token.endpoint.auth.signing.alg"
+
+const (
+	acrLoaMapAttribute        = "acr.loa.map"
+	defaultAcrValuesAttribute = "default.acr.values"
+	minimumAcrValueAttribute  = "minimum.acr.value"
+)
+
+const (
+	introspectionSignedResponseAlgAttribute    = "introspection.signed.response.alg"
+	introspectionEncryptedResponseAlgAttribute = "introspection.encrypted.response.alg"
+	introspectionEncryptedResponseEncAttribute = "introspection.encrypted.response.enc"
+)
+
+const requirePushedAuthorizationRequestsAttribute = "require.pushed.authorization.requests"
+
+const (
+	useJwksUrlAttribute               = "use.jwks.url"
+	jwksUrlAttribute                  = "jwks.url"
+	jwtCredentialCertificateAttribute = "jwt.credential.certificate"
+)
+
+const (
+	tlsClientCertificateBoundAccessTokensAttribute = "tls.client.certificate.bound.access.tokens"
+	x509SubjectDnAttribute                         = "x509.subjectdn"
+	x509AllowRegexPatternComparisonAttribute       = "x509.allow.regex.pattern.comparison"
+)
+
+// openidClientFieldAttributes maps fields to the client attributes they manage, which could previously only be set through extra_config
+var openidClientFieldAttributes = map[string]string{
+	"token_endpoint_auth_signing_alg":            tokenEndpointAuthSigningAlgAttribute,
+	"default_acr_values":                         defaultAcrValuesAttribute,
+	"minimum_acr_value":                          minimumAcrValueAttribute,
+	"introspection_signed_response_alg":          introspectionSignedResponseAlgAttribute,
+	"introspection_encrypted_response_alg":       introspectionEncryptedResponseAlgAttribute,
+	"introspection_encrypted_response_enc":       introspectionEncryptedResponseEncAttribute,
+	"require_pushed_authorization_requests":      requirePushedAuthorizationRequestsAttribute,
+	"use_jwks_url":                               useJwksUrlAttribute,
+	"jwks_url":                                   jwksUrlAttribute,
+	"jwt_credential_certificate":                 jwtCredentialCertificateAttribute,
+	"tls_client_certificate_bound_access_tokens": tlsClientCertificateBoundAccessTokensAttribute,
+	"tls_client_auth_subject_dn":                 x509SubjectDnAttribute,
+	"x509_allow_regex_pattern_comparison":        x509AllowRegexPatternComparisonAttribute,
+}
+
 func resourceKeycloakOpenidClient() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKeycloakOpenidClientCreate,
@@ -300,11 +353,163 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"use_lightweight_access_token": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"introspection_response_allow_jwt_claim": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"token_endpoint_auth_signing_alg": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The algorithm the client must use to sign the JWT it authenticates with when client_authenticator_type is client-jwt or client-secret-jwt.",
+			},
+			"use_jwks_url": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the signatures of the JWTs the client authenticates with are verified with the keys published at jwks_url instead of jwt_credential_certificate.",
+			},
+			"jwks_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The url of the JWK set with the keys of the client, used when use_jwks_url is true.",
+			},
+			"jwt_credential_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded certificate, without header and footer, whose key verifies the JWTs the client authenticates with when client_authenticator_type is client-jwt.",
+			},
+			"introspection_signed_response_alg": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The algorithm Keycloak signs JWT introspection responses with, ex. PS256.",
+			},
+			"introspection_encrypted_response_alg": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The algorithm Keycloak encrypts the content encryption key of JWT introspection responses with, ex. RSA-OAEP.",
+			},
+			"introspection_encrypted_response_enc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The algorithm Keycloak encrypts the content of JWT introspection responses with, ex. A256GCM. Requires introspection_encrypted_response_alg.",
+			},
+			"require_pushed_authorization_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the client can only start an authorization request through the pushed authorization request (PAR) endpoint.",
+			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ciba_backchannel_token_delivery_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientCibaTokenDeliveryModes, false),
+			},
+			"ciba_backchannel_auth_request_signing_alg": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ciba_backchannel_client_notification_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_certificate_bound_access_tokens": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Binds access and refresh tokens to the client certificate used during the token request (RFC 8705).",
+			},
+			"tls_client_auth_subject_dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The subject DN the client certificate must have when client_authenticator_type is client-x509.",
+			},
+			"x509_allow_regex_pattern_comparison": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, tls_client_auth_subject_dn is matched as a regular expression instead of an exact DN.",
+			},
+			"standard_token_exchange_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allows the client to exchange tokens issued to other clients using standard token exchange (Keycloak 26.2 and later).",
+			},
+			"standard_token_exchange_refresh_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allows the client to request a refresh token through standard token exchange, which is bound to the session of the subject token.",
+			},
+			"verifiable_credential": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Verifiable credentials this client can be issued through OID4VCI. Requires Keycloak 25 or later.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(keycloakOpenidClientVerifiableCredentialFormats, false),
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"always_display_in_console": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"acr_loa_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Mapping of ACR values to level of authentication (LoA) for this client.",
+			},
+			"default_acr_values": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "ACR values used when the client doesn't request one, in order of preference.",
+			},
+			"minimum_acr_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The lowest ACR value the client accepts, requests for lower values are authenticated with this one instead.",
+			},
+			"registration_access_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The registration access token last generated by Terraform, used to manage the client through the client registration endpoint.",
+			},
+			"registration_access_token_regenerate_when_changed": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, will trigger the regeneration of the registration access token.",
+			},
 			"import": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -312,9 +517,17 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				ForceNew: true,
 			},
 		},
-		CustomizeDiff: customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			return d.HasChange("service_accounts_enabled")
-		}),
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("service_account_user_id", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("service_accounts_enabled")
+			}),
+			customdiff.ComputedIf("registration_access_token", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("registration_access_token_regenerate_when_changed")
+			}),
+			suppressEquivalentUriSetDiff("valid_redirect_uris"),
+			suppressEquivalentUriSetDiff("valid_post_logout_redirect_uris"),
+			suppressEquivalentUriSetDiff("web_origins"),
+		),
 	}
 }
 
@@ -386,6 +599,12 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 			ConsentScreenText:                     data.Get("consent_screen_text").(string),
 			DisplayOnConsentScreen:                types.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
 			PostLogoutRedirectUris:                types.KeycloakSliceHashDelimited(validPostLogoutRedirectUris),
+			UseLightweightAccessToken:             types.KeycloakBoolQuoted(data.Get("use_lightweight_access_token").(bool)),
+			IntrospectionResponseAllowJwtClaim:    types.KeycloakBoolQuoted(data.Get("introspection_response_allow_jwt_claim").(bool)),
+			CibaGrantEnabled:                      types.KeycloakBoolQuoted(data.Get("ciba_grant_enabled").(bool)),
+			CibaBackchannelTokenDeliveryMode:      data.Get("ciba_backchannel_token_delivery_mode").(string),
+			CibaBackchannelAuthRequestSigningAlg:  data.Get("ciba_backchannel_auth_request_signing_alg").(string),
+			CibaBackchannelClientNotificationUrl:  data.Get("ciba_backchannel_client_notification_endpoint").(string),
 		},
 		ValidRedirectUris:      validRedirectUris,
 		WebOrigins:             webOrigins,
@@ -399,6 +618,27 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		openidClient.RootUrl = &rootUrlString
 	}
 
+	err := setOpenidClientAcrLoaMapAttribute(data, openidClient.Attributes.ExtraConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	setVerifiableCredentialAttributes(data, openidClient.Attributes.ExtraConfig)
+
+	err = validateOpenidClientJwtCredentials(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Get("tls_client_auth_subject_dn").(string) != "" && openidClient.ClientAuthenticatorType != "client-x509" {
+		return nil, fmt.Errorf("validation error: a tls client auth subject dn can only be used with the client-x509 client authenticator")
+	}
+
+	err = setOpenidClientFieldAttributes(data, openidClient.Attributes.ExtraConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	if !openidClient.ImplicitFlowEnabled && !openidClient.StandardFlowEnabled {
 		if _, ok := data.GetOk("valid_redirect_uris"); ok {
 			return nil, errors.New("valid_redirect_uris cannot be set when standard or implicit flow is not enabled")
@@ -444,6 +684,409 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 	return openidClient, nil
 }
 
+// suppressEquivalentUriSetDiff ignores changes to a set of URIs which only differ in the case of their scheme and host,
+// or in a port which is the default one for the scheme. Keycloak treats these URIs the same when matching them.
+func suppressEquivalentUriSetDiff(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if !d.HasChange(key) || !d.NewValueKnown(key) {
+			return nil
+		}
+
+		oldUris, newUris := d.GetChange(key)
+		if !normalizedUriSetsEqual(oldUris.(*schema.Set), newUris.(*schema.Set)) {
+			return nil
+		}
+
+		return d.Clear(key)
+	}
+}
+
+func normalizedUriSetsEqual(oldUris, newUris *schema.Set) bool {
+	if oldUris.Len() != newUris.Len() {
+		return false
+	}
+
+	normalizedOldUris := make(map[string]bool, oldUris.Len())
+	for _, uri := range oldUris.List() {
+		normalizedOldUris[normalizeClientUri(uri.(string))] = true
+	}
+
+	for _, uri := range newUris.List() {
+		if !normalizedOldUris[normalizeClientUri(uri.(string))] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeClientUri only rewrites the parts of an http(s) URI which are case-insensitive or implied, paths are kept as they are
+// since Keycloak compares them exactly. Values which aren't absolute URIs, such as "+" or "*" for web origins, are returned unchanged.
+func normalizeClientUri(uri string) string {
+	parsedUri, err := url.Parse(uri)
+	if err != nil || parsedUri.Scheme == "" || parsedUri.Host == "" {
+		return uri
+	}
+
+	parsedUri.Scheme = strings.ToLower(parsedUri.Scheme)
+	if parsedUri.Scheme != "http" && parsedUri.Scheme != "https" {
+		return parsedUri.String()
+	}
+
+	parsedUri.Host = strings.ToLower(parsedUri.Host)
+	if (parsedUri.Scheme == "https" && parsedUri.Port() == "443") || (parsedUri.Scheme == "http" && parsedUri.Port() == "80") {
+		parsedUri.Host = strings.TrimSuffix(parsedUri.Host, ":"+parsedUri.Port())
+	}
+
+	return parsedUri.String()
+}
+
+// validateOpenidClientJwtCredentials checks the arguments configuring how Keycloak verifies the JWTs of client-jwt clients.
+func validateOpenidClientJwtCredentials(data *schema.ResourceData) error {
+	useJwksUrl := data.Get("use_jwks_url").(bool)
+	jwksUrl := data.Get("jwks_url").(string)
+
+	if (useJwksUrl || jwksUrl != "" || data.Get("jwt_credential_certificate").(string) != "") && data.Get("client_authenticator_type").(string) != "client-jwt" {
+		return fmt.Errorf("validation error: use_jwks_url, jwks_url and jwt_credential_certificate can only be used with the client-jwt client authenticator")
+	}
+
+	if useJwksUrl && jwksUrl == "" {
+		return fmt.Errorf("validation error: use_jwks_url requires a jwks_url")
+	}
+
+	return nil
+}
+
+// getOpenidClientAttributeFromField converts the value of a field to the client attribute it manages, along with the value
+// the attribute has when the field isn't set. Keycloak stores lists separated by ##, like other multivalued attributes.
+func getOpenidClientAttributeFromField(value interface{}) (string, string) {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v), "false"
+	case []interface{}:
+		return strings.Join(interfaceSliceToStringSlice(v), MULTIVALUE_ATTRIBUTE_SEPARATOR), ""
+	default:
+		return v.(string), ""
+	}
+}
+
+// setOpenidClientFieldAttributes sets the client attributes managed by fields. extra_config keeps managing an attribute as
+// long as its field isn't set, otherwise removing the field resets the attribute, as Keycloak ignores missing attributes on update.
+func setOpenidClientFieldAttributes(data *schema.ResourceData, attributes map[string]interface{}) error {
+	for field, attribute := range openidClientFieldAttributes {
+		oldValue, newValue := data.GetChange(field)
+		oldAttribute, unset := getOpenidClientAttributeFromField(oldValue)
+		newAttribute, _ := getOpenidClientAttributeFromField(newValue)
+
+		// attributes removed from extra_config are sent as empty strings
+		extraConfigValue, inExtraConfig := attributes[attribute]
+		inExtraConfig = inExtraConfig && extraConfigValue != ""
+
+		if newAttribute != unset {
+			if inExtraConfig {
+				return fmt.Errorf(`"%s" and extra_config "%s" can't be set at the same time`, field, attribute)
+			}
+
+			attributes[attribute] = newAttribute
+		} else if !inExtraConfig && oldAttribute != unset {
+			attributes[attribute] = unset
+		}
+	}
+
+	return nil
+}
+
+// setOpenidClientFieldAttributesData reads the fields managing client attributes back from Keycloak. Attributes managed
+// through extra_config leave their field unset.
+func setOpenidClientFieldAttributesData(data *schema.ResourceData, attributes map[string]interface{}) {
+	extraConfig := data.Get("extra_config").(map[string]interface{})
+
+	for field, attribute := range openidClientFieldAttributes {
+		value, _ := attributes[attribute].(string)
+		if _, ok := extraConfig[attribute]; ok {
+			value = ""
+		}
+
+		switch data.Get(field).(type) {
+		case bool:
+			data.Set(field, value == "true")
+		case []interface{}:
+			if value == "" {
+				data.Set(field, nil)
+			} else {
+				data.Set(field, strings.Split(value, MULTIVALUE_ATTRIBUTE_SEPARATOR))
+			}
+		default:
+			data.Set(field, value)
+		}
+	}
+}
+
+// validateOpenidClientAcrValues checks that the ACR values of the client are mapped to a level of authentication by the
+// client or its realm. Like Keycloak, numeric ACR values are accepted as levels of authentication.
+func validateOpenidClientAcrValues(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) error {
+	acrValues := interfaceSliceToStringSlice(data.Get("default_acr_values").([]interface{}))
+	if minimumAcrValue := data.Get("minimum_acr_value").(string); minimumAcrValue != "" {
+		acrValues = append(acrValues, minimumAcrValue)
+	}
+
+	if len(acrValues) == 0 {
+		return nil
+	}
+
+	realmId := data.Get("realm_id").(string)
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	realmAcrLoaMapJson, _ := realm.Attributes[acrLoaMapAttribute].(string)
+	acrLoaMap, err := getAcrLoaMapData(realmAcrLoaMapJson)
+	if err != nil {
+		return err
+	}
+
+	// the mapping of the client takes precedence over the one of the realm, and may still be set through extra_config
+	clientAcrLoaMap := data.Get("acr_loa_map").(map[string]interface{})
+	if extraConfigAcrLoaMapJson, ok := data.Get("extra_config").(map[string]interface{})[acrLoaMapAttribute].(string); ok {
+		clientAcrLoaMap, err = getAcrLoaMapData(extraConfigAcrLoaMapJson)
+		if err != nil {
+			return err
+		}
+	}
+
+	for acr, loa := range clientAcrLoaMap {
+		acrLoaMap[acr] = loa
+	}
+
+	for _, acrValue := range acrValues {
+		if _, ok := acrLoaMap[acrValue]; ok {
+			continue
+		}
+
+		if _, err := strconv.Atoi(acrValue); err == nil {
+			continue
+		}
+
+		return fmt.Errorf("validation error: acr value %s is neither mapped to a level of authentication by the acr_loa_map of the client or of realm %s, nor numeric", acrValue, realmId)
+	}
+
+	return nil
+}
+
+// Keycloak stores each verifiable credential as a set of client attributes, ex. vc.{credential_id}.format
+// Attributes can't be removed from a client through an update, so credentials that were removed are blanked out instead.
+func setVerifiableCredentialAttributes(data *schema.ResourceData, attributes map[string]interface{}) {
+	oldCredentials, newCredentials := data.GetChange("verifiable_credential")
+
+	for _, credential := range oldCredentials.(*schema.Set).List() {
+		credentialId := credential.(map[string]interface{})["credential_id"].(string)
+		attributes[fmt.Sprintf("vc.%s.format", credentialId)] = ""
+		attributes[fmt.Sprintf("vc.%s.scope", credentialId)] = ""
+	}
+
+	for _, credential := range newCredentials.(*schema.Set).List() {
+		credentialData := credential.(map[string]interface{})
+		credentialId := credentialData["credential_id"].(string)
+		attributes[fmt.Sprintf("vc.%s.format", credentialId)] = credentialData["format"].(string)
+		attributes[fmt.Sprintf("vc.%s.scope", credentialId)] = credentialData["scope"].(string)
+	}
+}
+
+func getVerifiableCredentialsData(attributes map[string]interface{}) []interface{} {
+	credentials := make(map[string]map[string]interface{})
+	for key, value := range attributes {
+		matches := keycloakOpenidClientVerifiableCredentialAttribute.FindStringSubmatch(key)
+		if matches == nil {
+			continue
+		}
+
+		v, ok := value.(string)
+		if !ok || v == "" {
+			continue
+		}
+
+		credential, ok := credentials[matches[1]]
+		if !ok {
+			credential = map[string]interface{}{
+				"credential_id": matches[1],
+				"format":        "",
+				"scope":         "",
+			}
+			credentials[matches[1]] = credential
+		}
+		credential[matches[2]] = v
+	}
+
+	credentialsData := make([]interface{}, 0, len(credentials))
+	for _, credential := range credentials {
+		// a credential without a format is either blanked out or not managed by this provider
+		if credential["format"] != "" {
+			credentialsData = append(credentialsData, credential)
+		}
+	}
+
+	return credentialsData
+}
+
+func validateOpenidClientVerifiableCredentials(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) error {
+	if v, ok := data.GetOk("verifiable_credential"); !ok || v.(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_25)
+	if err != nil {
+		return err
+	}
+	if !versionOk {
+		return fmt.Errorf("verifiable_credential requires Keycloak 25 or later")
+	}
+
+	return nil
+}
+
+// setOpenidClientStandardTokenExchange only sends the standard token exchange attributes to versions which support them,
+// but always sends them to these versions, otherwise standard token exchange could never be disabled again.
+func setOpenidClientStandardTokenExchange(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
+	enabled := data.Get("standard_token_exchange_enabled").(bool)
+	refreshEnabled := data.Get("standard_token_exchange_refresh_enabled").(bool)
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_26_2)
+	if err != nil {
+		return err
+	}
+	if !versionOk {
+		if enabled || refreshEnabled {
+			return fmt.Errorf("standard_token_exchange_enabled and standard_token_exchange_refresh_enabled require Keycloak 26.2 or later")
+		}
+
+		return nil
+	}
+
+	refresh := "NO"
+	if refreshEnabled {
+		refresh = "SAME_SESSION"
+	}
+
+	client.Attributes.StandardTokenExchangeEnabled = (*types.KeycloakBoolQuoted)(&enabled)
+	client.Attributes.StandardTokenExchangeRefresh = &refresh
+
+	return nil
+}
+
+// validateOpenidClientIntrospectionResponse checks the introspection response algorithms against the providers installed on
+// the server, as Keycloak accepts any value for these attributes and only fails once a JWT introspection response is requested.
+func validateOpenidClientIntrospectionResponse(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) error {
+	fields := []struct {
+		name         string
+		providerType string
+	}{
+		{"introspection_signed_response_alg", "signature"},
+		{"introspection_encrypted_response_alg", "cekmanagement"},
+		{"introspection_encrypted_response_enc", "contentencryption"},
+	}
+
+	if data.Get("introspection_encrypted_response_enc").(string) != "" && data.Get("introspection_encrypted_response_alg").(string) == "" {
+		return fmt.Errorf("validation error: introspection_encrypted_response_enc requires introspection_encrypted_response_alg to be set")
+	}
+
+	var serverInfo *keycloak.ServerInfo
+	for _, field := range fields {
+		alg := data.Get(field.name).(string)
+		if alg == "" {
+			continue
+		}
+
+		if serverInfo == nil {
+			var err error
+			serverInfo, err = keycloakClient.GetServerInfo(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		if installed, algs := serverInfo.ProviderIsInstalled(field.providerType, alg); !installed {
+			sort.Strings(algs)
+			return fmt.Errorf("validation error: %s %s is not supported by the server, supported algorithms are %s", field.name, alg, strings.Join(algs, ", "))
+		}
+	}
+
+	return nil
+}
+
+// setOpenidClientAcrLoaMapAttribute sets the acr.loa.map client attribute from acr_loa_map. The mapping used to be set through
+// extra_config, which keeps working as long as acr_loa_map isn't set.
+func setOpenidClientAcrLoaMapAttribute(data *schema.ResourceData, attributes map[string]interface{}) error {
+	acrLoaMap, err := getAcrLoaMapFromData(data.Get("acr_loa_map").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	// attributes removed from extra_config are sent as empty strings
+	if extraConfigValue, ok := attributes[acrLoaMapAttribute]; ok && extraConfigValue != "" {
+		if acrLoaMap != "" {
+			return fmt.Errorf(`"acr_loa_map" and extra_config "%s" can't be set at the same time`, acrLoaMapAttribute)
+		}
+	} else if acrLoaMap != "" {
+		attributes[acrLoaMapAttribute] = acrLoaMap
+	} else if oldAcrLoaMap, _ := data.GetChange("acr_loa_map"); len(oldAcrLoaMap.(map[string]interface{})) != 0 {
+		// Keycloak keeps client attributes which are missing from an update
+		attributes[acrLoaMapAttribute] = ""
+	}
+
+	return nil
+}
+
+// Keycloak stores the ACR to LoA mapping as a JSON encoded object, ex. {"silver":1,"gold":2}
+func getAcrLoaMapFromData(acrLoaMapData map[string]interface{}) (string, error) {
+	if len(acrLoaMapData) == 0 {
+		return "", nil
+	}
+
+	acrLoaMap := make(map[string]int)
+	for acr, loa := range acrLoaMapData {
+		acrLoaMap[acr] = loa.(int)
+	}
+
+	acrLoaMapJson, err := json.Marshal(acrLoaMap)
+	if err != nil {
+		return "", err
+	}
+
+	return string(acrLoaMapJson), nil
+}
+
+func getAcrLoaMapData(acrLoaMapJson string) (map[string]interface{}, error) {
+	acrLoaMapData := make(map[string]interface{})
+	if acrLoaMapJson == "" {
+		return acrLoaMapData, nil
+	}
+
+	// the admin console saves the levels as strings, while the API accepts numbers as well
+	var acrLoaMap map[string]interface{}
+	err := json.Unmarshal([]byte(acrLoaMapJson), &acrLoaMap)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse acr.loa.map attribute %s: %v", acrLoaMapJson, err)
+	}
+
+	for acr, loa := range acrLoaMap {
+		switch v := loa.(type) {
+		case float64:
+			acrLoaMapData[acr] = int(v)
+		case string:
+			level, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse level of authentication %s for acr %s: %v", v, acr, err)
+			}
+			acrLoaMapData[acr] = level
+		default:
+			return nil, fmt.Errorf("unexpected level of authentication %v for acr %s", loa, acr)
+		}
+	}
+
+	return acrLoaMapData, nil
+}
+
 func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
 	var serviceAccountUserId string
 	if client.ServiceAccountsEnabled {
@@ -493,8 +1136,36 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 	data.Set("backchannel_logout_url", client.Attributes.BackchannelLogoutUrl)
 	data.Set("backchannel_logout_revoke_offline_sessions", client.Attributes.BackchannelLogoutRevokeOfflineTokens)
 	data.Set("backchannel_logout_session_required", client.Attributes.BackchannelLogoutSessionRequired)
+	data.Set("use_lightweight_access_token", client.Attributes.UseLightweightAccessToken)
+	data.Set("introspection_response_allow_jwt_claim", client.Attributes.IntrospectionResponseAllowJwtClaim)
+	data.Set("ciba_grant_enabled", client.Attributes.CibaGrantEnabled)
+	data.Set("ciba_backchannel_token_delivery_mode", client.Attributes.CibaBackchannelTokenDeliveryMode)
+	data.Set("ciba_backchannel_auth_request_signing_alg", client.Attributes.CibaBackchannelAuthRequestSigningAlg)
+	data.Set("ciba_backchannel_client_notification_endpoint", client.Attributes.CibaBackchannelClientNotificationUrl)
+	data.Set("standard_token_exchange_enabled", client.Attributes.StandardTokenExchangeEnabled != nil && bool(*client.Attributes.StandardTokenExchangeEnabled))
+	data.Set("standard_token_exchange_refresh_enabled", client.Attributes.StandardTokenExchangeRefresh != nil && *client.Attributes.StandardTokenExchangeRefresh == "SAME_SESSION")
 	setExtraConfigData(data, client.Attributes.ExtraConfig)
 
+	// a mapping managed through extra_config leaves acr_loa_map unset
+	if _, ok := data.Get("extra_config").(map[string]interface{})[acrLoaMapAttribute]; ok {
+		data.Set("acr_loa_map", nil)
+	} else {
+		acrLoaMapJson, _ := client.Attributes.ExtraConfig[acrLoaMapAttribute].(string)
+		acrLoaMap, err := getAcrLoaMapData(acrLoaMapJson)
+		if err != nil {
+			return err
+		}
+		data.Set("acr_loa_map", acrLoaMap)
+	}
+	data.Set("verifiable_credential", getVerifiableCredentialsData(client.Attributes.ExtraConfig))
+
+	setOpenidClientFieldAttributesData(data, client.Attributes.ExtraConfig)
+
+	// keycloak keeps use.jwks.url when the client switches to another authenticator, which ignores it
+	if client.ClientAuthenticatorType != "client-jwt" {
+		data.Set("use_jwks_url", false)
+	}
+
 	if client.AuthorizationServicesEnabled {
 		data.Set("resource_server_id", client.Id)
 	}
@@ -534,11 +1205,31 @@ func resourceKeycloakOpenidClientCreate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	err = setOpenidClientStandardTokenExchange(ctx, keycloakClient, data, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = keycloakClient.ValidateOpenidClient(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	err = validateOpenidClientVerifiableCredentials(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = validateOpenidClientAcrValues(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = validateOpenidClientIntrospectionResponse(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if data.Get("import").(bool) {
 		existingClient, err := keycloakClient.GetOpenidClientByClientId(ctx, client.RealmId, client.ClientId)
 		if err != nil {
@@ -565,6 +1256,13 @@ func resourceKeycloakOpenidClientCreate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	if len(data.Get("registration_access_token_regenerate_when_changed").(map[string]interface{})) != 0 {
+		err = regenerateOpenidClientRegistrationAccessToken(ctx, keycloakClient, data)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKeycloakOpenidClientRead(ctx, data, meta)
 }
 
@@ -599,11 +1297,31 @@ func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	err = setOpenidClientStandardTokenExchange(ctx, keycloakClient, data, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = keycloakClient.ValidateOpenidClient(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	err = validateOpenidClientVerifiableCredentials(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = validateOpenidClientAcrValues(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = validateOpenidClientIntrospectionResponse(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	err = keycloakClient.UpdateOpenidClient(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -614,6 +1332,26 @@ func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
+	if data.HasChange("registration_access_token_regenerate_when_changed") {
+		err = regenerateOpenidClientRegistrationAccessToken(ctx, keycloakClient, data)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// regenerateOpenidClientRegistrationAccessToken rotates the registration access token and stores the new one in state.
+// The token is not set during read, since Keycloak doesn't return it outside of regeneration.
+func regenerateOpenidClientRegistrationAccessToken(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) error {
+	registrationAccessToken, err := keycloakClient.RegenerateOpenidClientRegistrationAccessToken(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return err
+	}
+
+	data.Set("registration_access_token", registrationAccessToken)
+
 	return nil
 }
 
@@ -632,19 +1370,25 @@ func resourceKeycloakOpenidClientDelete(ctx context.Context, data *schema.Resour
 func resourceKeycloakOpenidClientImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	parts := strings.Split(d.Id(), "/")
+	// client ids may contain slashes, ex. when they are URLs
+	parts := strings.SplitN(d.Id(), "/", 2)
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientId}}")
 	}
 
-	_, err := keycloakClient.GetOpenidClient(ctx, parts[0], parts[1])
+	id, err := getClientUuid(ctx, keycloakClient, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keycloakClient.GetOpenidClient(ctx, parts[0], id)
 	if err != nil {
 		return nil, err
 	}
 
 	d.Set("realm_id", parts[0])
 	d.Set("import", false)
-	d.SetId(parts[1])
+	d.SetId(id)
 
 	diagnostics := resourceKeycloakOpenidClientRead(ctx, d, meta)
 	if diagnostics.HasError() {
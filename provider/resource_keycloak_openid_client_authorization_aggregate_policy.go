@@ -31,12 +31,14 @@ func resourceKeycloakOpenidClientAuthorizationAggregatePolicy() *schema.Resource
 				Required: true,
 			},
 			"decision_strategy": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
 			},
 			"logic": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Default:      "POSITIVE",
 				ValidateFunc: validation.StringInSlice(keycloakPolicyLogicTypes, false),
 			},
 			"policies": {
@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
+// Keycloak parses the dates of time policies with the pattern yyyy-MM-dd HH:mm:ss
+var keycloakTimePolicyDateFormat = regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}$`)
+
 func resourceKeycloakOpenidClientAuthorizationTimePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKeycloakOpenidClientAuthorizationTimePolicyCreate,
@@ -31,12 +36,14 @@ func resourceKeycloakOpenidClientAuthorizationTimePolicy() *schema.Resource {
 				Required: true,
 			},
 			"decision_strategy": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
 			},
 			"logic": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Default:      "POSITIVE",
 				ValidateFunc: validation.StringInSlice(keycloakPolicyLogicTypes, false),
 			},
 			"description": {
@@ -44,12 +51,14 @@ func resourceKeycloakOpenidClientAuthorizationTimePolicy() *schema.Resource {
 				Optional: true,
 			},
 			"not_before": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(keycloakTimePolicyDateFormat, "validation error: dates of time policies must be in the format yyyy-MM-dd HH:mm:ss"),
 			},
 			"not_on_or_after": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(keycloakTimePolicyDateFormat, "validation error: dates of time policies must be in the format yyyy-MM-dd HH:mm:ss"),
 			},
 			"day_month": {
 				Type:     schema.TypeString,
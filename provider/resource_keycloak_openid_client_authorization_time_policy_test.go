@@ -2,6 +2,8 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -28,6 +30,66 @@ func TestAccKeycloakOpenidClientAuthorizationTimePolicy(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClientAuthorizationTimePolicy_partialRange(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_openid_client_time_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testResourceKeycloakOpenidClientAuthorizationTimePolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceKeycloakOpenidClientAuthorizationTimePolicy_partialRange(policyName, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testResourceKeycloakOpenidClientAuthorizationTimePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "not_before", "2400-12-12 01:01:11"),
+					resource.TestCheckResourceAttr(resourceName, "not_on_or_after", ""),
+					resource.TestCheckResourceAttr(resourceName, "day_month", ""),
+					resource.TestCheckResourceAttr(resourceName, "logic", "POSITIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getResourceKeycloakOpenidClientAuthorizationTimePolicyImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientAuthorizationTimePolicy_invalidDate(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testResourceKeycloakOpenidClientAuthorizationTimePolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testResourceKeycloakOpenidClientAuthorizationTimePolicy_partialRange(policyName, clientId), "2400-12-12 01:01:11", "2400-12-12T01:01:11Z", 1),
+				ExpectError: regexp.MustCompile("dates of time policies must be in the format yyyy-MM-dd HH:mm:ss"),
+			},
+		},
+	})
+}
+
+func getResourceKeycloakOpenidClientAuthorizationTimePolicyImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["resource_server_id"], rs.Primary.ID), nil
+	}
+}
+
 func getResourceKeycloakOpenidClientAuthorizationTimePolicyFromState(s *terraform.State, resourceName string) (*keycloak.OpenidClientAuthorizationTimePolicy, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -117,3 +179,32 @@ func testResourceKeycloakOpenidClientAuthorizationTimePolicy_basic(policyName, c
 	}
 	`, testAccRealm.Realm, clientId, policyName)
 }
+
+func testResourceKeycloakOpenidClientAuthorizationTimePolicy_partialRange(policyName, clientId string) string {
+
+	return fmt.Sprintf(`
+	data "keycloak_realm" "realm" {
+		realm = "%s"
+	}
+
+	resource keycloak_openid_client test {
+		client_id                = "%s"
+		realm_id                 = data.keycloak_realm.realm.id
+		access_type              = "CONFIDENTIAL"
+		service_accounts_enabled = true
+		authorization {
+			policy_enforcement_mode = "ENFORCING"
+		}
+	}
+
+	resource keycloak_openid_client_time_policy test {
+		resource_server_id = "${keycloak_openid_client.test.resource_server_id}"
+		realm_id = data.keycloak_realm.realm.id
+		name = "%s"
+		not_before = "2400-12-12 01:01:11"
+		hour = "8"
+		hour_end = "18"
+		decision_strategy = "UNANIMOUS"
+	}
+	`, testAccRealm.Realm, clientId, policyName)
+}
@@ -32,6 +32,12 @@ func resourceKeycloakOpenidClientDefaultScopes() *schema.Resource {
 				Required: true,
 				Set:      schema.HashString,
 			},
+			"exhaustive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When false, default scopes of the client which aren't listed, such as the ones Keycloak assigns to new clients, are left alone instead of being detached.",
+			},
 		},
 	}
 }
@@ -51,8 +57,16 @@ func resourceKeycloakOpenidClientDefaultScopesRead(ctx context.Context, data *sc
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	exhaustive := data.Get("exhaustive").(bool)
+	tfOpenidClientDefaultScopes := data.Get("default_scopes").(*schema.Set)
+
 	var defaultScopes []string
 	for _, clientScope := range clientScopes {
+		// scopes which aren't managed by this resource are ignored unless it's exhaustive
+		if !exhaustive && !tfOpenidClientDefaultScopes.Contains(clientScope.Name) {
+			continue
+		}
+
 		defaultScopes = append(defaultScopes, clientScope.Name)
 	}
 
@@ -68,6 +82,11 @@ func resourceKeycloakOpenidClientDefaultScopesReconcile(ctx context.Context, dat
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	tfOpenidClientDefaultScopes := data.Get("default_scopes").(*schema.Set)
+	exhaustive := data.Get("exhaustive").(bool)
+
+	// scopes which were removed from the config are detached even if the resource isn't exhaustive
+	oldDefaultScopes, _ := data.GetChange("default_scopes")
+	removedOpenidClientDefaultScopes := oldDefaultScopes.(*schema.Set).Difference(tfOpenidClientDefaultScopes)
 
 	keycloakOpenidClientDefaultScopes, err := keycloakClient.GetOpenidClientDefaultScopes(ctx, realmId, clientId)
 	if err != nil {
@@ -83,7 +102,7 @@ func resourceKeycloakOpenidClientDefaultScopesReconcile(ctx context.Context, dat
 		// remove it from the set so we can look at scopes that need to be attached later
 		if tfOpenidClientDefaultScopes.Contains(keycloakOpenidClientDefaultScope.Name) {
 			tfOpenidClientDefaultScopes.Remove(keycloakOpenidClientDefaultScope.Name)
-		} else {
+		} else if exhaustive || removedOpenidClientDefaultScopes.Contains(keycloakOpenidClientDefaultScope.Name) {
 			// if this scope is attached in keycloak but not in tf state, add them to a slice containing all scopes to detach
 			openidClientDefaultScopesToDetach = append(openidClientDefaultScopesToDetach, keycloakOpenidClientDefaultScope.Name)
 		}
@@ -284,6 +284,47 @@ func TestAccKeycloakOpenidClientDefaultScopes_validateDuplicateScopeAssignment(t
 	})
 }
 
+// the realm default scopes Keycloak assigns to new clients are left alone unless they're listed, even when listed scopes are removed
+func TestAccKeycloakOpenidClientDefaultScopes_notExhaustive(t *testing.T) {
+	t.Parallel()
+	client := acctest.RandomWithPrefix("tf-acc")
+	clientScope := "terraform-client-scope-" + acctest.RandString(10)
+
+	preAssignedClientScopes := preAssignedDefaultClientScopes
+	listedRealmDefaultScope := preAssignedClientScopes[0]
+	unlistedRealmDefaultScopes := preAssignedClientScopes[1:]
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientDefaultScopes_notExhaustive(client, clientScope, []string{listedRealmDefaultScope, clientScope}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client_default_scopes.default_scopes", preAssignedClientScopes),
+					testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client_default_scopes.default_scopes", []string{clientScope}),
+					resource.TestCheckResourceAttr("keycloak_openid_client_default_scopes.default_scopes", "default_scopes.#", "2"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientDefaultScopes_notExhaustive(client, clientScope, []string{listedRealmDefaultScope}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client_default_scopes.default_scopes", preAssignedClientScopes),
+					testAccCheckKeycloakOpenidClientDefaultScopeIsNotAttached("keycloak_openid_client_default_scopes.default_scopes", clientScope),
+					resource.TestCheckResourceAttr("keycloak_openid_client_default_scopes.default_scopes", "default_scopes.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientDefaultScopes_notExhaustive(client, clientScope, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasDefaultScopes("keycloak_openid_client_default_scopes.default_scopes", unlistedRealmDefaultScopes),
+					testAccCheckKeycloakOpenidClientDefaultScopeIsNotAttached("keycloak_openid_client_default_scopes.default_scopes", listedRealmDefaultScope),
+				),
+			},
+		},
+	})
+}
+
 func getDefaultClientScopesFromState(resourceName string, s *terraform.State) ([]*keycloak.OpenidClientScope, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -569,3 +610,31 @@ resource "keycloak_openid_client_default_scopes" "default_scopes" {
 }
 	`, testKeycloakOpenidClientOptionalScopes_basic(client, clientScope))
 }
+
+func testKeycloakOpenidClientDefaultScopes_notExhaustive(client, clientScope string, listOfDefaultScopes []string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_client_default_scopes" "default_scopes" {
+	realm_id       = data.keycloak_realm.realm.id
+	client_id      = keycloak_openid_client.client.id
+	default_scopes = %s
+	exhaustive     = false
+
+	depends_on = [keycloak_openid_client_scope.client_scope]
+}
+	`, testAccRealm.Realm, client, clientScope, arrayOfStringsForTerraformResource(listOfDefaultScopes))
+}
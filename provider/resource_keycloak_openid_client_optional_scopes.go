@@ -32,6 +32,12 @@ func resourceKeycloakOpenidClientOptionalScopes() *schema.Resource {
 				Required: true,
 				Set:      schema.HashString,
 			},
+			"exhaustive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When false, optional scopes of the client which aren't listed, such as the ones Keycloak assigns to new clients, are left alone instead of being detached.",
+			},
 		},
 	}
 }
@@ -51,8 +57,16 @@ func resourceKeycloakOpenidClientOptionalScopesRead(ctx context.Context, data *s
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	exhaustive := data.Get("exhaustive").(bool)
+	tfOpenidClientOptionalScopes := data.Get("optional_scopes").(*schema.Set)
+
 	var optionalScopes []string
 	for _, clientScope := range clientScopes {
+		// scopes which aren't managed by this resource are ignored unless it's exhaustive
+		if !exhaustive && !tfOpenidClientOptionalScopes.Contains(clientScope.Name) {
+			continue
+		}
+
 		optionalScopes = append(optionalScopes, clientScope.Name)
 	}
 
@@ -68,6 +82,11 @@ func resourceKeycloakOpenidClientOptionalScopesReconcile(ctx context.Context, da
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 	tfOpenidClientOptionalScopes := data.Get("optional_scopes").(*schema.Set)
+	exhaustive := data.Get("exhaustive").(bool)
+
+	// scopes which were removed from the config are detached even if the resource isn't exhaustive
+	oldOptionalScopes, _ := data.GetChange("optional_scopes")
+	removedOpenidClientOptionalScopes := oldOptionalScopes.(*schema.Set).Difference(tfOpenidClientOptionalScopes)
 
 	keycloakOpenidClientOptionalScopes, err := keycloakClient.GetOpenidClientOptionalScopes(ctx, realmId, clientId)
 	if err != nil {
@@ -83,7 +102,7 @@ func resourceKeycloakOpenidClientOptionalScopesReconcile(ctx context.Context, da
 		// remove it from the set so we can look at scopes that need to be attached later
 		if tfOpenidClientOptionalScopes.Contains(keycloakOpenidClientOptionalScope.Name) {
 			tfOpenidClientOptionalScopes.Remove(keycloakOpenidClientOptionalScope.Name)
-		} else {
+		} else if exhaustive || removedOpenidClientOptionalScopes.Contains(keycloakOpenidClientOptionalScope.Name) {
 			// if this scope is attached in keycloak but not in tf state, add them to a slice containing all scopes to detach
 			openidClientOptionalScopesToDetach = append(openidClientOptionalScopesToDetach, keycloakOpenidClientOptionalScope.Name)
 		}
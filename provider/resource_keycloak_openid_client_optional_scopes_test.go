@@ -290,6 +290,47 @@ func TestAccKeycloakOpenidClientOptionalScopes_validateDuplicateScopeAssignment(
 	})
 }
 
+// the realm optional scopes Keycloak assigns to new clients are left alone unless they're listed, even when listed scopes are removed
+func TestAccKeycloakOpenidClientOptionalScopes_notExhaustive(t *testing.T) {
+	t.Parallel()
+	client := acctest.RandomWithPrefix("tf-acc")
+	clientScope := "terraform-client-scope-" + acctest.RandString(10)
+
+	preAssignedClientScopes := getPreAssignedOptionalClientScopes()
+	listedRealmOptionalScope := preAssignedClientScopes[0]
+	unlistedRealmOptionalScopes := preAssignedClientScopes[1:]
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientOptionalScopes_notExhaustive(client, clientScope, []string{listedRealmOptionalScope, clientScope}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasOptionalScopes("keycloak_openid_client_optional_scopes.optional_scopes", preAssignedClientScopes),
+					testAccCheckKeycloakOpenidClientHasOptionalScopes("keycloak_openid_client_optional_scopes.optional_scopes", []string{clientScope}),
+					resource.TestCheckResourceAttr("keycloak_openid_client_optional_scopes.optional_scopes", "optional_scopes.#", "2"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientOptionalScopes_notExhaustive(client, clientScope, []string{listedRealmOptionalScope}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasOptionalScopes("keycloak_openid_client_optional_scopes.optional_scopes", preAssignedClientScopes),
+					testAccCheckKeycloakOpenidClientOptionalScopeIsNotAttached("keycloak_openid_client_optional_scopes.optional_scopes", clientScope),
+					resource.TestCheckResourceAttr("keycloak_openid_client_optional_scopes.optional_scopes", "optional_scopes.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientOptionalScopes_notExhaustive(client, clientScope, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasOptionalScopes("keycloak_openid_client_optional_scopes.optional_scopes", unlistedRealmOptionalScopes),
+					testAccCheckKeycloakOpenidClientOptionalScopeIsNotAttached("keycloak_openid_client_optional_scopes.optional_scopes", listedRealmOptionalScope),
+				),
+			},
+		},
+	})
+}
+
 func getOptionalClientScopesFromState(resourceName string, s *terraform.State) ([]*keycloak.OpenidClientScope, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -682,3 +723,31 @@ resource "keycloak_openid_client_optional_scopes" "optional_scopes" {
 	`, testKeycloakOpenidClientDefaultScopes_basic(client, clientScope))
 	}
 }
+
+func testKeycloakOpenidClientOptionalScopes_notExhaustive(client, clientScope string, listOfOptionalScopes []string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "PUBLIC"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_client_optional_scopes" "optional_scopes" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id       = keycloak_openid_client.client.id
+	optional_scopes = %s
+	exhaustive      = false
+
+	depends_on = [keycloak_openid_client_scope.client_scope]
+}
+	`, testAccRealm.Realm, client, clientScope, arrayOfStringsForTerraformResource(listOfOptionalScopes))
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,6 +12,17 @@ import (
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
+// the blocks of the resource, by the name of the scope permission Keycloak creates for them
+var keycloakOpenidClientPermissionsScopes = map[string]string{
+	"view_scope":                   "view",
+	"manage_scope":                 "manage",
+	"configure_scope":              "configure",
+	"map_roles_scope":              "map-roles",
+	"map_roles_client_scope_scope": "map-roles-client-scope",
+	"map_roles_composite_scope":    "map-roles-composite",
+	"token_exchange_scope":         "token-exchange",
+}
+
 func resourceKeycloakOpenidClientPermissions() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKeycloakOpenidClientPermissionsReconcile,
@@ -41,6 +53,12 @@ func resourceKeycloakOpenidClientPermissions() *schema.Resource {
 				Computed:    true,
 				Description: "Resource server id representing the realm management client on which this permission is managed",
 			},
+			"scope_permission_ids": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Ids of the scope permissions Keycloak created for the client, by scope",
+			},
 			"view_scope":                   scopePermissionsSchema(),
 			"manage_scope":                 scopePermissionsSchema(),
 			"configure_scope":              scopePermissionsSchema(),
@@ -78,44 +96,8 @@ func resourceKeycloakOpenidClientPermissionsReconcile(ctx context.Context, data
 		return diag.FromErr(err)
 	}
 
-	if viewScope, ok := data.GetOk("view_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["view"], viewScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if manageScope, ok := data.GetOk("manage_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["manage"], manageScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if configureScope, ok := data.GetOk("configure_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["configure"], configureScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if mapRolesScope, ok := data.GetOk("map_roles_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles"], mapRolesScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if mapRolesClientsScope, ok := data.GetOk("map_roles_client_scope_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-client-scope"], mapRolesClientsScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if mapRolesCompositeScope, ok := data.GetOk("map_roles_composite_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-composite"], mapRolesCompositeScope.(*schema.Set))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if tokenExchangeScope, ok := data.GetOk("token_exchange_scope"); ok {
-		err := setOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["token-exchange"], tokenExchangeScope.(*schema.Set))
+	for key, scope := range keycloakOpenidClientPermissionsScopes {
+		err := reconcileOpenidClientScopePermissionPolicy(ctx, keycloakClient, data, key, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions[scope])
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -125,6 +107,11 @@ func resourceKeycloakOpenidClientPermissionsReconcile(ctx context.Context, data
 }
 
 func resourceKeycloakOpenidClientPermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readOpenidClientPermissions(ctx, data, meta, false)
+}
+
+// readOpenidClientPermissions reads the resource, the scope blocks which aren't part of the state are only read on import
+func readOpenidClientPermissions(ctx context.Context, data *schema.ResourceData, meta interface{}, importing bool) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
@@ -152,47 +139,13 @@ func resourceKeycloakOpenidClientPermissionsRead(ctx context.Context, data *sche
 	data.Set("client_id", openidClientPermissions.ClientId)
 	data.Set("enabled", openidClientPermissions.Enabled)
 	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+	data.Set("scope_permission_ids", openidClientPermissions.ScopePermissions)
 
-	if viewScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["view"]); err == nil && viewScope != nil {
-		data.Set("view_scope", []interface{}{viewScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if manageScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["manage"]); err == nil && manageScope != nil {
-		data.Set("manage_scope", []interface{}{manageScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if mapRolesScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["configure"]); err == nil && mapRolesScope != nil {
-		data.Set("configure_scope", []interface{}{mapRolesScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if manageGroupMembershipScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles"]); err == nil && manageGroupMembershipScope != nil {
-		data.Set("map_roles_scope", []interface{}{manageGroupMembershipScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if impersonateScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-client-scope"]); err == nil && impersonateScope != nil {
-		data.Set("map_roles_client_scope_scope", []interface{}{impersonateScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if userImpersonatedScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["map-roles-composite"]); err == nil && userImpersonatedScope != nil {
-		data.Set("map_roles_composite_scope", []interface{}{userImpersonatedScope})
-	} else if err != nil {
-		return diag.FromErr(err)
-	}
-
-	if tokenExchangeScope, err := getOpenidClientScopePermissionPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions["token-exchange"]); err == nil && tokenExchangeScope != nil {
-		data.Set("token_exchange_scope", []interface{}{tokenExchangeScope})
-	} else if err != nil {
-		return diag.FromErr(err)
+	for key, scope := range keycloakOpenidClientPermissionsScopes {
+		err := setOpenidClientScopePermissionPolicyData(ctx, keycloakClient, data, key, realmId, realmManagementClient.Id, openidClientPermissions.ScopePermissions[scope], importing)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return nil
@@ -207,7 +160,7 @@ func resourceKeycloakOpenidClientPermissionsDelete(ctx context.Context, data *sc
 	return diag.FromErr(keycloakClient.DisableOpenidClientPermissions(ctx, realmId, clientId))
 }
 
-func resourceKeycloakOpenidClientPermissionsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceKeycloakOpenidClientPermissionsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{openidClientId}}")
@@ -217,5 +170,14 @@ func resourceKeycloakOpenidClientPermissionsImport(_ context.Context, d *schema.
 
 	d.SetId(clientPermissionsId(parts[0], parts[1]))
 
+	diagnostics := readOpenidClientPermissions(ctx, d, meta, true)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("permissions of client %s aren't enabled", parts[1])
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
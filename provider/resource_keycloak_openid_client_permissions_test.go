@@ -75,6 +75,12 @@ func testAccCheckKeycloakOpenidClientPermissionExists(resourceName string) resou
 			return fmt.Errorf("decision strategy %s was not equal to %s", authzClientView.DecisionStrategy, viewScopeDecisionStrategy)
 		}
 
+		for scope, scopePermissionId := range permissions.ScopePermissions {
+			if id := rs.Primary.Attributes["scope_permission_ids."+scope]; id != scopePermissionId {
+				return fmt.Errorf("computed scope permission ID %s of scope %s was not equal to %s", id, scope, scopePermissionId)
+			}
+		}
+
 		return nil
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -33,6 +34,13 @@ func TestAccKeycloakOpenidClient_basic(t *testing.T) {
 				ImportStateIdPrefix:     testAccRealm.Realm + "/",
 				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response", "exclude_issuer_from_auth_response"},
 			},
+			{
+				ResourceName:            "keycloak_openid_client.client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           testAccRealm.Realm + "/" + clientId,
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response", "exclude_issuer_from_auth_response"},
+			},
 		},
 	})
 }
@@ -174,6 +182,57 @@ func TestAccKeycloakOpenidClient_clientAuthenticatorType(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_jwtCredential(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	certificate := "MIICyDCCAjGgAwIBAgIBADANBgkqhkiG9w0BAQ0FADCBgDELMAkGA1UEBhMCdXMx"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_jwtCredential(clientId, "client-jwt", `
+	use_jwks_url = true
+	jwks_url     = "https://example.com/jwks.json"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAuthenticatorType("keycloak_openid_client.client", "client-jwt"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "use.jwks.url", "true"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "jwks.url", "https://example.com/jwks.json"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_jwtCredential(clientId, "client-jwt", fmt.Sprintf(`
+	jwt_credential_certificate = "%s"`, certificate)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "use.jwks.url", "false"),
+					testAccCheckKeycloakOpenidClientExtraConfigMissing("keycloak_openid_client.client", "jwks.url"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "jwt.credential.certificate", certificate),
+				),
+			},
+			// switching to another authenticator is done in place
+			{
+				Config: testKeycloakOpenidClient_jwtCredential(clientId, "client-secret", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAuthenticatorType("keycloak_openid_client.client", "client-secret"),
+					testAccCheckKeycloakOpenidClientExtraConfigMissing("keycloak_openid_client.client", "jwt.credential.certificate"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_jwtCredential(clientId, "client-secret", `
+	jwks_url = "https://example.com/jwks.json"`),
+				ExpectError: regexp.MustCompile("can only be used with the client-jwt client authenticator"),
+			},
+			{
+				Config: testKeycloakOpenidClient_jwtCredential(clientId, "client-jwt", `
+	use_jwks_url = true`),
+				ExpectError: regexp.MustCompile("use_jwks_url requires a jwks_url"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_updateInPlace(t *testing.T) {
 	t.Parallel()
 	clientId := acctest.RandomWithPrefix("tf-acc")
@@ -284,6 +343,44 @@ func TestAccKeycloakOpenidClient_backChannel(t *testing.T) {
 					testAccCheckKeycloakOpenidClientHasBackchannelSettings("keycloak_openid_client.client", backchannelLogoutUrl, backchannelLogoutSessionRequired, backchannelLogoutRevokeOfflineSessions),
 				),
 			},
+			// an empty logout url clears the attribute
+			{
+				Config: testKeycloakOpenidClient_backchannel(clientId, "", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasBackchannelSettings("keycloak_openid_client.client", "", true, false),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "backchannel_logout_url", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_backChannelValidation(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_backchannel(clientId, "", true, true),
+				ExpectError: regexp.MustCompile("revoking offline sessions on backchannel logout requires a backchannel logout url"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_backchannel(clientId, "/backchannel", true, false),
+				ExpectError: regexp.MustCompile("must be an absolute http or https url"),
+			},
+			{
+				Config: testKeycloakOpenidClient_backchannel(clientId, "", false, false),
+				Check:  testAccCheckKeycloakOpenidClientHasBackchannelSettings("keycloak_openid_client.client", "", false, false),
+			},
+			{
+				Config: testKeycloakOpenidClient_backchannel(clientId, "https://example.com/backchannel", false, true),
+				Check:  testAccCheckKeycloakOpenidClientHasBackchannelSettings("keycloak_openid_client.client", "https://example.com/backchannel", false, true),
+			},
 		},
 	})
 }
@@ -649,6 +746,15 @@ func TestAccKeycloakOpenidClient_authenticationFlowBindingOverrides(t *testing.T
 				Config: testKeycloakOpenidClient_withoutAuthenticationFlowBindingOverrides(clientId),
 				Check:  testAccCheckKeycloakOpenidClientAuthenticationFlowBindingOverrides("keycloak_openid_client.client", ""),
 			},
+			{
+				Config: testKeycloakOpenidClient_authenticationFlowBindingOverrides(clientId),
+				Check:  testAccCheckKeycloakOpenidClientAuthenticationFlowBindingOverrides("keycloak_openid_client.client", "keycloak_authentication_flow.another_flow"),
+			},
+			// removing the override and its flow at the same time must not leave the client pointing at a deleted flow
+			{
+				Config: testKeycloakOpenidClient_withoutAuthenticationFlow(clientId),
+				Check:  testAccCheckKeycloakOpenidClientAuthenticationFlowBindingOverrides("keycloak_openid_client.client", ""),
+			},
 		},
 	})
 }
@@ -796,6 +902,119 @@ func TestAccKeycloakOpenidClient_extraConfigInvalid(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_tokenEndpointAuthSigningAlg(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_tokenEndpointAuthSigningAlg(clientId, "RS256"),
+				Check:  testAccCheckKeycloakOpenidClientExtraConfig("keycloak_openid_client.client", "token.endpoint.auth.signing.alg", "RS256"),
+			},
+			{
+				Config: testKeycloakOpenidClient_tokenEndpointAuthSigningAlg(clientId, "ES256"),
+				Check:  testAccCheckKeycloakOpenidClientExtraConfig("keycloak_openid_client.client", "token.endpoint.auth.signing.alg", "ES256"),
+			},
+			{
+				Config: testKeycloakOpenidClient_basic(clientId),
+				Check:  testAccCheckKeycloakOpenidClientExtraConfigMissing("keycloak_openid_client.client", "token.endpoint.auth.signing.alg"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_tokenEndpointAuthSigningAlgConflictsWithExtraConfig(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_tokenEndpointAuthSigningAlgAndExtraConfig(clientId),
+				ExpectError: regexp.MustCompile(`"token_endpoint_auth_signing_alg" and extra_config "token.endpoint.auth.signing.alg" can't be set at the same time`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_introspectionResponseAlgorithms(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_introspectionResponseAlgorithms(clientId, "PS256", "RSA-OAEP", "A256GCM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientExtraConfig("keycloak_openid_client.client", "introspection.signed.response.alg", "PS256"),
+					testAccCheckKeycloakOpenidClientExtraConfig("keycloak_openid_client.client", "introspection.encrypted.response.alg", "RSA-OAEP"),
+					testAccCheckKeycloakOpenidClientExtraConfig("keycloak_openid_client.client", "introspection.encrypted.response.enc", "A256GCM"),
+				),
+			},
+			{
+				Config:      testKeycloakOpenidClient_introspectionResponseAlgorithms(clientId, "PS257", "RSA-OAEP", "A256GCM"),
+				ExpectError: regexp.MustCompile("validation error: introspection_signed_response_alg PS257 is not supported by the server"),
+			},
+			{
+				Config:      testKeycloakOpenidClient_introspectionResponseAlgorithms(clientId, "PS256", "", "A256GCM"),
+				ExpectError: regexp.MustCompile("validation error: introspection_encrypted_response_enc requires introspection_encrypted_response_alg to be set"),
+			},
+			{
+				Config: testKeycloakOpenidClient_basic(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientExtraConfigMissing("keycloak_openid_client.client", "introspection.signed.response.alg"),
+					testAccCheckKeycloakOpenidClientExtraConfigMissing("keycloak_openid_client.client", "introspection.encrypted.response.alg"),
+					testAccCheckKeycloakOpenidClientExtraConfigMissing("keycloak_openid_client.client", "introspection.encrypted.response.enc"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_requirePushedAuthorizationRequests(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_requirePushedAuthorizationRequests(clientId, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientExtraConfig("keycloak_openid_client.client", "require.pushed.authorization.requests", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "require_pushed_authorization_requests", "true"),
+				),
+			},
+			{
+				ResourceName:            "keycloak_openid_client.client",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"exclude_session_state_from_auth_response", "exclude_issuer_from_auth_response"},
+			},
+			{
+				Config: testKeycloakOpenidClient_requirePushedAuthorizationRequests(clientId, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientExtraConfig("keycloak_openid_client.client", "require.pushed.authorization.requests", "false"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "require_pushed_authorization_requests", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_oauth2DeviceAuthorizationGrantEnabled(t *testing.T) {
 	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_13); !ok {
 		t.Skip()
@@ -821,68 +1040,394 @@ func TestAccKeycloakOpenidClient_oauth2DeviceAuthorizationGrantEnabled(t *testin
 	})
 }
 
-func testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		client, err := getOpenidClientFromState(s, resourceName)
-		if err != nil {
-			return err
-		}
-
-		if client.Protocol != "openid-connect" {
-			return fmt.Errorf("expected openid client to have openid-connect protocol, but got %s", client.Protocol)
-		}
+func TestAccKeycloakOpenidClient_lightweightAccessToken(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
 
-		return nil
-	}
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_lightweightAccessToken(clientId, true),
+				Check:  testAccCheckKeycloakOpenidClientLightweightAccessToken("keycloak_openid_client.client", true),
+			},
+			{
+				Config: testKeycloakOpenidClient_lightweightAccessToken(clientId, false),
+				Check:  testAccCheckKeycloakOpenidClientLightweightAccessToken("keycloak_openid_client.client", false),
+			},
+		},
+	})
 }
 
-func testAccCheckKeycloakOpenidClientExistsWithCorrectConsentSettings(resourceName string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		client, err := getOpenidClientFromState(s, resourceName)
-		if err != nil {
-			return err
-		}
-
-		if client.ConsentRequired != true {
-			return fmt.Errorf("expected openid client to have ConsentRequired %v, but got %v", true, client.ConsentRequired)
-		}
-
-		if client.Attributes.DisplayOnConsentScreen != true {
-			return fmt.Errorf("expected openid client to have DisplayClientOnConsentScreen %v, but got %v", true, client.Attributes.DisplayOnConsentScreen)
-		}
+func TestAccKeycloakOpenidClient_registrationAccessToken(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
 
-		if client.Attributes.ConsentScreenText != "some consent screen text" {
-			return fmt.Errorf("expected openid client to have ConsentScreenText %v, but got %v", "some consent screen text", client.Attributes.ConsentScreenText)
-		}
+	var registrationAccessToken string
 
-		return nil
-	}
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_registrationAccessToken(clientId, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keycloak_openid_client.client", "registration_access_token"),
+					func(state *terraform.State) error {
+						registrationAccessToken = state.RootModule().Resources["keycloak_openid_client.client"].Primary.Attributes["registration_access_token"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_registrationAccessToken(clientId, "2"),
+				Check: func(state *terraform.State) error {
+					if state.RootModule().Resources["keycloak_openid_client.client"].Primary.Attributes["registration_access_token"] == registrationAccessToken {
+						return fmt.Errorf("expected registration access token to be regenerated")
+					}
+					return nil
+				},
+			},
+		},
+	})
 }
 
-func testAccCheckKeycloakOpenidClientHasBackchannelSettings(resourceName, backchannelLogoutUrl string, backchannelLogoutSessionRequired, backchannelLogoutRevokeOfflineSessions bool) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		client, err := getOpenidClientFromState(s, resourceName)
-		if err != nil {
-			return err
-		}
-
-		if client.Attributes.BackchannelLogoutUrl != backchannelLogoutUrl {
-			return fmt.Errorf("expected openid client to have backchannel logout url %s, got %s", backchannelLogoutUrl, client.Attributes.BackchannelLogoutUrl)
-		}
+func TestAccKeycloakOpenidClient_ciba(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
 
-		if bool(client.Attributes.BackchannelLogoutSessionRequired) != backchannelLogoutSessionRequired {
-			return fmt.Errorf("expected openid client to have backchannel session required bool %t, got %t", backchannelLogoutSessionRequired, bool(client.Attributes.BackchannelLogoutSessionRequired))
-		}
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_ciba(clientId, "push", ""),
+				ExpectError: regexp.MustCompile(`expected ciba_backchannel_token_delivery_mode to be one of`),
+			},
+			{
+				Config:      testKeycloakOpenidClient_ciba(clientId, "ping", ""),
+				ExpectError: regexp.MustCompile("ciba ping delivery mode requires a client notification endpoint"),
+			},
+			{
+				Config: testKeycloakOpenidClient_ciba(clientId, "poll", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol("keycloak_openid_client.client"),
+					testAccCheckKeycloakOpenidClientCiba("keycloak_openid_client.client", "poll", ""),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_ciba(clientId, "ping", "https://example.com/ciba/notify"),
+				Check:  testAccCheckKeycloakOpenidClientCiba("keycloak_openid_client.client", "ping", "https://example.com/ciba/notify"),
+			},
+		},
+	})
+}
 
-		if bool(client.Attributes.BackchannelLogoutRevokeOfflineTokens) != backchannelLogoutRevokeOfflineSessions {
-			return fmt.Errorf("expected openid client to have backchannel revoke offline sessions bool %t, got %t", backchannelLogoutRevokeOfflineSessions, bool(client.Attributes.BackchannelLogoutRevokeOfflineTokens))
-		}
+func TestAccKeycloakOpenidClient_equivalentUrisDoNotDrift(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
 
-		return nil
-	}
-}
-func testAccCheckKeycloakOpenidClientHasFrontchannelSettings(resourceName, frontChannelLogoutUrl string, frontChannelLogoutEnabled bool) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_uris(clientId, "https://example.com/callback", "https://app.example.com/logout", "https://example.com"),
+				Check:  testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol("keycloak_openid_client.client"),
+			},
+			{
+				Config:   testKeycloakOpenidClient_uris(clientId, "HTTPS://Example.com:443/callback", "https://APP.example.com/logout", "https://example.com:443"),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakOpenidClient_uris(clientId, "https://example.com/Callback", "https://app.example.com/logout", "https://example.com"),
+				Check:  resource.TestCheckTypeSetElemAttr("keycloak_openid_client.client", "valid_redirect_uris.*", "https://example.com/Callback"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_mutualTls(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_mutualTls(clientId, "client-secret", "CN=workload", false),
+				ExpectError: regexp.MustCompile("a tls client auth subject dn can only be used with the client-x509 client authenticator"),
+			},
+			{
+				Config: testKeycloakOpenidClient_mutualTls(clientId, "client-x509", "CN=workload", false),
+				Check:  testAccCheckKeycloakOpenidClientMutualTls("keycloak_openid_client.client", "CN=workload", false),
+			},
+			{
+				Config: testKeycloakOpenidClient_mutualTls(clientId, "client-x509", "CN=workload-.*", true),
+				Check:  testAccCheckKeycloakOpenidClientMutualTls("keycloak_openid_client.client", "CN=workload-.*", true),
+			},
+			{
+				Config:      testKeycloakOpenidClient_mutualTlsAndExtraConfig(clientId, `tls_client_auth_subject_dn = "CN=workload"`),
+				ExpectError: regexp.MustCompile(`"tls_client_auth_subject_dn" and extra_config "x509.subjectdn" can't be set at the same time`),
+			},
+			{
+				Config: testKeycloakOpenidClient_mutualTlsAndExtraConfig(clientId, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "x509.subjectdn", "CN=extra-config"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "tls.client.certificate.bound.access.tokens", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "tls_client_auth_subject_dn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_standardTokenExchange(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_2); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_standardTokenExchange(clientId, false, true),
+				ExpectError: regexp.MustCompile("refresh tokens can only be requested through standard token exchange when standard token exchange is enabled"),
+			},
+			{
+				Config: testKeycloakOpenidClient_standardTokenExchange(clientId, true, false),
+				Check:  testAccCheckKeycloakOpenidClientStandardTokenExchange("keycloak_openid_client.client", true, "NO"),
+			},
+			{
+				Config: testKeycloakOpenidClient_standardTokenExchange(clientId, true, true),
+				Check:  testAccCheckKeycloakOpenidClientStandardTokenExchange("keycloak_openid_client.client", true, "SAME_SESSION"),
+			},
+			{
+				Config: testKeycloakOpenidClient_standardTokenExchange(clientId, false, false),
+				Check:  testAccCheckKeycloakOpenidClientStandardTokenExchange("keycloak_openid_client.client", false, "NO"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_standardTokenExchangeUnsupported(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_2); ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				// the attributes aren't sent to versions which don't support them
+				Config: testKeycloakOpenidClient_standardTokenExchange(clientId, false, false),
+				Check: func(s *terraform.State) error {
+					client, err := getOpenidClientFromState(s, "keycloak_openid_client.client")
+					if err != nil {
+						return err
+					}
+
+					if client.Attributes.StandardTokenExchangeEnabled != nil || client.Attributes.StandardTokenExchangeRefresh != nil {
+						return fmt.Errorf("expected openid client to have no standard token exchange attributes")
+					}
+
+					return nil
+				},
+			},
+			{
+				Config:      testKeycloakOpenidClient_standardTokenExchange(clientId, true, false),
+				ExpectError: regexp.MustCompile("require Keycloak 26.2 or later"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_verifiableCredentials(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_verifiableCredentials(clientId, map[string]string{"IdentityCredential": "vc+sd-jwt", "StudentCredential": "jwt_vc"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "verifiable_credential.#", "2"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "vc.IdentityCredential.format", "vc+sd-jwt"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "vc.StudentCredential.scope", "StudentCredential"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_verifiableCredentials(clientId, map[string]string{"IdentityCredential": "vc+sd-jwt"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "verifiable_credential.#", "1"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "vc.StudentCredential.format", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_acrLoaMap(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_acrLoaMap(clientId, map[string]int{"silver": 1, "gold": 2}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAcrLoaMap("keycloak_openid_client.client", "{\"gold\":2,\"silver\":1}"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "acr_loa_map.gold", "2"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_acrLoaMap(clientId, map[string]int{"silver": 1}),
+				Check:  testAccCheckKeycloakOpenidClientAcrLoaMap("keycloak_openid_client.client", "{\"silver\":1}"),
+			},
+			{
+				Config: testKeycloakOpenidClient_extraConfig(clientId, map[string]string{"acr.loa.map": `{\"normal\":\"1\",\"transfer\":\"2\"}`}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAcrLoaMap("keycloak_openid_client.client", "{\"normal\":\"1\",\"transfer\":\"2\"}"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "acr_loa_map.%", "0"),
+				),
+			},
+			{
+				Config:      testKeycloakOpenidClient_acrLoaMapAndExtraConfig(clientId),
+				ExpectError: regexp.MustCompile(`"acr_loa_map" and extra_config "acr.loa.map" can't be set at the same time`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_acrValues(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_acrValues(realmName, clientId, `["gold", "silver"]`, "silver"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "default.acr.values", "gold##silver"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "minimum.acr.value", "silver"),
+				),
+			},
+			{
+				// the client's own mapping and numeric levels are accepted as well
+				Config: testKeycloakOpenidClient_acrValues(realmName, clientId, `["platinum", "1"]`, "gold"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "default.acr.values", "platinum##1"),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "minimum.acr.value", "gold"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_acrValues(realmName, clientId, `[]`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "default.acr.values", ""),
+					testAccCheckKeycloakOpenidClientHasAttribute("keycloak_openid_client.client", "minimum.acr.value", ""),
+				),
+			},
+			{
+				Config:      testKeycloakOpenidClient_acrValues(realmName, clientId, `["bronze"]`, ""),
+				ExpectError: regexp.MustCompile("acr value bronze is neither mapped to a level of authentication"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.Protocol != "openid-connect" {
+			return fmt.Errorf("expected openid client to have openid-connect protocol, but got %s", client.Protocol)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientExistsWithCorrectConsentSettings(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.ConsentRequired != true {
+			return fmt.Errorf("expected openid client to have ConsentRequired %v, but got %v", true, client.ConsentRequired)
+		}
+
+		if client.Attributes.DisplayOnConsentScreen != true {
+			return fmt.Errorf("expected openid client to have DisplayClientOnConsentScreen %v, but got %v", true, client.Attributes.DisplayOnConsentScreen)
+		}
+
+		if client.Attributes.ConsentScreenText != "some consent screen text" {
+			return fmt.Errorf("expected openid client to have ConsentScreenText %v, but got %v", "some consent screen text", client.Attributes.ConsentScreenText)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientHasBackchannelSettings(resourceName, backchannelLogoutUrl string, backchannelLogoutSessionRequired, backchannelLogoutRevokeOfflineSessions bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.Attributes.BackchannelLogoutUrl != backchannelLogoutUrl {
+			return fmt.Errorf("expected openid client to have backchannel logout url %s, got %s", backchannelLogoutUrl, client.Attributes.BackchannelLogoutUrl)
+		}
+
+		if bool(client.Attributes.BackchannelLogoutSessionRequired) != backchannelLogoutSessionRequired {
+			return fmt.Errorf("expected openid client to have backchannel session required bool %t, got %t", backchannelLogoutSessionRequired, bool(client.Attributes.BackchannelLogoutSessionRequired))
+		}
+
+		if bool(client.Attributes.BackchannelLogoutRevokeOfflineTokens) != backchannelLogoutRevokeOfflineSessions {
+			return fmt.Errorf("expected openid client to have backchannel revoke offline sessions bool %t, got %t", backchannelLogoutRevokeOfflineSessions, bool(client.Attributes.BackchannelLogoutRevokeOfflineTokens))
+		}
+
+		return nil
+	}
+}
+func testAccCheckKeycloakOpenidClientHasFrontchannelSettings(resourceName, frontChannelLogoutUrl string, frontChannelLogoutEnabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
 		if err != nil {
 			return err
@@ -1241,6 +1786,106 @@ func testAccCheckKeycloakOpenidClientOauth2DeviceAuthorizationGrantEnabled(resou
 	}
 }
 
+func testAccCheckKeycloakOpenidClientLightweightAccessToken(resourceName string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.Attributes.UseLightweightAccessToken != types.KeycloakBoolQuoted(enabled) {
+			return fmt.Errorf("expected openid client to have lightweight access tokens set to %t, but got %v", enabled, client.Attributes.UseLightweightAccessToken)
+		}
+
+		if client.Attributes.IntrospectionResponseAllowJwtClaim != types.KeycloakBoolQuoted(enabled) {
+			return fmt.Errorf("expected openid client to have introspection jwt claim set to %t, but got %v", enabled, client.Attributes.IntrospectionResponseAllowJwtClaim)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientCiba(resourceName, deliveryMode, notificationEndpoint string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !client.Attributes.CibaGrantEnabled {
+			return fmt.Errorf("expected openid client to have ciba grant enabled")
+		}
+
+		if client.Attributes.CibaBackchannelTokenDeliveryMode != deliveryMode {
+			return fmt.Errorf("expected openid client to have ciba token delivery mode %s, but got %s", deliveryMode, client.Attributes.CibaBackchannelTokenDeliveryMode)
+		}
+
+		if client.Attributes.CibaBackchannelClientNotificationUrl != notificationEndpoint {
+			return fmt.Errorf("expected openid client to have ciba client notification endpoint %s, but got %s", notificationEndpoint, client.Attributes.CibaBackchannelClientNotificationUrl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientMutualTls(resourceName, subjectDn string, allowRegexPatternComparison bool) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "tls.client.certificate.bound.access.tokens", "true"),
+		testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "x509.subjectdn", subjectDn),
+		testAccCheckKeycloakOpenidClientHasAttribute(resourceName, "x509.allow.regex.pattern.comparison", strconv.FormatBool(allowRegexPatternComparison)),
+	)
+}
+
+func testAccCheckKeycloakOpenidClientHasAttribute(resourceName, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if v, _ := client.Attributes.ExtraConfig[key].(string); v != value {
+			return fmt.Errorf("expected openid client to have attribute %s set to %s, but got %s", key, value, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientStandardTokenExchange(resourceName string, enabled bool, refresh string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		actual := client.Attributes.StandardTokenExchangeEnabled != nil && bool(*client.Attributes.StandardTokenExchangeEnabled)
+		if actual != enabled {
+			return fmt.Errorf("expected openid client to have standard token exchange enabled set to %t, but got %t", enabled, actual)
+		}
+
+		if client.Attributes.StandardTokenExchangeRefresh == nil || *client.Attributes.StandardTokenExchangeRefresh != refresh {
+			return fmt.Errorf("expected openid client to have standard token exchange refresh set to %s, but got %v", refresh, client.Attributes.StandardTokenExchangeRefresh)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOpenidClientAcrLoaMap(resourceName string, acrLoaMap string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if client.Attributes.ExtraConfig["acr.loa.map"] != acrLoaMap {
+			return fmt.Errorf("expected openid client to have acr.loa.map set to %s, but got %v", acrLoaMap, client.Attributes.ExtraConfig["acr.loa.map"])
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientExtraConfig(resourceName string, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -1409,6 +2054,22 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, authType)
 }
 
+func testKeycloakOpenidClient_jwtCredential(clientId, authType, jwtCredential string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                  = data.keycloak_realm.realm.id
+	client_id                 = "%s"
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "%s"
+%s
+}
+	`, testAccRealm.Realm, clientId, authType, jwtCredential)
+}
+
 func testKeycloakOpenidClient_pkceChallengeMethod(clientId, pkceChallengeMethod string) string {
 
 	return fmt.Sprintf(`
@@ -1721,6 +2382,20 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId)
 }
 
+func testKeycloakOpenidClient_withoutAuthenticationFlow(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "PUBLIC"
+}
+	`, testAccRealm.Realm, clientId)
+}
+
 func testKeycloakOpenidClient_loginTheme(clientId, loginTheme string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -1790,6 +2465,118 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, sb.String())
 }
 
+func testKeycloakOpenidClient_tokenEndpointAuthSigningAlg(clientId, alg string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                       = "%s"
+	realm_id                        = data.keycloak_realm.realm.id
+	access_type                     = "CONFIDENTIAL"
+	client_authenticator_type       = "client-jwt"
+	token_endpoint_auth_signing_alg = "%s"
+}
+	`, testAccRealm.Realm, clientId, alg)
+}
+
+func testKeycloakOpenidClient_tokenEndpointAuthSigningAlgAndExtraConfig(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                       = "%s"
+	realm_id                        = data.keycloak_realm.realm.id
+	access_type                     = "CONFIDENTIAL"
+	client_authenticator_type       = "client-jwt"
+	token_endpoint_auth_signing_alg = "RS256"
+
+	extra_config = {
+		"token.endpoint.auth.signing.alg" = "ES256"
+	}
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakOpenidClient_introspectionResponseAlgorithms(clientId, signedResponseAlg, encryptedResponseAlg, encryptedResponseEnc string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                              = "%s"
+	realm_id                               = data.keycloak_realm.realm.id
+	access_type                            = "CONFIDENTIAL"
+	introspection_response_allow_jwt_claim = true
+	introspection_signed_response_alg      = "%s"
+	introspection_encrypted_response_alg   = "%s"
+	introspection_encrypted_response_enc   = "%s"
+}
+	`, testAccRealm.Realm, clientId, signedResponseAlg, encryptedResponseAlg, encryptedResponseEnc)
+}
+
+func testKeycloakOpenidClient_requirePushedAuthorizationRequests(clientId string, requirePushedAuthorizationRequests bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                             = "%s"
+	realm_id                              = data.keycloak_realm.realm.id
+	access_type                           = "CONFIDENTIAL"
+	require_pushed_authorization_requests = %t
+}
+	`, testAccRealm.Realm, clientId, requirePushedAuthorizationRequests)
+}
+
+func testKeycloakOpenidClient_acrLoaMap(clientId string, acrLoaMap map[string]int) string {
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for k, v := range acrLoaMap {
+		sb.WriteString(fmt.Sprintf("\t\t\"%s\" = %d\n", k, v))
+	}
+	sb.WriteString("}")
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+	acr_loa_map = %s
+}
+	`, testAccRealm.Realm, clientId, sb.String())
+}
+
+func testKeycloakOpenidClient_acrLoaMapAndExtraConfig(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+	acr_loa_map = {
+		silver = 1
+	}
+
+	extra_config = {
+		"acr.loa.map" = "{\"gold\":\"2\"}"
+	}
+}
+	`, testAccRealm.Realm, clientId)
+}
+
 func testKeycloakOpenidClient_oauth2DeviceAuthorizationGrantEnabled(clientId string, oauth2DeviceAuthorizationGrantEnabled bool) string {
 
 	return fmt.Sprintf(`
@@ -1823,6 +2610,186 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, oauth2DeviceAuthorizationGrantEnabled, oauth2DeviceCodeLifespan, oauth2DevicePollingInterval)
 }
 
+func testKeycloakOpenidClient_lightweightAccessToken(clientId string, enabled bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                              = "%s"
+	realm_id                               = data.keycloak_realm.realm.id
+	access_type                            = "CONFIDENTIAL"
+	use_lightweight_access_token           = %t
+	introspection_response_allow_jwt_claim = %t
+}
+	`, testAccRealm.Realm, clientId, enabled, enabled)
+}
+
+func testKeycloakOpenidClient_registrationAccessToken(clientId, rotation string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+
+	registration_access_token_regenerate_when_changed = {
+		rotation = "%s"
+	}
+}
+	`, testAccRealm.Realm, clientId, rotation)
+}
+
+func testKeycloakOpenidClient_ciba(clientId, deliveryMode, notificationEndpoint string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                                     = "%s"
+	realm_id                                      = data.keycloak_realm.realm.id
+	access_type                                   = "CONFIDENTIAL"
+	ciba_grant_enabled                            = true
+	ciba_backchannel_token_delivery_mode          = "%s"
+	ciba_backchannel_auth_request_signing_alg     = "RS256"
+	ciba_backchannel_client_notification_endpoint = "%s"
+}
+	`, testAccRealm.Realm, clientId, deliveryMode, notificationEndpoint)
+}
+
+func testKeycloakOpenidClient_uris(clientId, redirectUri, postLogoutRedirectUri, webOrigin string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id             = "%s"
+	realm_id              = data.keycloak_realm.realm.id
+	access_type           = "CONFIDENTIAL"
+	standard_flow_enabled = true
+
+	valid_redirect_uris = [
+		"%s",
+		"http://localhost:8080/callback",
+	]
+	valid_post_logout_redirect_uris = ["%s"]
+	web_origins                     = ["%s", "+"]
+}
+	`, testAccRealm.Realm, clientId, redirectUri, postLogoutRedirectUri, webOrigin)
+}
+
+func testKeycloakOpenidClient_mutualTls(clientId, clientAuthenticatorType, subjectDn string, allowRegexPatternComparison bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                                  = "%s"
+	realm_id                                   = data.keycloak_realm.realm.id
+	access_type                                = "CONFIDENTIAL"
+	service_accounts_enabled                   = true
+	client_authenticator_type                  = "%s"
+	tls_client_certificate_bound_access_tokens = true
+	tls_client_auth_subject_dn                 = "%s"
+	x509_allow_regex_pattern_comparison        = %t
+}
+	`, testAccRealm.Realm, clientId, clientAuthenticatorType, subjectDn, allowRegexPatternComparison)
+}
+
+func testKeycloakOpenidClient_mutualTlsAndExtraConfig(clientId, subjectDn string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                 = "%s"
+	realm_id                  = data.keycloak_realm.realm.id
+	access_type               = "CONFIDENTIAL"
+	service_accounts_enabled  = true
+	client_authenticator_type = "client-x509"
+	%s
+
+	extra_config = {
+		"x509.subjectdn"                             = "CN=extra-config"
+		"tls.client.certificate.bound.access.tokens" = "true"
+	}
+}
+	`, testAccRealm.Realm, clientId, subjectDn)
+}
+
+func testKeycloakOpenidClient_acrValues(realm, clientId, defaultAcrValues, minimumAcrValue string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm       = "%s"
+	acr_loa_map = {
+		silver = 1
+		gold   = 2
+	}
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id          = "%s"
+	realm_id           = keycloak_realm.realm.id
+	access_type        = "CONFIDENTIAL"
+	acr_loa_map        = {
+		platinum = 3
+	}
+	default_acr_values = %s
+	minimum_acr_value  = "%s"
+}
+	`, realm, clientId, defaultAcrValues, minimumAcrValue)
+}
+
+func testKeycloakOpenidClient_standardTokenExchange(clientId string, enabled, refreshEnabled bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id                               = "%s"
+	realm_id                                = data.keycloak_realm.realm.id
+	access_type                             = "CONFIDENTIAL"
+	standard_token_exchange_enabled         = %t
+	standard_token_exchange_refresh_enabled = %t
+}
+	`, testAccRealm.Realm, clientId, enabled, refreshEnabled)
+}
+
+func testKeycloakOpenidClient_verifiableCredentials(clientId string, credentials map[string]string) string {
+	var credentialBlocks strings.Builder
+	for credentialId, format := range credentials {
+		credentialBlocks.WriteString(fmt.Sprintf(`
+	verifiable_credential {
+		credential_id = "%s"
+		format        = "%s"
+		scope         = "%s"
+	}
+`, credentialId, format, credentialId))
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+%s
+}
+	`, testAccRealm.Realm, clientId, credentialBlocks.String())
+}
+
 func testKeycloakOpenidClient_import(clientId string, enabled bool) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
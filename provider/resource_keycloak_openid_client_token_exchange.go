@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// Grants clients the permission to exchange tokens for the source client. It enables the permissions of the source client,
+// creates a client policy for the allowed clients and binds it to the token-exchange scope permission Keycloak creates.
+// The permission is given the AFFIRMATIVE decision strategy, so that each of its policies grants the exchange on its own.
+func resourceKeycloakOpenidClientTokenExchange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientTokenExchangeCreate,
+		ReadContext:   resourceKeycloakOpenidClientTokenExchangeRead,
+		UpdateContext: resourceKeycloakOpenidClientTokenExchangeUpdate,
+		DeleteContext: resourceKeycloakOpenidClientTokenExchangeDelete,
+		// This resource can be imported using {{realmId}}/{{sourceClient}}/{{policyId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOpenidClientTokenExchangeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_client": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the client tokens are exchanged for, the audience of the exchanged tokens",
+			},
+			"allowed_clients": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				MinItems:    1,
+				Description: "Ids of the clients which are allowed to exchange tokens for the source client",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the client policy bound to the token-exchange scope permission of the source client",
+			},
+			"authorization_resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Resource server id representing the realm management client on which the permission is managed",
+			},
+			"authorization_token_exchange_scope_permission_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the token-exchange scope permission Keycloak created for the source client",
+			},
+			"permissions_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the permissions of the source client were enabled by this resource, they are only disabled on destroy in that case",
+			},
+		},
+	}
+}
+
+func openidClientTokenExchangeId(realmId, sourceClient, policyId string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, sourceClient, policyId)
+}
+
+// The policies of a token-exchange permission can only be replaced all at once, so changes of the resources sharing
+// the same source client have to be serialized.
+var openidClientTokenExchangeMutexes sync.Map
+
+func lockOpenidClientTokenExchange(realmId, sourceClient string) func() {
+	mutex, _ := openidClientTokenExchangeMutexes.LoadOrStore(realmId+"/"+sourceClient, &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+
+	return mutex.(*sync.Mutex).Unlock
+}
+
+// getOpenidClientTokenExchangeScopePermission returns the token-exchange scope permission of a client, whose permissions
+// have to be enabled
+func getOpenidClientTokenExchangeScopePermission(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, realmManagementClientId string, openidClientPermissions *keycloak.OpenidClientPermissions) (*keycloak.OpenidClientAuthorizationPermission, error) {
+	permissionId, ok := openidClientPermissions.ScopePermissions["token-exchange"]
+	if !ok {
+		return nil, fmt.Errorf("client %s has no token-exchange scope permission, the token_exchange feature has to be enabled", openidClientPermissions.ClientId)
+	}
+
+	return keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClientId, permissionId)
+}
+
+func resourceKeycloakOpenidClientTokenExchangeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	sourceClient := data.Get("source_client").(string)
+	allowedClients := interfaceSliceToStringSlice(data.Get("allowed_clients").(*schema.Set).List())
+
+	unlock := lockOpenidClientTokenExchange(realmId, sourceClient)
+	defer unlock()
+
+	client, err := keycloakClient.GetOpenidClient(ctx, realmId, sourceClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, sourceClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permissionsEnabled := !openidClientPermissions.Enabled
+	if permissionsEnabled {
+		err = keycloakClient.EnableOpenidClientPermissions(ctx, realmId, sourceClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		openidClientPermissions, err = keycloakClient.GetOpenidClientPermissions(ctx, realmId, sourceClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission, err := getOpenidClientTokenExchangeScopePermission(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyId, err := createClientPolicy(ctx, keycloakClient, realmId, realmManagementClient.Id, client.ClientId, "token_exchange_client_policy", allowedClients)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// policies bound to the permission elsewhere are kept. Keycloak creates the permission with the UNANIMOUS decision strategy,
+	// which would only allow the clients listed in every policy.
+	permission.Policies = append(permission.Policies, policyId)
+	permission.DecisionStrategy = "AFFIRMATIVE"
+	err = keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
+	if err != nil {
+		_ = keycloakClient.DeleteOpenidClientAuthorizationClientPolicy(ctx, realmId, realmManagementClient.Id, policyId)
+		return diag.FromErr(err)
+	}
+
+	data.SetId(openidClientTokenExchangeId(realmId, sourceClient, policyId))
+	data.Set("policy_id", policyId)
+	data.Set("permissions_enabled", permissionsEnabled)
+
+	return resourceKeycloakOpenidClientTokenExchangeRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenidClientTokenExchangeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	sourceClient := data.Get("source_client").(string)
+	policyId := data.Get("policy_id").(string)
+
+	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, sourceClient)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if !openidClientPermissions.Enabled {
+		tflog.Warn(ctx, "Removing resource from state as the permissions of the source client are no longer enabled", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+		return nil
+	}
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission, err := getOpenidClientTokenExchangeScopePermission(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !stringSliceContains(permission.Policies, policyId) {
+		tflog.Warn(ctx, "Removing resource from state as the client policy is no longer bound to the token-exchange permission", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+		return nil
+	}
+
+	policy, err := keycloakClient.GetOpenidClientAuthorizationClientPolicy(ctx, realmId, realmManagementClient.Id, policyId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.SetId(openidClientTokenExchangeId(realmId, sourceClient, policyId))
+	data.Set("allowed_clients", policy.Clients)
+	data.Set("authorization_resource_server_id", realmManagementClient.Id)
+	data.Set("authorization_token_exchange_scope_permission_id", permission.Id)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientTokenExchangeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	sourceClient := data.Get("source_client").(string)
+	policyId := data.Get("policy_id").(string)
+	allowedClients := interfaceSliceToStringSlice(data.Get("allowed_clients").(*schema.Set).List())
+
+	unlock := lockOpenidClientTokenExchange(realmId, sourceClient)
+	defer unlock()
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := keycloakClient.GetOpenidClientAuthorizationClientPolicy(ctx, realmId, realmManagementClient.Id, policyId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy.Clients = allowedClients
+	err = keycloakClient.UpdateOpenidClientAuthorizationClientPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenidClientTokenExchangeRead(ctx, data, meta)
+}
+
+// The binding is removed before the policy is deleted, the token-exchange permission gets back the UNANIMOUS decision
+// strategy Keycloak creates it with when no policies are left. The permissions of the source client are only disabled if
+// this resource enabled them and none of its scope permissions have policies left, as disabling them deletes all of its
+// scope permissions.
+func resourceKeycloakOpenidClientTokenExchangeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	sourceClient := data.Get("source_client").(string)
+	policyId := data.Get("policy_id").(string)
+
+	unlock := lockOpenidClientTokenExchange(realmId, sourceClient)
+	defer unlock()
+
+	realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(ctx, realmId, "realm-management")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	openidClientPermissions, err := keycloakClient.GetOpenidClientPermissions(ctx, realmId, sourceClient)
+	if err != nil {
+		// the permissions are deleted along with the client, but not the policy
+		if !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+
+		err = keycloakClient.DeleteOpenidClientAuthorizationClientPolicy(ctx, realmId, realmManagementClient.Id, policyId)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+
+	if openidClientPermissions.Enabled {
+		permission, err := getOpenidClientTokenExchangeScopePermission(ctx, keycloakClient, realmId, realmManagementClient.Id, openidClientPermissions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if stringSliceContains(permission.Policies, policyId) {
+			var policies []string
+			for _, id := range permission.Policies {
+				if id != policyId {
+					policies = append(policies, id)
+				}
+			}
+
+			permission.Policies = append([]string{}, policies...)
+			if len(policies) == 0 {
+				permission.DecisionStrategy = "UNANIMOUS"
+			}
+
+			err = keycloakClient.UpdateOpenidClientAuthorizationPermission(ctx, permission)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	err = keycloakClient.DeleteOpenidClientAuthorizationClientPolicy(ctx, realmId, realmManagementClient.Id, policyId)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	if !openidClientPermissions.Enabled || !data.Get("permissions_enabled").(bool) {
+		return nil
+	}
+
+	for scope, permissionId := range openidClientPermissions.ScopePermissions {
+		permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(ctx, realmId, realmManagementClient.Id, permissionId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(permission.Policies) != 0 {
+			tflog.Info(ctx, "Keeping the permissions of the source client enabled, as other scope permissions have policies", map[string]interface{}{
+				"id":    data.Id(),
+				"scope": scope,
+			})
+			return nil
+		}
+	}
+
+	return diag.FromErr(keycloakClient.DisableOpenidClientPermissions(ctx, realmId, sourceClient))
+}
+
+func resourceKeycloakOpenidClientTokenExchangeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{sourceClient}}/{{policyId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("source_client", parts[1])
+	d.Set("policy_id", parts[2])
+	d.SetId(openidClientTokenExchangeId(parts[0], parts[1], parts[2]))
+
+	diagnostics := resourceKeycloakOpenidClientTokenExchangeRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("policy %s isn't bound to the token-exchange permission of client %s", parts[2], parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
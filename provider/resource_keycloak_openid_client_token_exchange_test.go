@@ -0,0 +1,491 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakOpenidClientTokenExchange_basic(t *testing.T) {
+	t.Parallel()
+
+	sourceClientId := acctest.RandomWithPrefix("tf-acc")
+	requesterClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequesterClientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchange_basic(sourceClientId, requesterClientId, otherRequesterClientId, "keycloak_openid_client.requester.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_token_exchange.token_exchange", "allowed_clients.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientTokenExchange_basic(sourceClientId, requesterClientId, otherRequesterClientId, "keycloak_openid_client.requester.id, keycloak_openid_client.other_requester.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_token_exchange.token_exchange", "allowed_clients.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_openid_client_token_exchange.token_exchange",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// whether the permissions were enabled by the resource can't be known after importing it
+				ImportStateVerifyIgnore: []string{"permissions_enabled"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientTokenExchange_keepEnabledPermissions(t *testing.T) {
+	t.Parallel()
+
+	sourceClientId := acctest.RandomWithPrefix("tf-acc")
+	requesterClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequesterClientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchange_clients(sourceClientId, requesterClientId, otherRequesterClientId),
+				Check: func(s *terraform.State) error {
+					return keycloakClient.EnableOpenidClientPermissions(testCtx, testAccRealm.Realm, s.RootModule().Resources["keycloak_openid_client.source"].Primary.ID)
+				},
+			},
+			{
+				Config: testKeycloakOpenidClientTokenExchange_basic(sourceClientId, requesterClientId, otherRequesterClientId, "keycloak_openid_client.requester.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_token_exchange.token_exchange", "permissions_enabled", "false"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClientTokenExchange_clients(sourceClientId, requesterClientId, otherRequesterClientId),
+				Check: func(s *terraform.State) error {
+					permissions, err := keycloakClient.GetOpenidClientPermissions(testCtx, testAccRealm.Realm, s.RootModule().Resources["keycloak_openid_client.source"].Primary.ID)
+					if err != nil {
+						return err
+					}
+
+					if !permissions.Enabled {
+						return fmt.Errorf("expected the permissions enabled outside of terraform to stay enabled")
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientTokenExchange_sameSourceClient(t *testing.T) {
+	t.Parallel()
+
+	sourceClientId := acctest.RandomWithPrefix("tf-acc")
+	requesterClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequesterClientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchange_sameSourceClient(sourceClientId, requesterClientId, otherRequesterClientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.other_token_exchange"),
+				),
+			},
+		},
+	})
+}
+
+// each resource sharing the source client has to allow its clients on its own
+func TestAccKeycloakOpenidClientTokenExchange_sameSourceClientExchange(t *testing.T) {
+	t.Parallel()
+
+	sourceClientId := acctest.RandomWithPrefix("tf-acc")
+	requesterClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequesterClientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchange_sameSourceClientExchange(sourceClientId, requesterClientId, otherRequesterClientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.other_token_exchange"),
+					testAccCheckKeycloakOpenidClientTokenExchangeAllowed(requesterClientId, sourceClientId),
+					testAccCheckKeycloakOpenidClientTokenExchangeAllowed(otherRequesterClientId, sourceClientId),
+				),
+			},
+		},
+	})
+}
+
+// keycloak_openid_client_permissions leaves the token-exchange permission alone when it has no token_exchange_scope block
+func TestAccKeycloakOpenidClientTokenExchange_withClientPermissions(t *testing.T) {
+	t.Parallel()
+
+	sourceClientId := acctest.RandomWithPrefix("tf-acc")
+	requesterClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequesterClientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchange_withClientPermissions(sourceClientId, requesterClientId, otherRequesterClientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					resource.TestCheckNoResourceAttr("keycloak_openid_client_permissions.source", "token_exchange_scope.#"),
+				),
+			},
+			{
+				Config:   testKeycloakOpenidClientTokenExchange_withClientPermissions(sourceClientId, requesterClientId, otherRequesterClientId),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClientTokenExchange_createAfterManualUnbind(t *testing.T) {
+	t.Parallel()
+
+	sourceClientId := acctest.RandomWithPrefix("tf-acc")
+	requesterClientId := acctest.RandomWithPrefix("tf-acc")
+	otherRequesterClientId := acctest.RandomWithPrefix("tf-acc")
+
+	var policyId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientTokenExchangeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientTokenExchange_basic(sourceClientId, requesterClientId, otherRequesterClientId, "keycloak_openid_client.requester.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					func(s *terraform.State) error {
+						policyId = s.RootModule().Resources["keycloak_openid_client_token_exchange.token_exchange"].Primary.Attributes["policy_id"]
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					realmManagementClient, err := keycloakClient.GetOpenidClientByClientId(testCtx, testAccRealm.Realm, "realm-management")
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					sourceClient, err := keycloakClient.GetOpenidClientByClientId(testCtx, testAccRealm.Realm, sourceClientId)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					permissions, err := keycloakClient.GetOpenidClientPermissions(testCtx, testAccRealm.Realm, sourceClient.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, testAccRealm.Realm, realmManagementClient.Id, permissions.ScopePermissions["token-exchange"])
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					permission.Policies = []string{}
+					err = keycloakClient.UpdateOpenidClientAuthorizationPermission(testCtx, permission)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakOpenidClientTokenExchange_basic(sourceClientId, requesterClientId, otherRequesterClientId, "keycloak_openid_client.requester.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientTokenExchangeBound("keycloak_openid_client_token_exchange.token_exchange"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["keycloak_openid_client_token_exchange.token_exchange"].Primary.Attributes["policy_id"] == policyId {
+							return fmt.Errorf("expected a new client policy to be bound after the binding was removed outside of terraform")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientTokenExchangeBound(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		sourceClient := rs.Primary.Attributes["source_client"]
+		policyId := rs.Primary.Attributes["policy_id"]
+		authorizationResourceServerId := rs.Primary.Attributes["authorization_resource_server_id"]
+
+		permissions, err := keycloakClient.GetOpenidClientPermissions(testCtx, realmId, sourceClient)
+		if err != nil {
+			return err
+		}
+
+		if !permissions.Enabled {
+			return fmt.Errorf("expected permissions of client %s to be enabled", sourceClient)
+		}
+
+		permission, err := keycloakClient.GetOpenidClientAuthorizationPermission(testCtx, realmId, authorizationResourceServerId, permissions.ScopePermissions["token-exchange"])
+		if err != nil {
+			return err
+		}
+
+		if !stringSliceContains(permission.Policies, policyId) {
+			return fmt.Errorf("expected policy %s to be bound to the token-exchange permission of client %s, got %v", policyId, sourceClient, permission.Policies)
+		}
+
+		policy, err := keycloakClient.GetOpenidClientAuthorizationClientPolicy(testCtx, realmId, authorizationResourceServerId, policyId)
+		if err != nil {
+			return err
+		}
+
+		if fmt.Sprint(len(policy.Clients)) != rs.Primary.Attributes["allowed_clients.#"] {
+			return fmt.Errorf("expected policy %s to allow %s clients, got %v", policyId, rs.Primary.Attributes["allowed_clients.#"], policy.Clients)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckKeycloakOpenidClientTokenExchangeAllowed exchanges a token of the requester client, obtained through its service
+// account, for a token of the source client
+func testAccCheckKeycloakOpenidClientTokenExchangeAllowed(requesterClientId, sourceClientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tokenUrl := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+
+		form := url.Values{}
+		form.Add("client_id", requesterClientId)
+		form.Add("client_secret", "secret")
+		form.Add("grant_type", "client_credentials")
+
+		subjectToken, err := requestTestAccessToken(tokenUrl, form)
+		if err != nil {
+			return fmt.Errorf("client %s can't obtain a token: %s", requesterClientId, err)
+		}
+
+		form = url.Values{}
+		form.Add("client_id", requesterClientId)
+		form.Add("client_secret", "secret")
+		form.Add("grant_type", "urn:ietf:params:oauth:grant-type:token-exchange")
+		form.Add("subject_token", subjectToken)
+		form.Add("audience", sourceClientId)
+
+		_, err = requestTestAccessToken(tokenUrl, form)
+		if err != nil {
+			return fmt.Errorf("client %s can't exchange its token for client %s: %s", requesterClientId, sourceClientId, err)
+		}
+
+		return nil
+	}
+}
+
+func requestTestAccessToken(tokenUrl string, form url.Values) (string, error) {
+	request, err := http.NewRequest(http.MethodPost, tokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d, body: %s", response.StatusCode, string(body))
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	err = json.Unmarshal(body, &token)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+func testAccCheckKeycloakOpenidClientTokenExchangeDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_openid_client_token_exchange" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			policyId := rs.Primary.Attributes["policy_id"]
+			authorizationResourceServerId := rs.Primary.Attributes["authorization_resource_server_id"]
+
+			policy, _ := keycloakClient.GetOpenidClientAuthorizationClientPolicy(testCtx, realmId, authorizationResourceServerId, policyId)
+			if policy != nil {
+				return fmt.Errorf("client policy for realm id %s, resource server id %s and policy id %s still exists", realmId, authorizationResourceServerId, policyId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientTokenExchange_clients(sourceClientId, requesterClientId, otherRequesterClientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "source" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_client" "requester" {
+	realm_id      = data.keycloak_realm.realm.id
+	client_id     = "%s"
+	client_secret = "secret"
+	access_type   = "CONFIDENTIAL"
+}
+
+resource "keycloak_openid_client" "other_requester" {
+	realm_id      = data.keycloak_realm.realm.id
+	client_id     = "%s"
+	client_secret = "secret"
+	access_type   = "CONFIDENTIAL"
+}
+	`, testAccRealm.Realm, sourceClientId, requesterClientId, otherRequesterClientId)
+}
+
+func testKeycloakOpenidClientTokenExchange_basic(sourceClientId, requesterClientId, otherRequesterClientId, allowedClients string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_openid_client_token_exchange" "token_exchange" {
+	realm_id        = data.keycloak_realm.realm.id
+	source_client   = keycloak_openid_client.source.id
+	allowed_clients = [%s]
+}
+	`, testKeycloakOpenidClientTokenExchange_clients(sourceClientId, requesterClientId, otherRequesterClientId), allowedClients)
+}
+
+func testKeycloakOpenidClientTokenExchange_sameSourceClient(sourceClientId, requesterClientId, otherRequesterClientId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_openid_client_token_exchange" "token_exchange" {
+	realm_id        = data.keycloak_realm.realm.id
+	source_client   = keycloak_openid_client.source.id
+	allowed_clients = [keycloak_openid_client.requester.id]
+}
+
+resource "keycloak_openid_client_token_exchange" "other_token_exchange" {
+	realm_id        = data.keycloak_realm.realm.id
+	source_client   = keycloak_openid_client.source.id
+	allowed_clients = [keycloak_openid_client.other_requester.id]
+}
+	`, testKeycloakOpenidClientTokenExchange_clients(sourceClientId, requesterClientId, otherRequesterClientId))
+}
+
+func testKeycloakOpenidClientTokenExchange_sameSourceClientExchange(sourceClientId, requesterClientId, otherRequesterClientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "source" {
+	realm_id      = data.keycloak_realm.realm.id
+	client_id     = "%s"
+	client_secret = "secret"
+	access_type   = "CONFIDENTIAL"
+}
+
+resource "keycloak_openid_client" "requester" {
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id                = "%s"
+	client_secret            = "secret"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_openid_client" "other_requester" {
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id                = "%s"
+	client_secret            = "secret"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+}
+
+resource "keycloak_openid_client_token_exchange" "token_exchange" {
+	realm_id        = data.keycloak_realm.realm.id
+	source_client   = keycloak_openid_client.source.id
+	allowed_clients = [keycloak_openid_client.requester.id]
+}
+
+resource "keycloak_openid_client_token_exchange" "other_token_exchange" {
+	realm_id        = data.keycloak_realm.realm.id
+	source_client   = keycloak_openid_client.source.id
+	allowed_clients = [keycloak_openid_client.other_requester.id]
+}
+	`, testAccRealm.Realm, sourceClientId, requesterClientId, otherRequesterClientId)
+}
+
+func testKeycloakOpenidClientTokenExchange_withClientPermissions(sourceClientId, requesterClientId, otherRequesterClientId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_openid_client_permissions" "source" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.source.id
+}
+
+resource "keycloak_openid_client_token_exchange" "token_exchange" {
+	realm_id        = data.keycloak_realm.realm.id
+	source_client   = keycloak_openid_client.source.id
+	allowed_clients = [keycloak_openid_client.requester.id]
+
+	depends_on = [keycloak_openid_client_permissions.source]
+}
+	`, testKeycloakOpenidClientTokenExchange_clients(sourceClientId, requesterClientId, otherRequesterClientId))
+}
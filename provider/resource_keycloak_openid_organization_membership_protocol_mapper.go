@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdOrganizationMembershipProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdOrganizationMembershipProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdOrganizationMembershipProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"claim_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "organization",
+				Description: "The name of the claim the organizations of the user are added to.",
+			},
+			"add_organization_id": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the id of each organization should be added to the claim, next to its alias.",
+			},
+			"add_organization_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attributes of each organization should be added to the claim, next to its alias.",
+			},
+			"add_to_id_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if this claim should be added to the id token.",
+			},
+			"add_to_access_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if this claim should be added to the access token.",
+			},
+			"add_to_userinfo": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if this claim should be added to the userinfo response.",
+			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if this claim should be added to the token introspection response.",
+			},
+			"add_to_lightweight_claim": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if this claim should be kept in lightweight access tokens.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdOrganizationMembershipProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdOrganizationMembershipProtocolMapper {
+	return &keycloak.OpenIdOrganizationMembershipProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToUserinfo:           data.Get("add_to_userinfo").(bool),
+		AddToTokenIntrospection: data.Get("add_to_token_introspection").(bool),
+		AddToLightweightClaim:   data.Get("add_to_lightweight_claim").(bool),
+
+		ClaimName:                 data.Get("claim_name").(string),
+		AddOrganizationId:         data.Get("add_organization_id").(bool),
+		AddOrganizationAttributes: data.Get("add_organization_attributes").(bool),
+	}
+}
+
+func mapFromOpenIdOrganizationMembershipMapperToData(mapper *keycloak.OpenIdOrganizationMembershipProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("claim_name", mapper.ClaimName)
+	data.Set("add_organization_id", mapper.AddOrganizationId)
+	data.Set("add_organization_attributes", mapper.AddOrganizationAttributes)
+	data.Set("add_to_id_token", mapper.AddToIdToken)
+	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_userinfo", mapper.AddToUserinfo)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("add_to_lightweight_claim", mapper.AddToLightweightClaim)
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdOrganizationMembershipMapper := mapFromDataToOpenIdOrganizationMembershipProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdOrganizationMembershipMapperToData(openIdOrganizationMembershipMapper, data)
+
+	return resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	openIdOrganizationMembershipMapper, err := keycloakClient.GetOpenIdOrganizationMembershipProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdOrganizationMembershipMapperToData(openIdOrganizationMembershipMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdOrganizationMembershipMapper := mapFromDataToOpenIdOrganizationMembershipProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdOrganizationMembershipProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
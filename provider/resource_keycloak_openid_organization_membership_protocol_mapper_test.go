@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_basicClient(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper_client"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_basic_client(clientId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "claim_name", "organization"),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_import(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	clientScopeId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	clientResourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper_client"
+	clientScopeResourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_import(clientId, clientScopeId, mapperName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(clientResourceName),
+					testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(clientScopeResourceName),
+				),
+			},
+			{
+				ResourceName:      clientResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(clientResourceName),
+			},
+			{
+				ResourceName:      clientScopeResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClientScope(clientScopeResourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_update(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	resourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper"
+
+	mapperOne := &keycloak.OpenIdOrganizationMembershipProtocolMapper{
+		Name:                      acctest.RandString(10),
+		ClientId:                  "terraform-client-" + acctest.RandString(10),
+		ClaimName:                 acctest.RandString(10),
+		AddOrganizationId:         randomBool(),
+		AddOrganizationAttributes: randomBool(),
+		AddToIdToken:              randomBool(),
+		AddToAccessToken:          randomBool(),
+		AddToUserinfo:             randomBool(),
+		AddToTokenIntrospection:   randomBool(),
+		AddToLightweightClaim:     randomBool(),
+	}
+
+	mapperTwo := &keycloak.OpenIdOrganizationMembershipProtocolMapper{
+		Name:                      mapperOne.Name,
+		ClientId:                  mapperOne.ClientId,
+		ClaimName:                 acctest.RandString(10),
+		AddOrganizationId:         !mapperOne.AddOrganizationId,
+		AddOrganizationAttributes: !mapperOne.AddOrganizationAttributes,
+		AddToIdToken:              randomBool(),
+		AddToAccessToken:          randomBool(),
+		AddToUserinfo:             randomBool(),
+		AddToTokenIntrospection:   randomBool(),
+		AddToLightweightClaim:     randomBool(),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_fromInterface(mapperOne),
+				Check:  testKeycloakOpenIdOrganizationMembershipProtocolMapperMatches(resourceName, mapperOne),
+			},
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_fromInterface(mapperTwo),
+				Check:  testKeycloakOpenIdOrganizationMembershipProtocolMapperMatches(resourceName, mapperTwo),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_organization_membership_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getOrganizationMembershipMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid organization membership protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getOrganizationMembershipMapperUsingState(state, resourceName)
+
+		return err
+	}
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapperMatches(resourceName string, expected *keycloak.OpenIdOrganizationMembershipProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		mapper, err := getOrganizationMembershipMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if mapper.ClaimName != expected.ClaimName {
+			return fmt.Errorf("expected mapper %s to have claim name %s, got %s", mapper.Name, expected.ClaimName, mapper.ClaimName)
+		}
+
+		if mapper.AddOrganizationId != expected.AddOrganizationId || mapper.AddOrganizationAttributes != expected.AddOrganizationAttributes {
+			return fmt.Errorf("expected mapper %s to have add_organization_id %t and add_organization_attributes %t, got %t and %t",
+				mapper.Name, expected.AddOrganizationId, expected.AddOrganizationAttributes, mapper.AddOrganizationId, mapper.AddOrganizationAttributes)
+		}
+
+		if mapper.AddToTokenIntrospection != expected.AddToTokenIntrospection {
+			return fmt.Errorf("expected mapper %s to have add_to_token_introspection %t, got %t", mapper.Name, expected.AddToTokenIntrospection, mapper.AddToTokenIntrospection)
+		}
+
+		return nil
+	}
+}
+
+func getOrganizationMembershipMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdOrganizationMembershipProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdOrganizationMembershipProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapper_basic_client(clientId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_organization_membership_protocol_mapper" "organization_membership_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+}`, testAccRealm.Realm, clientId, mapperName)
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapper_import(clientId, clientScopeId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_organization_membership_protocol_mapper" "organization_membership_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_openid_organization_membership_protocol_mapper" "organization_membership_mapper_client_scope" {
+	name                        = "%s"
+	realm_id                    = data.keycloak_realm.realm.id
+	client_scope_id             = keycloak_openid_client_scope.client_scope.id
+	add_organization_id         = true
+	add_organization_attributes = true
+}`, testAccRealm.Realm, clientId, mapperName, clientScopeId, mapperName)
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapper_fromInterface(mapper *keycloak.OpenIdOrganizationMembershipProtocolMapper) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_organization_membership_protocol_mapper" "organization_membership_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	claim_name                  = "%s"
+	add_organization_id         = %t
+	add_organization_attributes = %t
+	add_to_id_token             = %t
+	add_to_access_token         = %t
+	add_to_userinfo             = %t
+	add_to_token_introspection  = %t
+	add_to_lightweight_claim    = %t
+}`, testAccRealm.Realm, mapper.ClientId, mapper.Name, mapper.ClaimName, mapper.AddOrganizationId, mapper.AddOrganizationAttributes,
+		mapper.AddToIdToken, mapper.AddToAccessToken, mapper.AddToUserinfo, mapper.AddToTokenIntrospection, mapper.AddToLightweightClaim)
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOrganization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOrganizationCreate,
+		ReadContext:   resourceKeycloakOrganizationRead,
+		UpdateContext: resourceKeycloakOrganizationUpdate,
+		DeleteContext: resourceKeycloakOrganizationDelete,
+		// This resource can be imported using {{realm}}/{{organization_id}}. The Organization ID is displayed in the URL when editing it from the GUI
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOrganizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The alias of the organization, which can't be changed once it was created. Defaults to the name of the organization.",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The url members of the organization are redirected to after completing their registration or accepting an invitation.",
+			},
+			"domain": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func mapFromDataToOrganization(data *schema.ResourceData) *keycloak.Organization {
+	domains := make([]keycloak.OrganizationDomain, 0)
+	for _, d := range data.Get("domain").(*schema.Set).List() {
+		domain := d.(map[string]interface{})
+		domains = append(domains, keycloak.OrganizationDomain{
+			Name:     domain["name"].(string),
+			Verified: domain["verified"].(bool),
+		})
+	}
+
+	attributes := map[string][]string{}
+	for key, value := range data.Get("attributes").(map[string]interface{}) {
+		attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	return &keycloak.Organization{
+		Id:          data.Id(),
+		RealmId:     data.Get("realm_id").(string),
+		Name:        data.Get("name").(string),
+		Alias:       data.Get("alias").(string),
+		RedirectUrl: data.Get("redirect_url").(string),
+		Enabled:     data.Get("enabled").(bool),
+		Description: data.Get("description").(string),
+		Domains:     domains,
+		Attributes:  attributes,
+	}
+}
+
+func mapFromOrganizationToData(data *schema.ResourceData, organization *keycloak.Organization) {
+	var domains []interface{}
+	for _, domain := range organization.Domains {
+		domains = append(domains, map[string]interface{}{
+			"name":     domain.Name,
+			"verified": domain.Verified,
+		})
+	}
+
+	attributes := map[string]string{}
+	for key, value := range organization.Attributes {
+		attributes[key] = strings.Join(value, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	data.SetId(organization.Id)
+	data.Set("realm_id", organization.RealmId)
+	data.Set("name", organization.Name)
+	data.Set("alias", organization.Alias)
+	data.Set("redirect_url", organization.RedirectUrl)
+	data.Set("enabled", organization.Enabled)
+	data.Set("description", organization.Description)
+	data.Set("domain", domains)
+	data.Set("attributes", attributes)
+}
+
+func resourceKeycloakOrganizationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	organization := mapFromDataToOrganization(data)
+
+	err := keycloakClient.ValidateOrganization(ctx, organization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOrganization(ctx, organization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(organization.Id)
+
+	return resourceKeycloakOrganizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	organization, err := keycloakClient.GetOrganization(ctx, realmId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOrganizationToData(data, organization)
+
+	return nil
+}
+
+func resourceKeycloakOrganizationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	organization := mapFromDataToOrganization(data)
+
+	err := keycloakClient.ValidateOrganization(ctx, organization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOrganization(ctx, organization)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOrganizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOrganization(ctx, realmId, data.Id()))
+}
+
+func resourceKeycloakOrganizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{organizationId}}")
+	}
+
+	_, err := keycloakClient.GetOrganization(ctx, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakOrganizationRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
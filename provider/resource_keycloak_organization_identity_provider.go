@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOrganizationIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOrganizationIdentityProviderCreate,
+		ReadContext:   resourceKeycloakOrganizationIdentityProviderRead,
+		DeleteContext: resourceKeycloakOrganizationIdentityProviderDelete,
+		// This resource can be imported using {{realm}}/{{organizationId}}/{{identityProviderAlias}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOrganizationIdentityProviderImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_provider_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func organizationIdentityProviderId(organizationId, identityProviderAlias string) string {
+	return fmt.Sprintf("%s/%s", organizationId, identityProviderAlias)
+}
+
+func resourceKeycloakOrganizationIdentityProviderCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	identityProviderAlias := data.Get("identity_provider_alias").(string)
+
+	err := keycloakClient.AddOrganizationIdentityProvider(ctx, realmId, organizationId, identityProviderAlias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(organizationIdentityProviderId(organizationId, identityProviderAlias))
+
+	return resourceKeycloakOrganizationIdentityProviderRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationIdentityProviderRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	identityProviderAlias := data.Get("identity_provider_alias").(string)
+
+	_, err := keycloakClient.GetOrganizationIdentityProvider(ctx, realmId, organizationId, identityProviderAlias)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.SetId(organizationIdentityProviderId(organizationId, identityProviderAlias))
+
+	return nil
+}
+
+func resourceKeycloakOrganizationIdentityProviderDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	identityProviderAlias := data.Get("identity_provider_alias").(string)
+
+	return diag.FromErr(keycloakClient.RemoveOrganizationIdentityProvider(ctx, realmId, organizationId, identityProviderAlias))
+}
+
+func resourceKeycloakOrganizationIdentityProviderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{organizationId}}/{{identityProviderAlias}}.")
+	}
+
+	_, err := keycloakClient.GetOrganizationIdentityProvider(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("organization_id", parts[1])
+	d.Set("identity_provider_alias", parts[2])
+
+	diagnostics := resourceKeycloakOrganizationIdentityProviderRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
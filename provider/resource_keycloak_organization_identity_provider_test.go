@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOrganizationIdentityProvider_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganizationIdentityProvider_basic(realmName, alias),
+				Check:  testAccCheckKeycloakOrganizationIdentityProviderExists("keycloak_organization_identity_provider.identity_provider"),
+			},
+			{
+				ResourceName:      "keycloak_organization_identity_provider.identity_provider",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["keycloak_organization_identity_provider.identity_provider"]
+
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["identity_provider_alias"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOrganizationIdentityProviderExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetOrganizationIdentityProvider(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["identity_provider_alias"])
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakOrganizationIdentityProviderDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_organization_identity_provider" {
+				continue
+			}
+
+			identityProvider, _ := keycloakClient.GetOrganizationIdentityProvider(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["identity_provider_alias"])
+			if identityProvider != nil {
+				return fmt.Errorf("identity provider %s is still linked to organization %s", rs.Primary.Attributes["identity_provider_alias"], rs.Primary.Attributes["organization_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOrganizationIdentityProvider_basic(realm, alias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+
+	domain {
+		name = "example.com"
+	}
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_organization_identity_provider" "identity_provider" {
+	realm_id                = keycloak_realm.realm.id
+	organization_id         = keycloak_organization.organization.id
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+}
+	`, realm, alias, alias)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOrganizationMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOrganizationMemberCreate,
+		ReadContext:   resourceKeycloakOrganizationMemberRead,
+		DeleteContext: resourceKeycloakOrganizationMemberDelete,
+		// This resource can be imported using {{realm}}/{{organizationId}}/{{userId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOrganizationMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func organizationMemberId(organizationId, userId string) string {
+	return fmt.Sprintf("%s/%s", organizationId, userId)
+}
+
+func resourceKeycloakOrganizationMemberCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	userId := data.Get("user_id").(string)
+
+	err := keycloakClient.AddOrganizationMember(ctx, realmId, organizationId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(organizationMemberId(organizationId, userId))
+
+	return resourceKeycloakOrganizationMemberRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationMemberRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	userId := data.Get("user_id").(string)
+
+	_, err := keycloakClient.GetOrganizationMember(ctx, realmId, organizationId, userId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.SetId(organizationMemberId(organizationId, userId))
+
+	return nil
+}
+
+func resourceKeycloakOrganizationMemberDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	organizationId := data.Get("organization_id").(string)
+	userId := data.Get("user_id").(string)
+
+	return diag.FromErr(keycloakClient.RemoveOrganizationMember(ctx, realmId, organizationId, userId))
+}
+
+func resourceKeycloakOrganizationMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{organizationId}}/{{userId}}.")
+	}
+
+	_, err := keycloakClient.GetOrganizationMember(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("organization_id", parts[1])
+	d.Set("user_id", parts[2])
+
+	diagnostics := resourceKeycloakOrganizationMemberRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOrganizationMember_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+	var organizationId, userId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationMemberDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganizationMember_basic(realmName, username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationMemberExists("keycloak_organization_member.member"),
+					testAccCheckKeycloakOrganizationMemberFetch("keycloak_organization_member.member", &organizationId, &userId),
+				),
+			},
+			{
+				ResourceName:      "keycloak_organization_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["keycloak_organization_member.member"]
+
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"]), nil
+				},
+			},
+			{
+				// the member is added again after it was removed outside of terraform
+				PreConfig: func() {
+					err := keycloakClient.RemoveOrganizationMember(testCtx, realmName, organizationId, userId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakOrganizationMember_basic(realmName, username),
+				Check:  testAccCheckKeycloakOrganizationMemberExists("keycloak_organization_member.member"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOrganizationMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetOrganizationMember(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"])
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakOrganizationMemberFetch(resourceName string, organizationId, userId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		*organizationId = rs.Primary.Attributes["organization_id"]
+		*userId = rs.Primary.Attributes["user_id"]
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakOrganizationMemberDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_organization_member" {
+				continue
+			}
+
+			member, _ := keycloakClient.GetOrganizationMember(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["organization_id"], rs.Primary.Attributes["user_id"])
+			if member != nil {
+				return fmt.Errorf("user %s is still a member of organization %s", rs.Primary.Attributes["user_id"], rs.Primary.Attributes["organization_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOrganizationMember_basic(realm, username string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+
+	domain {
+		name = "example.com"
+	}
+}
+
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "%s"
+	email    = "%s@example.com"
+}
+
+resource "keycloak_organization_member" "member" {
+	realm_id        = keycloak_realm.realm.id
+	organization_id = keycloak_organization.organization.id
+	user_id         = keycloak_user.user.id
+}
+	`, realm, username, username, username)
+}
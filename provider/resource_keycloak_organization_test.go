@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOrganization_basic(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganization_basic(realmName, organizationName, "first description", "b.example.com", "a.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationExists("keycloak_organization.organization"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "description", "first description"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "domain.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("keycloak_organization.organization", "domain.*", map[string]string{
+						"name":     "a.example.com",
+						"verified": "true",
+					}),
+				),
+			},
+			{
+				Config: testKeycloakOrganization_basic(realmName, organizationName, "second description", "c.example.com", "a.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationExists("keycloak_organization.organization"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "description", "second description"),
+					resource.TestCheckTypeSetElemNestedAttrs("keycloak_organization.organization", "domain.*", map[string]string{
+						"name":     "c.example.com",
+						"verified": "false",
+					}),
+				),
+			},
+			{
+				ResourceName:        "keycloak_organization.organization",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOrganization_aliasAndRedirectUrl(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganization_aliasAndRedirectUrl(realmName, organizationName, "https://example.com/welcome"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationExists("keycloak_organization.organization"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "alias", organizationName+"-alias"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "redirect_url", "https://example.com/welcome"),
+				),
+			},
+			{
+				Config: testKeycloakOrganization_aliasAndRedirectUrl(realmName, organizationName, ""),
+				Check:  resource.TestCheckResourceAttr("keycloak_organization.organization", "redirect_url", ""),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOrganization_attributes(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganization_attributes(realmName, organizationName, "eu##us"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationExists("keycloak_organization.organization"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "attributes.region", "eu##us"),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "attributes.tier", "gold"),
+				),
+			},
+			{
+				Config: testKeycloakOrganization_basic(realmName, organizationName, "first description", "b.example.com", "a.example.com"),
+				Check:  resource.TestCheckResourceAttr("keycloak_organization.organization", "attributes.%", "0"),
+			},
+			{
+				Config: testKeycloakOrganization_attributes(realmName, organizationName, "eu"),
+				Check:  resource.TestCheckResourceAttr("keycloak_organization.organization", "attributes.region", "eu"),
+			},
+			{
+				ResourceName:        "keycloak_organization.organization",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOrganization_domainValidation(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOrganization_basic(realmName, organizationName, "", "example.com", "EXAMPLE.com"),
+				ExpectError: regexp.MustCompile("domain EXAMPLE.com is specified more than once"),
+			},
+			{
+				Config:      testKeycloakOrganization_domainClaimed(realmName, organizationName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("it is already claimed by organization %s-first", organizationName)),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOrganization_organizationsDisabled(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	t.Parallel()
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOrganization_organizationsDisabled(organizationName),
+				ExpectError: regexp.MustCompile("organizations are not enabled in realm"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOrganizationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getOrganizationFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakOrganizationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_organization" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			organization, _ := keycloakClient.GetOrganization(testCtx, realm, id)
+			if organization != nil {
+				return fmt.Errorf("organization with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getOrganizationFromState(s *terraform.State, resourceName string) (*keycloak.Organization, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	organization, err := keycloakClient.GetOrganization(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization with id %s: %s", id, err)
+	}
+
+	return organization, nil
+}
+
+func testKeycloakOrganization_basic(realm, organization, description, firstDomain, secondDomain string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id    = keycloak_realm.realm.id
+	name        = "%s"
+	description = "%s"
+
+	domain {
+		name = "%s"
+	}
+
+	domain {
+		name     = "%s"
+		verified = true
+	}
+}
+	`, realm, organization, description, firstDomain, secondDomain)
+}
+
+func testKeycloakOrganization_aliasAndRedirectUrl(realm, organization, redirectUrl string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id     = keycloak_realm.realm.id
+	name         = "%s"
+	alias        = "%s-alias"
+	redirect_url = "%s"
+
+	domain {
+		name = "example.com"
+	}
+}
+	`, realm, organization, organization, redirectUrl)
+}
+
+func testKeycloakOrganization_attributes(realm, organization, region string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+
+	domain {
+		name = "a.example.com"
+	}
+
+	attributes = {
+		region = "%s"
+		tier   = "gold"
+	}
+}
+	`, realm, organization, region)
+}
+
+func testKeycloakOrganization_domainClaimed(realm, organization string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	organizations_enabled = true
+}
+
+resource "keycloak_organization" "first" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s-first"
+
+	domain {
+		name     = "example.com"
+		verified = true
+	}
+}
+
+resource "keycloak_organization" "second" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s-second"
+
+	domain {
+		name = "example.com"
+	}
+
+	depends_on = [keycloak_organization.first]
+}
+	`, realm, organization, organization)
+}
+
+func testKeycloakOrganization_organizationsDisabled(organization string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_organization" "organization" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+
+	domain {
+		name = "example.com"
+	}
+}
+	`, testAccRealm.Realm, organization)
+}
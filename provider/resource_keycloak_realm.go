@@ -2,12 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	parRequestUriLifespanAttribute = "parRequestUriLifespan"
+	darkModeAttribute              = "darkMode"
 )
 
 var (
@@ -70,7 +80,8 @@ func resourceKeycloakRealm() *schema.Resource {
 		"acceptable_aaguids": {
 			Type: schema.TypeSet,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
 			},
 			Optional: true,
 		},
@@ -150,6 +161,7 @@ func resourceKeycloakRealm() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateRealmInternationalization,
 		Schema: map[string]*schema.Schema{
 			"realm": {
 				Type:     schema.TypeString,
@@ -185,6 +197,18 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"admin_permissions_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the realm's admins are authorized with the fine-grained admin permissions of the admin-permissions client.",
+			},
+			"verifiable_credentials_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enables OpenID for Verifiable Credential Issuance (OID4VCI) for this realm. Requires Keycloak 25 or later.",
+			},
 
 			// Login Config
 			"registration_allowed": {
@@ -287,14 +311,41 @@ func resourceKeycloakRealm() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"auth_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "basic",
+										ValidateFunc: validation.StringInSlice([]string{"basic", "token"}, false),
+										Description:  "Authenticate with the password or with an access token obtained through the client credentials grant (XOAUTH2).",
+									},
 									"password": {
 										Type:      schema.TypeString,
-										Required:  true,
+										Optional:  true,
 										Sensitive: true,
 										DiffSuppressFunc: func(_, smtpServerPassword, _ string, _ *schema.ResourceData) bool {
 											return smtpServerPassword == "**********"
 										},
 									},
+									"auth_token_url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"auth_token_scope": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"auth_token_client_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"auth_token_client_secret": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+										DiffSuppressFunc: func(_, smtpServerClientSecret, _ string, _ *schema.ResourceData) bool {
+											return smtpServerClientSecret == "**********"
+										},
+									},
 								},
 							},
 						},
@@ -307,6 +358,12 @@ func resourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"dark_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "When false, the login and account themes don't switch to their dark variant when the browser of the user prefers it. Requires Keycloak 26 or later.",
+			},
 			"account_theme": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -324,16 +381,17 @@ func resourceKeycloakRealm() *schema.Resource {
 			"default_signature_algorithm": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"revoke_refresh_token": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  false,
+				Computed: true,
 			},
 			"refresh_token_max_reuse": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				Default:  0,
+				Computed: true,
 			},
 			"sso_session_idle_timeout": {
 				Type:             schema.TypeString,
@@ -374,7 +432,7 @@ func resourceKeycloakRealm() *schema.Resource {
 			"offline_session_max_lifespan_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  false,
+				Computed: true,
 			},
 			"client_session_idle_timeout": {
 				Type:             schema.TypeString,
@@ -436,6 +494,13 @@ func resourceKeycloakRealm() *schema.Resource {
 				Computed:         true,
 				DiffSuppressFunc: suppressDurationStringDiff,
 			},
+			"par_request_uri_lifespan": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "How long the request_uri returned by the pushed authorization request (PAR) endpoint stays valid, ex. 90s.",
+				ValidateFunc:     validateDurationString,
+				DiffSuppressFunc: suppressDurationStringDiff,
+			},
 			"oauth2_device_polling_interval": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -536,9 +601,10 @@ func resourceKeycloakRealm() *schema.Resource {
 										Default:  30,
 									},
 									"wait_increment_seconds": { //Wait Increment
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  60,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          60,
+										DiffSuppressFunc: suppressBruteForceWaitDiffOnPermanentLockout,
 									},
 									"quick_login_check_milli_seconds": { //Quick Login Check Milli Seconds
 										Type:     schema.TypeInt,
@@ -551,15 +617,30 @@ func resourceKeycloakRealm() *schema.Resource {
 										Default:  60,
 									},
 									"max_failure_wait_seconds": { //Max Wait
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  900,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										Default:          900,
+										DiffSuppressFunc: suppressBruteForceWaitDiffOnPermanentLockout,
 									},
 									"failure_reset_time_seconds": { //maxDeltaTimeSeconds
 										Type:     schema.TypeInt,
 										Optional: true,
 										Default:  43200,
 									},
+									"max_temporary_lockouts": { //maxTemporaryLockouts
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+										Description:  "How many times a user is temporarily locked out before being locked out permanently when permanent_lockout is true, 0 locks users out permanently right away.",
+									},
+									"brute_force_strategy": { //bruteForceStrategy
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "MULTIPLE",
+										ValidateFunc: validation.StringInSlice([]string{"MULTIPLE", "LINEAR"}, false),
+										Description:  "How the wait time grows with each temporary lockout, either MULTIPLE or LINEAR.",
+									},
 								},
 							},
 						},
@@ -572,6 +653,9 @@ func resourceKeycloakRealm() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
 				Optional:    true,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return keycloak.NormalizePasswordPolicy(old) == keycloak.NormalizePasswordPolicy(new)
+				},
 			},
 
 			// authentication flow bindings
@@ -623,6 +707,12 @@ func resourceKeycloakRealm() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"acr_loa_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Mapping of ACR values to level of authentication (LoA) for the clients of this realm.",
+			},
 
 			// default default client scopes
 			"default_default_client_scopes": {
@@ -676,19 +766,49 @@ func resourceKeycloakRealm() *schema.Resource {
 	}
 }
 
-func getRealmSMTPPasswordFromData(data *schema.ResourceData) (string, bool) {
+func getRealmSMTPAuthFromData(data *schema.ResourceData) (map[string]interface{}, bool) {
 	if v, ok := data.GetOk("smtp_server"); ok {
 		smtpSettings := v.([]interface{})[0].(map[string]interface{})
 		authConfig := smtpSettings["auth"].([]interface{})
 
-		if len(authConfig) == 1 {
-			return authConfig[0].(map[string]interface{})["password"].(string), true
+		if len(authConfig) == 1 && authConfig[0] != nil {
+			return authConfig[0].(map[string]interface{}), true
 		}
 
-		return "", false
+		return nil, false
 	}
 
-	return "", false
+	return nil, false
+}
+
+func setRealmSMTPAuth(smtpServer *keycloak.SmtpServer, auth map[string]interface{}, keycloakVersion *version.Version) error {
+	smtpServer.Auth = true
+	smtpServer.User = auth["username"].(string)
+
+	if auth["auth_type"].(string) != "token" {
+		if auth["password"].(string) == "" {
+			return fmt.Errorf("validation error: the password of the smtp_server auth is required when auth_type is basic")
+		}
+
+		smtpServer.Password = auth["password"].(string)
+
+		return nil
+	}
+
+	if keycloakVersion.LessThan(keycloak.Version_26_1.AsVersion()) {
+		return fmt.Errorf("the token auth_type of smtp_server requires Keycloak 26.1 or later")
+	}
+	if auth["auth_token_url"].(string) == "" || auth["auth_token_client_id"].(string) == "" || auth["auth_token_client_secret"].(string) == "" {
+		return fmt.Errorf("validation error: auth_token_url, auth_token_client_id and auth_token_client_secret of the smtp_server auth are required when auth_type is token")
+	}
+
+	smtpServer.AuthType = "token"
+	smtpServer.AuthTokenUrl = auth["auth_token_url"].(string)
+	smtpServer.AuthTokenScope = auth["auth_token_scope"].(string)
+	smtpServer.AuthTokenClientId = auth["auth_token_client_id"].(string)
+	smtpServer.AuthTokenClientSecret = auth["auth_token_client_secret"].(string)
+
+	return nil
 }
 
 func setRealmFlowBindings(data *schema.ResourceData, realm *keycloak.Realm, keycloakVersion *version.Version) {
@@ -737,6 +857,136 @@ func setRealmFlowBindings(data *schema.ResourceData, realm *keycloak.Realm, keyc
 	}
 }
 
+// validateRealmInternationalization catches a default locale that isn't one of the supported locales at plan time.
+// Keycloak accepts this configuration, but silently falls back to English for logins and emails.
+func validateRealmInternationalization(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("internationalization.0.default_locale") || !d.NewValueKnown("internationalization.0.supported_locales") {
+		return nil
+	}
+
+	v, ok := d.GetOk("internationalization")
+	if !ok {
+		return nil
+	}
+
+	internationalizationSettings, ok := v.([]interface{})[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	defaultLocale := internationalizationSettings["default_locale"].(string)
+	supportedLocales := internationalizationSettings["supported_locales"].(*schema.Set)
+	if defaultLocale != "" && !supportedLocales.Contains(defaultLocale) {
+		return fmt.Errorf("validation error: DefaultLocale should be in the SupportLocales, \"%s\" is not one of %v", defaultLocale, interfaceSliceToStringSlice(supportedLocales.List()))
+	}
+
+	return nil
+}
+
+// getRealmInternationalizationWarnings warns about supported locales the login theme of the realm has no messages for.
+// Keycloak accepts them, but the login pages are shown in the default locale instead.
+func getRealmInternationalizationWarnings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realm *keycloak.Realm) diag.Diagnostics {
+	if !realm.InternationalizationEnabled || realm.LoginTheme == "" {
+		return nil
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	themeLocales, ok := serverInfo.ThemeLocales("login", realm.LoginTheme)
+	if !ok || len(themeLocales) == 0 {
+		return nil
+	}
+
+	var missingLocales []string
+	for _, locale := range realm.SupportLocales {
+		if !stringSliceContains(themeLocales, locale) {
+			missingLocales = append(missingLocales, locale)
+		}
+	}
+
+	if len(missingLocales) == 0 {
+		return nil
+	}
+
+	sort.Strings(missingLocales)
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("login theme %s of realm %s doesn't support the locales %s", realm.LoginTheme, realm.Realm, strings.Join(missingLocales, ", ")),
+			Detail:   "Users choosing one of these locales see the login pages in the default locale. Add messages for them to the theme, or remove them from supported_locales.",
+		},
+	}
+}
+
+// getRealmPasswordResetWarnings warns about realms which allow resetting passwords, but can't complete a password reset.
+// Keycloak accepts all of these configurations, the reset only fails once a user requests it.
+func getRealmPasswordResetWarnings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realm *keycloak.Realm) diag.Diagnostics {
+	if !realm.ResetPasswordAllowed {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	if realm.SmtpServer.Host == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("realm %s allows resetting passwords without an SMTP server", realm.Realm),
+			Detail:   "The reset credentials flow sends the password reset link by email, configure smtp_server or set reset_password_allowed to false.",
+		})
+	}
+
+	if realm.ResetCredentialsFlow != nil && *realm.ResetCredentialsFlow != "" {
+		executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realm.Realm, *realm.ResetCredentialsFlow)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		enabled := false
+		for _, execution := range executions {
+			if execution.Level == 0 && execution.Requirement != "DISABLED" {
+				enabled = true
+				break
+			}
+		}
+
+		if !enabled {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("reset credentials flow %s of realm %s has no enabled executions", *realm.ResetCredentialsFlow, realm.Realm),
+				Detail:   "Users requesting a password reset can't complete the flow. Enable the flow's executions or bind another flow with reset_credentials_flow.",
+			})
+		}
+	}
+
+	updatePassword, err := keycloakClient.GetRequiredAction(ctx, realm.Realm, "UPDATE_PASSWORD")
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if err != nil || !updatePassword.Enabled {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("realm %s allows resetting passwords, but the UPDATE_PASSWORD required action is disabled", realm.Realm),
+			Detail:   "The reset credentials flow relies on the UPDATE_PASSWORD required action to let users choose a new password, enable it with keycloak_required_action.",
+		})
+	}
+
+	return diags
+}
+
+// realmAttributeConfigured tells whether attribute is set in the configuration of the realm.
+func realmAttributeConfigured(rawConfig cty.Value, attribute string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	return !rawConfig.GetAttr(attribute).IsNull()
+}
+
 func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.Realm, error) {
 	internationalizationEnabled := false
 	supportLocales := make([]string, 0)
@@ -759,13 +1009,12 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	}
 
 	realm := &keycloak.Realm{
-		Id:                   realmId.(string),
-		Realm:                data.Get("realm").(string),
-		Enabled:              data.Get("enabled").(bool),
-		DisplayName:          data.Get("display_name").(string),
-		DisplayNameHtml:      data.Get("display_name_html").(string),
-		UserManagedAccess:    data.Get("user_managed_access").(bool),
-		OrganizationsEnabled: data.Get("organizations_enabled").(bool),
+		Id:                realmId.(string),
+		Realm:             data.Get("realm").(string),
+		Enabled:           data.Get("enabled").(bool),
+		DisplayName:       data.Get("display_name").(string),
+		DisplayNameHtml:   data.Get("display_name_html").(string),
+		UserManagedAccess: data.Get("user_managed_access").(bool),
 
 		// Login Config
 		RegistrationAllowed:         data.Get("registration_allowed").(bool),
@@ -802,11 +1051,10 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 
 		authConfig := smtpSettings["auth"].([]interface{})
 		if len(authConfig) == 1 {
-			auth := authConfig[0].(map[string]interface{})
-
-			smtpServer.Auth = true
-			smtpServer.User = auth["username"].(string)
-			smtpServer.Password = auth["password"].(string)
+			err := setRealmSMTPAuth(&smtpServer, authConfig[0].(map[string]interface{}), keycloakVersion)
+			if err != nil {
+				return nil, err
+			}
 		} else {
 			smtpServer.Auth = false
 		}
@@ -834,16 +1082,19 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 
 	// Tokens
 
-	if defaultSignatureAlgorithm, ok := data.GetOk("default_signature_algorithm"); ok {
-		realm.DefaultSignatureAlgorithm = defaultSignatureAlgorithm.(string)
+	// these are only sent when configured, so they can be managed with keycloak_realm_token_settings instead
+	rawConfig := data.GetRawConfig()
+
+	if realmAttributeConfigured(rawConfig, "default_signature_algorithm") {
+		realm.DefaultSignatureAlgorithm = stringPointer(data.Get("default_signature_algorithm").(string))
 	}
 
-	if revokeRefreshToken, ok := data.GetOk("revoke_refresh_token"); ok {
-		realm.RevokeRefreshToken = revokeRefreshToken.(bool)
+	if realmAttributeConfigured(rawConfig, "revoke_refresh_token") {
+		realm.RevokeRefreshToken = boolPointer(data.Get("revoke_refresh_token").(bool))
 	}
 
-	if refreshTokenMaxReuse, ok := data.GetOk("refresh_token_max_reuse"); ok {
-		realm.RefreshTokenMaxReuse = refreshTokenMaxReuse.(int)
+	if realmAttributeConfigured(rawConfig, "refresh_token_max_reuse") {
+		realm.RefreshTokenMaxReuse = intPointer(data.Get("refresh_token_max_reuse").(int))
 	}
 
 	if ssoSessionIdleTimeout := data.Get("sso_session_idle_timeout").(string); ssoSessionIdleTimeout != "" {
@@ -894,8 +1145,8 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 		realm.OfflineSessionMaxLifespan = offlineSessionMaxLifespanDurationString
 	}
 
-	if offlineSessionMaxLifespanEnabled, ok := data.GetOk("offline_session_max_lifespan_enabled"); ok {
-		realm.OfflineSessionMaxLifespanEnabled = offlineSessionMaxLifespanEnabled.(bool)
+	if realmAttributeConfigured(rawConfig, "offline_session_max_lifespan_enabled") {
+		realm.OfflineSessionMaxLifespanEnabled = boolPointer(data.Get("offline_session_max_lifespan_enabled").(bool))
 	}
 
 	if clientSessionIdleTimeout := data.Get("client_session_idle_timeout").(string); clientSessionIdleTimeout != "" {
@@ -1015,6 +1266,11 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 			realm.MinimumQuickLoginWaitSeconds = bruteForceDetectionSettings["minimum_quick_login_wait_seconds"].(int)
 			realm.MaxFailureWaitSeconds = bruteForceDetectionSettings["max_failure_wait_seconds"].(int)
 			realm.MaxDeltaTimeSeconds = bruteForceDetectionSettings["failure_reset_time_seconds"].(int)
+
+			err := setRealmBruteForceLockoutSettings(realm, bruteForceDetectionSettings, keycloakVersion)
+			if err != nil {
+				return nil, err
+			}
 		} else {
 			setDefaultSecuritySettingsBruteForceDetection(realm)
 		}
@@ -1023,18 +1279,98 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 		setDefaultSecuritySettingsBruteForceDetection(realm)
 	}
 
+	// the settings are sent to versions that support them even without brute force detection, so they're reset along with the others
+	if realm.MaxTemporaryLockouts == nil && keycloakVersion.GreaterThanOrEqual(keycloak.Version_24.AsVersion()) {
+		realm.MaxTemporaryLockouts = intPointer(0)
+	}
+	if realm.BruteForceStrategy == "" && keycloakVersion.GreaterThanOrEqual(keycloak.Version_26.AsVersion()) {
+		realm.BruteForceStrategy = "MULTIPLE"
+	}
+
 	if passwordPolicy, ok := data.GetOk("password_policy"); ok {
-		realm.PasswordPolicy = passwordPolicy.(string)
+		realm.PasswordPolicy = keycloak.NormalizePasswordPolicy(passwordPolicy.(string))
 	}
 
 	setRealmFlowBindings(data, realm, keycloakVersion)
 
+	// organizations_enabled is always sent to versions that support it, otherwise organizations could never be disabled again
+	if keycloakVersion.LessThan(keycloak.Version_25.AsVersion()) {
+		if data.Get("organizations_enabled").(bool) {
+			return nil, fmt.Errorf("organizations_enabled requires Keycloak 25 or later")
+		}
+	} else {
+		realm.OrganizationsEnabled = boolPointer(data.Get("organizations_enabled").(bool))
+	}
+
+	// admin_permissions_enabled is always sent to versions that support it, so that admin permissions can be disabled again
+	if keycloakVersion.LessThan(keycloak.Version_26_2.AsVersion()) {
+		if data.Get("admin_permissions_enabled").(bool) {
+			return nil, fmt.Errorf("admin_permissions_enabled requires Keycloak 26.2 or later")
+		}
+	} else {
+		realm.AdminPermissionsEnabled = boolPointer(data.Get("admin_permissions_enabled").(bool))
+	}
+
+	if v, ok := data.GetOkExists("verifiable_credentials_enabled"); ok {
+		if keycloakVersion.LessThan(keycloak.Version_25.AsVersion()) {
+			return nil, fmt.Errorf("verifiable_credentials_enabled requires Keycloak 25 or later")
+		}
+		realm.VerifiableCredentialsEnabled = boolPointer(v.(bool))
+	}
+
 	attributes := map[string]interface{}{}
 	if v, ok := data.GetOk("attributes"); ok {
 		for key, value := range v.(map[string]interface{}) {
 			attributes[key] = value
 		}
 	}
+
+	// the mapping used to be set through attributes, which keeps working as long as acr_loa_map isn't set
+	acrLoaMap, err := getAcrLoaMapFromData(data.Get("acr_loa_map").(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := attributes[acrLoaMapAttribute]; ok {
+		if acrLoaMap != "" {
+			return nil, fmt.Errorf(`"acr_loa_map" and attributes "%s" can't be set at the same time`, acrLoaMapAttribute)
+		}
+	} else if acrLoaMap != "" {
+		attributes[acrLoaMapAttribute] = acrLoaMap
+	} else if oldAcrLoaMap, _ := data.GetChange("acr_loa_map"); len(oldAcrLoaMap.(map[string]interface{})) != 0 {
+		// Keycloak keeps realm attributes which are missing from an update
+		attributes[acrLoaMapAttribute] = "{}"
+	}
+
+	// the lifespan used to be set through attributes, which keeps working as long as par_request_uri_lifespan isn't set
+	if parRequestUriLifespan := data.Get("par_request_uri_lifespan").(string); parRequestUriLifespan != "" {
+		if _, ok := attributes[parRequestUriLifespanAttribute]; ok {
+			return nil, fmt.Errorf(`"par_request_uri_lifespan" and attributes "%s" can't be set at the same time`, parRequestUriLifespanAttribute)
+		}
+
+		parRequestUriLifespanSeconds, err := getSecondsFromDurationString(parRequestUriLifespan)
+		if err != nil {
+			return nil, err
+		}
+		attributes[parRequestUriLifespanAttribute] = strconv.Itoa(parRequestUriLifespanSeconds)
+	} else if _, ok := attributes[parRequestUriLifespanAttribute]; !ok {
+		if oldParRequestUriLifespan, _ := data.GetChange("par_request_uri_lifespan"); oldParRequestUriLifespan.(string) != "" {
+			// Keycloak keeps realm attributes which are missing from an update
+			attributes[parRequestUriLifespanAttribute] = ""
+		}
+	}
+
+	// dark mode used to be set through attributes, which keeps working as long as dark_mode isn't set
+	if v, ok := data.GetOkExists("dark_mode"); ok {
+		if keycloakVersion.LessThan(keycloak.Version_26.AsVersion()) {
+			return nil, fmt.Errorf("dark_mode requires Keycloak 26 or later")
+		}
+		if _, ok := attributes[darkModeAttribute]; ok {
+			return nil, fmt.Errorf(`"dark_mode" and attributes "%s" can't be set at the same time`, darkModeAttribute)
+		}
+
+		attributes[darkModeAttribute] = strconv.FormatBool(v.(bool))
+	}
+
 	realm.Attributes = attributes
 
 	defaultDefaultClientScopes := make([]string, 0)
@@ -1191,6 +1527,35 @@ func setDefaultSecuritySettingsBruteForceDetection(realm *keycloak.Realm) {
 	realm.MaxDeltaTimeSeconds = 43200
 }
 
+// setRealmBruteForceLockoutSettings sets the brute force settings which older versions of Keycloak don't have, which are
+// only sent to versions that support them.
+func setRealmBruteForceLockoutSettings(realm *keycloak.Realm, bruteForceDetectionSettings map[string]interface{}, keycloakVersion *version.Version) error {
+	maxTemporaryLockouts := bruteForceDetectionSettings["max_temporary_lockouts"].(int)
+	if keycloakVersion.GreaterThanOrEqual(keycloak.Version_24.AsVersion()) {
+		realm.MaxTemporaryLockouts = intPointer(maxTemporaryLockouts)
+	} else if maxTemporaryLockouts != 0 {
+		return fmt.Errorf("max_temporary_lockouts requires Keycloak 24 or later")
+	}
+
+	bruteForceStrategy := bruteForceDetectionSettings["brute_force_strategy"].(string)
+	if keycloakVersion.GreaterThanOrEqual(keycloak.Version_26.AsVersion()) {
+		realm.BruteForceStrategy = bruteForceStrategy
+	} else if bruteForceStrategy != "MULTIPLE" {
+		return fmt.Errorf("brute_force_strategy requires Keycloak 26 or later")
+	}
+
+	return nil
+}
+
+// Users are disabled instead of temporarily locked out when permanent_lockout is true and max_temporary_lockouts is 0,
+// so Keycloak doesn't use the wait related settings and may return stale values for them. With temporary lockouts before
+// the permanent one, the wait settings are still used.
+func suppressBruteForceWaitDiffOnPermanentLockout(k, _, _ string, data *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".")+1]
+
+	return data.Get(prefix+"permanent_lockout").(bool) && data.Get(prefix+"max_temporary_lockouts").(int) == 0
+}
+
 func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVersion *version.Version) {
 	data.SetId(realm.Realm)
 
@@ -1200,7 +1565,11 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	data.Set("display_name", realm.DisplayName)
 	data.Set("display_name_html", realm.DisplayNameHtml)
 	data.Set("user_managed_access", realm.UserManagedAccess)
-	data.Set("organizations_enabled", realm.OrganizationsEnabled)
+	data.Set("organizations_enabled", realm.OrganizationsEnabled != nil && *realm.OrganizationsEnabled)
+	data.Set("admin_permissions_enabled", realm.AdminPermissionsEnabled != nil && *realm.AdminPermissionsEnabled)
+	if realm.VerifiableCredentialsEnabled != nil {
+		data.Set("verifiable_credentials_enabled", *realm.VerifiableCredentialsEnabled)
+	}
 
 	// Login Config
 	data.Set("registration_allowed", realm.RegistrationAllowed)
@@ -1235,6 +1604,14 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 
 			auth["username"] = realm.SmtpServer.User
 			auth["password"] = realm.SmtpServer.Password
+			auth["auth_type"] = "basic"
+			if realm.SmtpServer.AuthType != "" {
+				auth["auth_type"] = realm.SmtpServer.AuthType
+			}
+			auth["auth_token_url"] = realm.SmtpServer.AuthTokenUrl
+			auth["auth_token_scope"] = realm.SmtpServer.AuthTokenScope
+			auth["auth_token_client_id"] = realm.SmtpServer.AuthTokenClientId
+			auth["auth_token_client_secret"] = realm.SmtpServer.AuthTokenClientSecret
 
 			smtpSettings["auth"] = []interface{}{auth}
 		}
@@ -1249,16 +1626,24 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	data.Set("email_theme", realm.EmailTheme)
 
 	// Tokens
-	data.Set("default_signature_algorithm", realm.DefaultSignatureAlgorithm)
-	data.Set("revoke_refresh_token", realm.RevokeRefreshToken)
-	data.Set("refresh_token_max_reuse", realm.RefreshTokenMaxReuse)
+	if realm.DefaultSignatureAlgorithm != nil {
+		data.Set("default_signature_algorithm", *realm.DefaultSignatureAlgorithm)
+	}
+	if realm.RevokeRefreshToken != nil {
+		data.Set("revoke_refresh_token", *realm.RevokeRefreshToken)
+	}
+	if realm.RefreshTokenMaxReuse != nil {
+		data.Set("refresh_token_max_reuse", *realm.RefreshTokenMaxReuse)
+	}
 	data.Set("sso_session_idle_timeout", getDurationStringFromSeconds(realm.SsoSessionIdleTimeout))
 	data.Set("sso_session_max_lifespan", getDurationStringFromSeconds(realm.SsoSessionMaxLifespan))
 	data.Set("sso_session_idle_timeout_remember_me", getDurationStringFromSeconds(realm.SsoSessionIdleTimeoutRememberMe))
 	data.Set("sso_session_max_lifespan_remember_me", getDurationStringFromSeconds(realm.SsoSessionMaxLifespanRememberMe))
 	data.Set("offline_session_idle_timeout", getDurationStringFromSeconds(realm.OfflineSessionIdleTimeout))
 	data.Set("offline_session_max_lifespan", getDurationStringFromSeconds(realm.OfflineSessionMaxLifespan))
-	data.Set("offline_session_max_lifespan_enabled", realm.OfflineSessionMaxLifespanEnabled)
+	if realm.OfflineSessionMaxLifespanEnabled != nil {
+		data.Set("offline_session_max_lifespan_enabled", *realm.OfflineSessionMaxLifespanEnabled)
+	}
 	data.Set("client_session_idle_timeout", getDurationStringFromSeconds(realm.ClientSessionIdleTimeout))
 	data.Set("client_session_max_lifespan", getDurationStringFromSeconds(realm.ClientSessionMaxLifespan))
 	data.Set("access_token_lifespan", getDurationStringFromSeconds(realm.AccessTokenLifespan))
@@ -1283,6 +1668,8 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 
 	if v, ok := data.GetOk("security_defenses"); ok {
 		oldHeadersConfig := v.([]interface{})[0].(map[string]interface{})["headers"].([]interface{})
+		// Keycloak returns the brute force settings even when brute force protection is disabled, but they might be stale,
+		// so brute_force_detection is only read when the protection is enabled.
 		if len(oldHeadersConfig) == 0 && !realm.BruteForceProtected {
 			data.Set("security_defenses", nil)
 		} else if len(oldHeadersConfig) == 1 && realm.BruteForceProtected {
@@ -1362,6 +1749,27 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	}
 	data.Set("attributes", attributes)
 
+	if _, ok := attributes[acrLoaMapAttribute]; !ok {
+		acrLoaMapJson, _ := realm.Attributes[acrLoaMapAttribute].(string)
+		if acrLoaMap, err := getAcrLoaMapData(acrLoaMapJson); err == nil {
+			data.Set("acr_loa_map", acrLoaMap)
+		}
+	}
+
+	if _, ok := data.GetOk("par_request_uri_lifespan"); ok {
+		if parRequestUriLifespan, ok := realm.Attributes[parRequestUriLifespanAttribute].(string); ok {
+			if parRequestUriLifespanSeconds, err := strconv.Atoi(parRequestUriLifespan); err == nil {
+				data.Set("par_request_uri_lifespan", getDurationStringFromSeconds(parRequestUriLifespanSeconds))
+			}
+		}
+	}
+
+	if _, ok := attributes[darkModeAttribute]; !ok {
+		if darkMode, ok := realm.Attributes[darkModeAttribute].(string); ok {
+			data.Set("dark_mode", darkMode != "false")
+		}
+	}
+
 	// default and optional client scope mappings
 	data.Set("default_default_client_scopes", realm.DefaultDefaultClientScopes)
 	data.Set("default_optional_client_scopes", realm.DefaultOptionalClientScopes)
@@ -1376,6 +1784,16 @@ func getBruteForceDetectionSettings(realm *keycloak.Realm) map[string]interface{
 	bruteForceDetectionSettings["minimum_quick_login_wait_seconds"] = realm.MinimumQuickLoginWaitSeconds
 	bruteForceDetectionSettings["max_failure_wait_seconds"] = realm.MaxFailureWaitSeconds
 	bruteForceDetectionSettings["failure_reset_time_seconds"] = realm.MaxDeltaTimeSeconds
+
+	// older versions of Keycloak don't return these settings, they behave like the defaults
+	bruteForceDetectionSettings["max_temporary_lockouts"] = 0
+	if realm.MaxTemporaryLockouts != nil {
+		bruteForceDetectionSettings["max_temporary_lockouts"] = *realm.MaxTemporaryLockouts
+	}
+	bruteForceDetectionSettings["brute_force_strategy"] = "MULTIPLE"
+	if realm.BruteForceStrategy != "" {
+		bruteForceDetectionSettings["brute_force_strategy"] = realm.BruteForceStrategy
+	}
 	return bruteForceDetectionSettings
 }
 
@@ -1421,7 +1839,14 @@ func resourceKeycloakRealmCreate(ctx context.Context, data *schema.ResourceData,
 
 	setRealmData(data, realm, keycloakVersion)
 
-	return resourceKeycloakRealmRead(ctx, data, meta)
+	diags := resourceKeycloakRealmRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, getRealmInternationalizationWarnings(ctx, keycloakClient, realm)...)
+
+	return append(diags, getRealmPasswordResetWarnings(ctx, keycloakClient, realm)...)
 }
 
 func resourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1436,9 +1861,10 @@ func resourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData, m
 		return handleNotFoundError(ctx, err, data)
 	}
 
-	// we can't trust the API to set this field correctly since it just responds with "**********" this implies a 'password only' change will not be detected
-	if smtpPassword, ok := getRealmSMTPPasswordFromData(data); ok {
-		realm.SmtpServer.Password = smtpPassword
+	// we can't trust the API to set these fields correctly since it just responds with "**********" this implies a 'password only' change will not be detected
+	if smtpAuth, ok := getRealmSMTPAuthFromData(data); ok {
+		realm.SmtpServer.Password = smtpAuth["password"].(string)
+		realm.SmtpServer.AuthTokenClientSecret = smtpAuth["auth_token_client_secret"].(string)
 	}
 
 	setRealmData(data, realm, keycloakVersion)
@@ -1470,7 +1896,9 @@ func resourceKeycloakRealmUpdate(ctx context.Context, data *schema.ResourceData,
 
 	setRealmData(data, realm, keycloakVersion)
 
-	return nil
+	diags := getRealmInternationalizationWarnings(ctx, keycloakClient, realm)
+
+	return append(diags, getRealmPasswordResetWarnings(ctx, keycloakClient, realm)...)
 }
 
 func resourceKeycloakRealmDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
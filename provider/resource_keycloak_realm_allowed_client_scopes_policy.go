@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakClientRegistrationPolicySubTypes = []string{"anonymous", "authenticated"}
+
+func resourceKeycloakRealmAllowedClientScopesPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmAllowedClientScopesPolicyCreate,
+		ReadContext:   resourceKeycloakRealmAllowedClientScopesPolicyRead,
+		UpdateContext: resourceKeycloakRealmAllowedClientScopesPolicyUpdate,
+		DeleteContext: resourceKeycloakRealmAllowedClientScopesPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			// {{realmId}}/{{policyId}}, same as the keystores, as both are realm components
+			StateContext: resourceKeycloakRealmKeystoreGenericImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the policy in the admin console.",
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sub_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloakClientRegistrationPolicySubTypes, false),
+				Description:  "Whether the policy applies to anonymous or authenticated client registration requests.",
+			},
+			"allowed_client_scopes": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Names of the client scopes which registered clients are allowed to use.",
+			},
+			"allow_default_scopes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set, registered clients are also allowed to use the realm's default and optional client scopes.",
+			},
+		},
+	}
+}
+
+func getRealmAllowedClientScopesPolicyFromData(data *schema.ResourceData) *keycloak.RealmAllowedClientScopesPolicy {
+	allowedClientScopes := make([]string, 0)
+	if v, ok := data.GetOk("allowed_client_scopes"); ok {
+		allowedClientScopes = interfaceSliceToStringSlice(v.(*schema.Set).List())
+	}
+
+	return &keycloak.RealmAllowedClientScopesPolicy{
+		Id:      data.Id(),
+		Name:    data.Get("name").(string),
+		RealmId: data.Get("realm_id").(string),
+		SubType: data.Get("sub_type").(string),
+
+		AllowedClientScopes: allowedClientScopes,
+		AllowDefaultScopes:  data.Get("allow_default_scopes").(bool),
+	}
+}
+
+func setRealmAllowedClientScopesPolicyData(data *schema.ResourceData, policy *keycloak.RealmAllowedClientScopesPolicy) {
+	data.SetId(policy.Id)
+
+	data.Set("name", policy.Name)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("sub_type", policy.SubType)
+
+	data.Set("allowed_client_scopes", policy.AllowedClientScopes)
+	data.Set("allow_default_scopes", policy.AllowDefaultScopes)
+}
+
+func resourceKeycloakRealmAllowedClientScopesPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getRealmAllowedClientScopesPolicyFromData(data)
+
+	err := keycloakClient.NewRealmAllowedClientScopesPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRealmAllowedClientScopesPolicyData(data, policy)
+
+	return resourceKeycloakRealmAllowedClientScopesPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmAllowedClientScopesPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	policy, err := keycloakClient.GetRealmAllowedClientScopesPolicy(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmAllowedClientScopesPolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakRealmAllowedClientScopesPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getRealmAllowedClientScopesPolicyFromData(data)
+
+	err := keycloakClient.UpdateRealmAllowedClientScopesPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRealmAllowedClientScopesPolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakRealmAllowedClientScopesPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteRealmAllowedClientScopesPolicy(ctx, realmId, id))
+}
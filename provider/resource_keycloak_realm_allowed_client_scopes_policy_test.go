@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmAllowedClientScopesPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_allowed_client_scopes_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmAllowedClientScopesPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmAllowedClientScopesPolicy_basic(realmName, policyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmAllowedClientScopesPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allowed_client_scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allow_default_scopes", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId(resourceName),
+			},
+			{
+				Config: testKeycloakRealmAllowedClientScopesPolicy_basic(realmName, policyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmAllowedClientScopesPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_default_scopes", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmAllowedClientScopesPolicy_subTypeValidation(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmAllowedClientScopesPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "keycloak_realm_allowed_client_scopes_policy" "policy" {
+	name     = "%s"
+	realm_id = "%s"
+	sub_type = "%s"
+}
+				`, acctest.RandomWithPrefix("tf-acc"), testAccRealm.Realm, acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("expected sub_type to be one of .+ got .+"),
+			},
+		},
+	})
+}
+
+func testAccCheckRealmAllowedClientScopesPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakRealmAllowedClientScopesPolicyFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckRealmAllowedClientScopesPolicyDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_allowed_client_scopes_policy" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			policy, _ := keycloakClient.GetRealmAllowedClientScopesPolicy(testCtx, realm, id)
+			if policy != nil {
+				return fmt.Errorf("allowed client scopes policy with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakRealmAllowedClientScopesPolicyFromState(s *terraform.State, resourceName string) (*keycloak.RealmAllowedClientScopesPolicy, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	policy, err := keycloakClient.GetRealmAllowedClientScopesPolicy(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting allowed client scopes policy with id %s: %s", id, err)
+	}
+
+	return policy, nil
+}
+
+func testKeycloakRealmAllowedClientScopesPolicy_basic(realm, policyName string, allowDefaultScopes bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = keycloak_realm.realm.id
+	name     = "registration-scope"
+}
+
+resource "keycloak_realm_allowed_client_scopes_policy" "policy" {
+	name     = "%s"
+	realm_id = keycloak_realm.realm.id
+	sub_type = "authenticated"
+
+	allowed_client_scopes = [
+		keycloak_openid_client_scope.client_scope.name,
+	]
+	allow_default_scopes = %t
+}
+	`, realm, policyName, allowDefaultScopes)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientPolicyCreate,
+		ReadContext:   resourceKeycloakRealmClientPolicyRead,
+		UpdateContext: resourceKeycloakRealmClientPolicyUpdate,
+		DeleteContext: resourceKeycloakRealmClientPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			// This resource can be imported using {{realm}}/{{name}}.
+			StateContext: resourceKeycloakRealmClientPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"profiles": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The names of the client profiles, either of the realm or global ones, that are applied to the clients matching all conditions of this policy.",
+			},
+			"condition": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Conditions of this policy, which all have to match a client for the profiles of this policy to be applied.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The provider id of the condition, for example any-client, client-access-type, client-roles or client-scopes.",
+						},
+						"configuration": {
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "Configuration of the condition.",
+						},
+						"configuration_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							Description:      "Configuration of the condition as a JSON object, for values which aren't strings.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getRealmClientPolicyFromData(data *schema.ResourceData) (*keycloak.ClientPolicy, error) {
+	conditions := make([]keycloak.ClientPolicyCondition, 0)
+
+	for _, conditionData := range data.Get("condition").([]interface{}) {
+		conditionMap := conditionData.(map[string]interface{})
+
+		configuration, err := getClientPolicyConfigurationFromData(conditionMap["configuration"].(map[string]interface{}), conditionMap["configuration_json"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, keycloak.ClientPolicyCondition{
+			Condition:     conditionMap["name"].(string),
+			Configuration: configuration,
+		})
+	}
+
+	return &keycloak.ClientPolicy{
+		RealmId:     data.Get("realm_id").(string),
+		Name:        data.Get("name").(string),
+		Description: data.Get("description").(string),
+		Enabled:     data.Get("enabled").(bool),
+		Conditions:  conditions,
+		Profiles:    interfaceSliceToStringSlice(data.Get("profiles").([]interface{})),
+	}, nil
+}
+
+func setRealmClientPolicyData(data *schema.ResourceData, policy *keycloak.ClientPolicy) {
+	data.SetId(fmt.Sprintf("%s/%s", policy.RealmId, policy.Name))
+	data.Set("realm_id", policy.RealmId)
+	data.Set("name", policy.Name)
+	data.Set("description", policy.Description)
+	data.Set("enabled", policy.Enabled)
+	data.Set("profiles", policy.Profiles)
+
+	conditions := make([]interface{}, 0)
+	for i, condition := range policy.Conditions {
+		configuration, configurationJson := setClientPolicyConfigurationData(condition.Configuration, data.Get(fmt.Sprintf("condition.%d.configuration_json", i)).(string))
+
+		conditions = append(conditions, map[string]interface{}{
+			"name":               condition.Condition,
+			"configuration":      configuration,
+			"configuration_json": configurationJson,
+		})
+	}
+	data.Set("condition", conditions)
+}
+
+func resourceKeycloakRealmClientPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy, err := getRealmClientPolicyFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewClientPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRealmClientPolicyData(data, policy)
+
+	return resourceKeycloakRealmClientPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy, err := keycloakClient.GetClientPolicy(ctx, data.Get("realm_id").(string), data.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmClientPolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy, err := getRealmClientPolicyFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateClientPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmClientPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return diag.FromErr(keycloakClient.DeleteClientPolicy(ctx, data.Get("realm_id").(string), data.Get("name").(string)))
+}
+
+func resourceKeycloakRealmClientPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid import. Supported import formats: {{realmId}}/{{name}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[1]))
+
+	diagnostics := resourceKeycloakRealmClientPolicyRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("client policy %s does not exist in realm %s", parts[1], parts[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,13 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// keycloak_realm_client_policy_profile is the former name of keycloak_realm_client_profile, both manage the same client profiles
+func resourceKeycloakRealmClientPolicyProfile() *schema.Resource {
+	resource := resourceKeycloakRealmClientProfile()
+	resource.DeprecationMessage = "please use keycloak_realm_client_profile instead"
+
+	return resource
+}
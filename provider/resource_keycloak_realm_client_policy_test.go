@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmClientPolicy_basic(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	policyName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_client_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicy_basic(realmName, policyName, true, "confidential"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "profiles.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "profiles.1", "fapi-1-baseline"),
+					resource.TestCheckResourceAttr(resourceName, "condition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.name", "client-access-type"),
+					resource.TestCheckResourceAttr(resourceName, "condition.1.configuration.is-negative-logic", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"condition.0.configuration", "condition.0.configuration_json", "condition.1.configuration", "condition.1.configuration_json"},
+			},
+			{
+				Config: testKeycloakRealmClientPolicy_basic(realmName, policyName, false, "public"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					testAccCheckKeycloakRealmClientPolicyAccessType(resourceName, "public"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmClientPolicyFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakRealmClientPolicyAccessType(resourceName, accessType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, err := getRealmClientPolicyFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		accessTypes, ok := policy.Conditions[0].Configuration["type"].([]interface{})
+		if !ok || len(accessTypes) != 1 || accessTypes[0] != accessType {
+			return fmt.Errorf("expected client policy %s to match clients with access type %s, got %v", policy.Name, accessType, policy.Conditions[0].Configuration["type"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmClientPolicyDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_client_policy" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm_id"]
+			name := rs.Primary.Attributes["name"]
+
+			policy, _ := keycloakClient.GetClientPolicy(testCtx, realm, name)
+			if policy != nil {
+				return fmt.Errorf("client policy %s still exists", name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmClientPolicyFromState(s *terraform.State, resourceName string) (*keycloak.ClientPolicy, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm_id"]
+	name := rs.Primary.Attributes["name"]
+
+	policy, err := keycloakClient.GetClientPolicy(testCtx, realm, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client policy %s: %s", name, err)
+	}
+
+	return policy, nil
+}
+
+func testKeycloakRealmClientPolicy_basic(realm, name string, enabled bool, accessType string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_profile" "profile" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s-profile"
+
+	executor {
+		name = "confidential-client"
+	}
+}
+
+resource "keycloak_realm_client_policy" "policy" {
+	realm_id    = keycloak_realm.realm.id
+	name        = "%s"
+	description = "policy for %s clients"
+	enabled     = %t
+	profiles    = [keycloak_realm_client_profile.profile.name, "fapi-1-baseline"]
+
+	condition {
+		name               = "client-access-type"
+		configuration_json = jsonencode({
+			type = ["%s"]
+		})
+	}
+
+	condition {
+		name = "client-roles"
+		configuration = {
+			"is-negative-logic" = "false"
+		}
+		configuration_json = jsonencode({
+			roles = ["fapi"]
+		})
+	}
+}
+	`, realm, name, name, accessType, enabled, accessType)
+}
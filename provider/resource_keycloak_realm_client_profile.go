@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+const (
+	clientPolicyPkceEnforcerExecutor              = "pkce-enforcer"
+	clientPolicySecureClientAuthenticatorExecutor = "secure-client-authenticator"
+)
+
+func resourceKeycloakRealmClientProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientProfileCreate,
+		ReadContext:   resourceKeycloakRealmClientProfileRead,
+		UpdateContext: resourceKeycloakRealmClientProfileUpdate,
+		DeleteContext: resourceKeycloakRealmClientProfileDelete,
+		Importer: &schema.ResourceImporter{
+			// This resource can be imported using {{realm}}/{{name}}.
+			StateContext: resourceKeycloakRealmClientProfileImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"executor": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Executors of this profile, which are run in the given order for clients matching a client policy that uses this profile.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The provider id of the executor, for example secure-session, pkce-enforcer, secure-client-authenticator or confidential-client.",
+						},
+						"auto_configure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "pkce-enforcer only: when true, the PKCE code challenge method of matching clients is set to S256 automatically.",
+						},
+						"allowed_client_authenticators": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "secure-client-authenticator only: the client authenticators matching clients are allowed to use.",
+						},
+						"default_client_authenticator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "secure-client-authenticator only: the client authenticator set on matching clients which don't specify an allowed one.",
+						},
+						"configuration": {
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "Configuration of executors which don't have typed attributes.",
+						},
+						"configuration_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							Description:      "Configuration of executors which don't have typed attributes as a JSON object, for values which aren't strings.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getRealmClientProfileFromData(data *schema.ResourceData) (*keycloak.ClientPolicyProfile, error) {
+	executors := make([]keycloak.ClientPolicyProfileExecutor, 0)
+
+	for _, executorData := range data.Get("executor").([]interface{}) {
+		executorMap := executorData.(map[string]interface{})
+
+		name := executorMap["name"].(string)
+		autoConfigure := executorMap["auto_configure"].(bool)
+		allowedClientAuthenticators := interfaceSliceToStringSlice(executorMap["allowed_client_authenticators"].(*schema.Set).List())
+		defaultClientAuthenticator := executorMap["default_client_authenticator"].(string)
+
+		if autoConfigure && name != clientPolicyPkceEnforcerExecutor {
+			return nil, fmt.Errorf("validation error: auto_configure is only supported for the %s executor, got %s", clientPolicyPkceEnforcerExecutor, name)
+		}
+
+		if (len(allowedClientAuthenticators) != 0 || defaultClientAuthenticator != "") && name != clientPolicySecureClientAuthenticatorExecutor {
+			return nil, fmt.Errorf("validation error: allowed_client_authenticators and default_client_authenticator are only supported for the %s executor, got %s", clientPolicySecureClientAuthenticatorExecutor, name)
+		}
+
+		configuration, err := getClientPolicyConfigurationFromData(executorMap["configuration"].(map[string]interface{}), executorMap["configuration_json"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		switch name {
+		case clientPolicyPkceEnforcerExecutor:
+			configuration["auto-configure"] = autoConfigure
+		case clientPolicySecureClientAuthenticatorExecutor:
+			configuration["allowed-client-authenticators"] = allowedClientAuthenticators
+			if defaultClientAuthenticator != "" {
+				configuration["default-client-authenticator"] = defaultClientAuthenticator
+			}
+		}
+
+		executors = append(executors, keycloak.ClientPolicyProfileExecutor{
+			Executor:      name,
+			Configuration: configuration,
+		})
+	}
+
+	return &keycloak.ClientPolicyProfile{
+		RealmId:     data.Get("realm_id").(string),
+		Name:        data.Get("name").(string),
+		Description: data.Get("description").(string),
+		Executors:   executors,
+	}, nil
+}
+
+func setRealmClientProfileData(data *schema.ResourceData, profile *keycloak.ClientPolicyProfile) {
+	data.SetId(fmt.Sprintf("%s/%s", profile.RealmId, profile.Name))
+	data.Set("realm_id", profile.RealmId)
+	data.Set("name", profile.Name)
+	data.Set("description", profile.Description)
+
+	executors := make([]interface{}, 0)
+	for i, executor := range profile.Executors {
+		executorMap := map[string]interface{}{
+			"name":                          executor.Executor,
+			"auto_configure":                false,
+			"allowed_client_authenticators": []string{},
+			"default_client_authenticator":  "",
+		}
+
+		configuration := make(map[string]interface{})
+		for key, value := range executor.Configuration {
+			switch {
+			case executor.Executor == clientPolicyPkceEnforcerExecutor && key == "auto-configure":
+				executorMap["auto_configure"] = value == true || value == "true"
+			case executor.Executor == clientPolicySecureClientAuthenticatorExecutor && key == "allowed-client-authenticators":
+				if allowedClientAuthenticators, ok := value.([]interface{}); ok {
+					executorMap["allowed_client_authenticators"] = interfaceSliceToStringSlice(allowedClientAuthenticators)
+				}
+			case executor.Executor == clientPolicySecureClientAuthenticatorExecutor && key == "default-client-authenticator":
+				executorMap["default_client_authenticator"] = fmt.Sprintf("%v", value)
+			default:
+				configuration[key] = value
+			}
+		}
+		executorMap["configuration"], executorMap["configuration_json"] = setClientPolicyConfigurationData(configuration, data.Get(fmt.Sprintf("executor.%d.configuration_json", i)).(string))
+
+		executors = append(executors, executorMap)
+	}
+	data.Set("executor", executors)
+}
+
+// getClientPolicyConfigurationFromData merges the configuration of an executor or condition given as a map of strings
+// with the one given as JSON, which is needed for values like lists.
+func getClientPolicyConfigurationFromData(configurationData map[string]interface{}, configurationJson string) (map[string]interface{}, error) {
+	configuration := make(map[string]interface{})
+
+	if configurationJson != "" {
+		err := json.Unmarshal([]byte(configurationJson), &configuration)
+		if err != nil {
+			return nil, fmt.Errorf("validation error: configuration_json must be a JSON object: %s", err)
+		}
+	}
+
+	for key, value := range configurationData {
+		if _, ok := configuration[key]; ok {
+			return nil, fmt.Errorf("validation error: %s can't be set in both configuration and configuration_json", key)
+		}
+
+		configuration[key] = value
+	}
+
+	return configuration, nil
+}
+
+// setClientPolicyConfigurationData splits the configuration of an executor or condition. Keys which were given as JSON
+// are kept in configuration_json, every other key is set in configuration.
+func setClientPolicyConfigurationData(configuration map[string]interface{}, configurationJson string) (map[string]string, string) {
+	jsonKeys := make(map[string]interface{})
+	if configurationJson != "" {
+		// an invalid configuration_json can't have been sent to keycloak, so its keys are all set in configuration
+		_ = json.Unmarshal([]byte(configurationJson), &jsonKeys)
+	}
+
+	configurationData := make(map[string]string)
+	jsonConfiguration := make(map[string]interface{})
+	for key, value := range configuration {
+		if _, ok := jsonKeys[key]; ok {
+			jsonConfiguration[key] = value
+		} else {
+			configurationData[key] = fmt.Sprintf("%v", value)
+		}
+	}
+
+	if len(jsonConfiguration) == 0 {
+		return configurationData, ""
+	}
+
+	jsonConfigurationBytes, _ := json.Marshal(jsonConfiguration)
+
+	return configurationData, string(jsonConfigurationBytes)
+}
+
+func resourceKeycloakRealmClientProfileCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	profile, err := getRealmClientProfileFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewClientPolicyProfile(ctx, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRealmClientProfileData(data, profile)
+
+	return resourceKeycloakRealmClientProfileRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientProfileRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	profile, err := keycloakClient.GetClientPolicyProfile(ctx, data.Get("realm_id").(string), data.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmClientProfileData(data, profile)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientProfileUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	profile, err := getRealmClientProfileFromData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateClientPolicyProfile(ctx, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmClientProfileRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientProfileDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return diag.FromErr(keycloakClient.DeleteClientPolicyProfile(ctx, data.Get("realm_id").(string), data.Get("name").(string)))
+}
+
+func resourceKeycloakRealmClientProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid import. Supported import formats: {{realmId}}/{{name}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[1]))
+
+	diagnostics := resourceKeycloakRealmClientProfileRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("client policy profile %s does not exist in realm %s", parts[1], parts[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
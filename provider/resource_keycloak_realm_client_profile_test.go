@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmClientProfile_basic(t *testing.T) {
+	t.Parallel()
+	profileName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_client_profile.profile"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientProfile_basic(profileName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "executor.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "executor.0.name", "secure-session"),
+					resource.TestCheckResourceAttr(resourceName, "executor.1.auto_configure", "true"),
+					resource.TestCheckResourceAttr(resourceName, "executor.2.default_client_authenticator", "client-jwt"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmClientProfile_basic(profileName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "executor.1.auto_configure", "false"),
+				),
+			},
+		},
+	})
+}
+
+// the deprecated name manages the same client profiles
+func TestAccKeycloakRealmClientPolicyProfile_deprecated(t *testing.T) {
+	t.Parallel()
+	profileName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_client_policy_profile.profile"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicyProfile_deprecated(profileName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "executor.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "executor.0.name", "pkce-enforcer"),
+					resource.TestCheckResourceAttr(resourceName, "executor.0.auto_configure", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientProfile_invalidExecutorConfiguration(t *testing.T) {
+	t.Parallel()
+	profileName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientProfile_invalidExecutorConfiguration(profileName),
+				ExpectError: regexp.MustCompile("auto_configure is only supported for the pkce-enforcer executor"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientProfile_globalProfile(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmClientProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientProfile_global(),
+				ExpectError: regexp.MustCompile("client policy profile fapi-1-baseline is a global profile of Keycloak, which can't be managed"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmClientProfileFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakRealmClientProfileDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_client_profile" && rs.Type != "keycloak_realm_client_policy_profile" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm_id"]
+			name := rs.Primary.Attributes["name"]
+
+			profile, _ := keycloakClient.GetClientPolicyProfile(testCtx, realm, name)
+			if profile != nil {
+				return fmt.Errorf("client policy profile %s still exists", name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmClientProfileFromState(s *terraform.State, resourceName string) (*keycloak.ClientPolicyProfile, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm_id"]
+	name := rs.Primary.Attributes["name"]
+
+	profile, err := keycloakClient.GetClientPolicyProfile(testCtx, realm, name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client policy profile %s: %s", name, err)
+	}
+
+	return profile, nil
+}
+
+func testKeycloakRealmClientProfile_basic(name string, autoConfigure bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_profile" "profile" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	description = "hardening for confidential clients"
+
+	executor {
+		name = "secure-session"
+	}
+
+	executor {
+		name           = "pkce-enforcer"
+		auto_configure = %t
+	}
+
+	executor {
+		name                          = "secure-client-authenticator"
+		allowed_client_authenticators = ["client-jwt", "client-x509"]
+		default_client_authenticator  = "client-jwt"
+	}
+
+	executor {
+		name = "confidential-client"
+	}
+}
+	`, testAccRealm.Realm, name, autoConfigure)
+}
+
+func testKeycloakRealmClientProfile_invalidExecutorConfiguration(name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_profile" "profile" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+
+	executor {
+		name           = "confidential-client"
+		auto_configure = true
+	}
+}
+	`, testAccRealm.Realm, name)
+}
+
+func testKeycloakRealmClientProfile_global() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_profile" "profile" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "fapi-1-baseline"
+}
+	`, testAccRealm.Realm)
+}
+
+func testKeycloakRealmClientPolicyProfile_deprecated(name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+
+	executor {
+		name           = "pkce-enforcer"
+		auto_configure = true
+	}
+
+	executor {
+		name = "confidential-client"
+	}
+}
+	`, testAccRealm.Realm, name)
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"errors"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
@@ -13,6 +15,10 @@ func resourceKeycloakRealmEvents() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmEventsRead,
 		DeleteContext: resourceKeycloakRealmEventsDelete,
 		UpdateContext: resourceKeycloakRealmEventsUpdate,
+		Importer: &schema.ResourceImporter{
+			// This resource can be imported using the realm id.
+			StateContext: resourceKeycloakRealmEventsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -46,10 +52,17 @@ func resourceKeycloakRealmEvents() *schema.Resource {
 				ForceNew: false,
 			},
 			"events_listeners": {
-				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Optional: true,
-				ForceNew: false,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    false,
+				Description: "Keycloak doesn't keep the order of the listeners, they are read in the order they are declared in.",
+			},
+			"allow_unknown_events_listeners": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, events listeners are not validated against the listeners installed on the server.",
 			},
 		},
 	}
@@ -66,7 +79,7 @@ func getRealmEventsConfigFromData(data *schema.ResourceData) *keycloak.RealmEven
 	}
 
 	if v, ok := data.GetOk("events_listeners"); ok {
-		for _, eventsListener := range v.(*schema.Set).List() {
+		for _, eventsListener := range v.([]interface{}) {
 			eventsListeners = append(eventsListeners, eventsListener.(string))
 		}
 	}
@@ -88,13 +101,31 @@ func setRealmEventsConfigData(data *schema.ResourceData, realmEventsConfig *keyc
 	data.Set("admin_events_enabled", realmEventsConfig.AdminEventsEnabled)
 	data.Set("events_enabled", realmEventsConfig.EventsEnabled)
 	data.Set("events_expiration", realmEventsConfig.EventsExpiration)
-	data.Set("events_listeners", realmEventsConfig.EventsListeners)
+	data.Set("events_listeners", sortEventsListenersByDeclaredOrder(interfaceSliceToStringSlice(data.Get("events_listeners").([]interface{})), realmEventsConfig.EventsListeners))
 
 	if _, ok := data.GetOk("enabled_event_types"); ok {
 		data.Set("enabled_event_types", realmEventsConfig.EnabledEventTypes)
 	}
 }
 
+// sortEventsListenersByDeclaredOrder returns the listeners of the realm in the order they were declared in, followed by
+// the listeners which weren't declared in the order Keycloak returned them.
+func sortEventsListenersByDeclaredOrder(declared, eventsListeners []string) []string {
+	sorted := make([]string, 0, len(eventsListeners))
+	for _, eventsListener := range declared {
+		if stringSliceContains(eventsListeners, eventsListener) && !stringSliceContains(sorted, eventsListener) {
+			sorted = append(sorted, eventsListener)
+		}
+	}
+	for _, eventsListener := range eventsListeners {
+		if !stringSliceContains(sorted, eventsListener) {
+			sorted = append(sorted, eventsListener)
+		}
+	}
+
+	return sorted
+}
+
 func resourceKeycloakRealmEventsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	realmId := data.Get("realm_id").(string)
 	data.SetId(realmId)
@@ -143,6 +174,19 @@ func resourceKeycloakRealmEventsUpdate(ctx context.Context, data *schema.Resourc
 	realmId := data.Get("realm_id").(string)
 	realmEventsConfig := getRealmEventsConfigFromData(data)
 
+	for i, eventsListener := range realmEventsConfig.EventsListeners {
+		if stringSliceContains(realmEventsConfig.EventsListeners[:i], eventsListener) {
+			return diag.Errorf("validation error: events listener \"%s\" is listed more than once", eventsListener)
+		}
+	}
+
+	if !data.Get("allow_unknown_events_listeners").(bool) {
+		err := keycloakClient.ValidateRealmEventsConfig(ctx, realmEventsConfig)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err := keycloakClient.UpdateRealmEventsConfig(ctx, realmId, realmEventsConfig)
 	if err != nil {
 		return diag.FromErr(err)
@@ -152,3 +196,25 @@ func resourceKeycloakRealmEventsUpdate(ctx context.Context, data *schema.Resourc
 
 	return nil
 }
+
+func resourceKeycloakRealmEventsImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	data.Set("realm_id", data.Id())
+	data.Set("allow_unknown_events_listeners", false)
+
+	realmEventsConfig, err := keycloakClient.GetRealmEventsConfig(ctx, data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// the event types are only read when they are declared, which they can't be while importing
+	data.Set("enabled_event_types", realmEventsConfig.EnabledEventTypes)
+
+	diagnostics := resourceKeycloakRealmEventsRead(ctx, data, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
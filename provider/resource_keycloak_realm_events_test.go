@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"regexp"
 	"testing"
 )
 
@@ -24,6 +25,91 @@ func TestAccKeycloakRealmEvents_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealmEvents_unknownEventsListener(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmEvents_eventsListener(realmName, "jboss-loging"),
+				ExpectError: regexp.MustCompile(`validation error: events listener "jboss-loging" does not exist on the server`),
+			},
+			{
+				Config: testKeycloakRealmEvents_eventsListener(realmName, "jboss-logging"),
+				Check:  testAccCheckKeycloakRealmEventsExists("keycloak_realm_events.realm_events"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmEvents_eventsListenersOrder(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_events.realm_events"
+
+	config := func(eventsListeners ...string) string {
+		return testKeycloakRealmEvents_basicFromInterface(realmName, &keycloak.RealmEventsConfig{
+			EnabledEventTypes: []string{},
+			EventsListeners:   eventsListeners,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config("jboss-logging", "email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "events_listeners.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "events_listeners.0", "jboss-logging"),
+					resource.TestCheckResourceAttr(resourceName, "events_listeners.1", "email"),
+				),
+			},
+			{
+				Config: config("email", "jboss-logging"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "events_listeners.0", "email"),
+					resource.TestCheckResourceAttr(resourceName, "events_listeners.1", "jboss-logging"),
+				),
+			},
+			{
+				Config:      config("email", "email"),
+				ExpectError: regexp.MustCompile(`validation error: events listener "email" is listed more than once`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmEvents_import(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmEvents_basicFromInterface(realmName, &keycloak.RealmEventsConfig{
+					AdminEventsDetailsEnabled: true,
+					AdminEventsEnabled:        true,
+					EnabledEventTypes:         []string{"LOGIN", "LOGOUT"},
+					EventsEnabled:             true,
+					EventsExpiration:          3600,
+					EventsListeners:           []string{"jboss-logging"},
+				}),
+				Check: testAccCheckKeycloakRealmEventsExists("keycloak_realm_events.realm_events"),
+			},
+			{
+				ResourceName:      "keycloak_realm_events.realm_events",
+				ImportState:       true,
+				ImportStateId:     realmName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealmEvents_destroy(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 
@@ -264,6 +350,22 @@ events_listeners = [
 	`, realm)
 }
 
+func testKeycloakRealmEvents_eventsListener(realm, eventsListener string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_events" "realm_events" {
+	realm_id = keycloak_realm.realm.id
+
+	events_listeners = [
+		"%s",
+	]
+}
+	`, realm, eventsListener)
+}
+
 func testKeycloakRealmEvents_realmOnly(realm string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -103,6 +103,12 @@ func resourceKeycloakRealmKeystoreAesGeneratedCreate(ctx context.Context, data *
 		return diag.FromErr(err)
 	}
 
+	err = keycloakClient.ValidateRealmKeystoreKey(ctx, realmKey.RealmId, realmKey.Id, "enc")
+	if err != nil {
+		_ = keycloakClient.DeleteRealmKeystoreAesGenerated(ctx, realmKey.RealmId, realmKey.Id)
+		return diag.FromErr(err)
+	}
+
 	err = setRealmKeystoreAesGeneratedData(data, realmKey)
 	if err != nil {
 		return diag.FromErr(err)
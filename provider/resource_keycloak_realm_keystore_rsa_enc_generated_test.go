@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestAccKeycloakRealmKeystoreRsaEncGenerated_basic(t *testing.T) {
+	t.Parallel()
+
+	rsaName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaEncGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsaEncGenerated_basic(rsaName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealmKeystoreRsaEncGeneratedExists("keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc"),
+					testAccCheckRealmKeystoreRsaEncGeneratedKeyUse("keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getRealmKeystoreGenericImportId("keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsaEncGenerated_algorithmValidation(t *testing.T) {
+	t.Parallel()
+
+	algorithm := randomStringInSlice(keycloakRealmKeystoreRsaEncAlgorithm)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaEncGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmKeystoreRsaEncGenerated_basicWithAttrValidation(algorithm, "algorithm", "RS256"),
+				ExpectError: regexp.MustCompile("expected algorithm to be one of .+ got .+"),
+			},
+			{
+				Config: testKeycloakRealmKeystoreRsaEncGenerated_basicWithAttrValidation(algorithm, "algorithm", algorithm),
+				Check:  testAccCheckRealmKeystoreRsaEncGeneratedKeyUse("keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeystoreRsaEncGenerated_updateRsaEncKeystoreGenerated(t *testing.T) {
+	t.Parallel()
+
+	keystoreOne := &keycloak.RealmKeystoreRsaEncGenerated{
+		Name:      acctest.RandString(10),
+		RealmId:   testAccRealmUserFederation.Realm,
+		Priority:  acctest.RandIntRange(0, 100),
+		KeySize:   2048,
+		Algorithm: "RSA-OAEP",
+	}
+
+	keystoreTwo := &keycloak.RealmKeystoreRsaEncGenerated{
+		Name:      acctest.RandString(10),
+		RealmId:   testAccRealmUserFederation.Realm,
+		Priority:  acctest.RandIntRange(0, 100),
+		KeySize:   4096,
+		Algorithm: "RSA-OAEP-256",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckRealmKeystoreRsaEncGeneratedDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeystoreRsaEncGenerated_basicFromInterface(keystoreOne),
+				Check:  testAccCheckRealmKeystoreRsaEncGeneratedExists("keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc"),
+			},
+			{
+				Config: testKeycloakRealmKeystoreRsaEncGenerated_basicFromInterface(keystoreTwo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc", "key_size", "4096"),
+					resource.TestCheckResourceAttr("keycloak_realm_keystore_rsa_enc_generated.realm_rsa_enc", "algorithm", "RSA-OAEP-256"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRealmKeystoreRsaEncGeneratedExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakRealmKeystoreRsaEncGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRealmKeystoreRsaEncGeneratedKeyUse(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keystore, err := getKeycloakRealmKeystoreRsaEncGeneratedFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return keycloakClient.ValidateRealmKeystoreKey(testCtx, keystore.RealmId, keystore.Id, "enc")
+	}
+}
+
+func testAccCheckRealmKeystoreRsaEncGeneratedDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_keystore_rsa_enc_generated" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			keystore, _ := keycloakClient.GetRealmKeystoreRsaEncGenerated(testCtx, realm, id)
+			if keystore != nil {
+				return fmt.Errorf("rsa enc keystore with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakRealmKeystoreRsaEncGeneratedFromState(s *terraform.State, resourceName string) (*keycloak.RealmKeystoreRsaEncGenerated, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	realmKeystore, err := keycloakClient.GetRealmKeystoreRsaEncGenerated(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rsa enc keystore with id %s: %s", id, err)
+	}
+
+	return realmKeystore, nil
+}
+
+func testKeycloakRealmKeystoreRsaEncGenerated_basic(rsaName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa_enc_generated" "realm_rsa_enc" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	priority  = 100
+	algorithm = "RSA-OAEP"
+}
+	`, testAccRealmUserFederation.Realm, rsaName)
+}
+
+func testKeycloakRealmKeystoreRsaEncGenerated_basicWithAttrValidation(rsaName, attr, val string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa_enc_generated" "realm_rsa_enc" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	%s        = "%s"
+}
+	`, testAccRealmUserFederation.Realm, rsaName, attr, val)
+}
+
+func testKeycloakRealmKeystoreRsaEncGenerated_basicFromInterface(keystore *keycloak.RealmKeystoreRsaEncGenerated) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa_enc_generated" "realm_rsa_enc" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+
+	priority  = %s
+	algorithm = "%s"
+	key_size  = %s
+}
+	`, testAccRealmUserFederation.Realm, keystore.Name, strconv.Itoa(keystore.Priority), keystore.Algorithm,
+		strconv.Itoa(keystore.KeySize))
+}
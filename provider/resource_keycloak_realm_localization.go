@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmLocalization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmLocalizationCreate,
+		ReadContext:   resourceKeycloakRealmLocalizationRead,
+		UpdateContext: resourceKeycloakRealmLocalizationUpdate,
+		DeleteContext: resourceKeycloakRealmLocalizationDelete,
+		Importer: &schema.ResourceImporter{
+			// This resource can be imported using {{realm}}/{{locale}}.
+			StateContext: resourceKeycloakRealmLocalizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"texts": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "The texts of the locale overridden by the realm, by message key. Overrides which aren't listed are removed.",
+			},
+		},
+	}
+}
+
+func getRealmLocalizationTextsFromData(data *schema.ResourceData) map[string]string {
+	texts := make(map[string]string)
+	for key, value := range data.Get("texts").(map[string]interface{}) {
+		texts[key] = value.(string)
+	}
+
+	return texts
+}
+
+// setRealmLocalizationTexts replaces all overrides of the locale with the texts, which Keycloak doesn't support in a single request.
+func setRealmLocalizationTexts(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, locale string, texts map[string]string) error {
+	err := keycloakClient.ValidateRealmLocalization(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	err = keycloakClient.UpsertRealmLocalizationTexts(ctx, realmId, locale, texts)
+	if err != nil {
+		return err
+	}
+
+	existingTexts, err := keycloakClient.GetRealmLocalizationTexts(ctx, realmId, locale)
+	if err != nil {
+		return err
+	}
+
+	for key := range existingTexts {
+		if _, ok := texts[key]; ok {
+			continue
+		}
+
+		err = keycloakClient.DeleteRealmLocalizationText(ctx, realmId, locale, key)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	locale := data.Get("locale").(string)
+
+	err := setRealmLocalizationTexts(ctx, keycloakClient, realmId, locale, getRealmLocalizationTextsFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, locale))
+
+	return resourceKeycloakRealmLocalizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmLocalizationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	texts, err := keycloakClient.GetRealmLocalizationTexts(ctx, data.Get("realm_id").(string), data.Get("locale").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("texts", texts)
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := setRealmLocalizationTexts(ctx, keycloakClient, data.Get("realm_id").(string), data.Get("locale").(string), getRealmLocalizationTextsFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmLocalizationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmLocalizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DeleteRealmLocalizationTexts(ctx, data.Get("realm_id").(string), data.Get("locale").(string))
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid import. Supported import formats: {{realmId}}/{{locale}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("locale", parts[1])
+
+	diagnostics := resourceKeycloakRealmLocalizationRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
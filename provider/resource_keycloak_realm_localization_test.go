@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmLocalization_basic(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_localization.localization"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmLocalizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, `
+		loginTitle      = "Sign in to Example"
+		emailVerifyBody = "Please verify your email"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLocalizationTexts(resourceName, map[string]string{
+						"loginTitle":      "Sign in to Example",
+						"emailVerifyBody": "Please verify your email",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "texts.%", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmLocalization_basic(realmName, `
+		loginTitle = "Welcome to Example"
+`),
+				Check: testAccCheckKeycloakRealmLocalizationTexts(resourceName, map[string]string{
+					"loginTitle": "Welcome to Example",
+				}),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmLocalization_internationalizationDisabled(t *testing.T) {
+	t.Parallel()
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmLocalizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmLocalization_internationalizationDisabled(realmName),
+				ExpectError: regexp.MustCompile("internationalization is not enabled in realm " + realmName),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmLocalizationTexts(resourceName string, expectedTexts map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm_id"]
+		locale := rs.Primary.Attributes["locale"]
+
+		texts, err := keycloakClient.GetRealmLocalizationTexts(testCtx, realm, locale)
+		if err != nil {
+			return fmt.Errorf("error getting localization texts of locale %s: %s", locale, err)
+		}
+
+		if len(texts) != len(expectedTexts) {
+			return fmt.Errorf("expected locale %s to have %d texts, got %v", locale, len(expectedTexts), texts)
+		}
+
+		for key, value := range expectedTexts {
+			if texts[key] != value {
+				return fmt.Errorf("expected text %s of locale %s to be %s, got %s", key, locale, value, texts[key])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmLocalizationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_localization" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm_id"]
+			locale := rs.Primary.Attributes["locale"]
+
+			texts, _ := keycloakClient.GetRealmLocalizationTexts(testCtx, realm, locale)
+			if len(texts) != 0 {
+				return fmt.Errorf("localization texts of locale %s still exist", locale)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmLocalization_basic(realm, texts string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	internationalization {
+		supported_locales = ["en", "de"]
+		default_locale    = "en"
+	}
+}
+
+resource "keycloak_realm_localization" "localization" {
+	realm_id = keycloak_realm.realm.id
+	locale   = "de"
+
+	texts = {
+%s
+	}
+}
+	`, realm, texts)
+}
+
+func testKeycloakRealmLocalization_internationalizationDisabled(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_localization" "localization" {
+	realm_id = keycloak_realm.realm.id
+	locale   = "de"
+
+	texts = {
+		loginTitle = "Sign in to Example"
+	}
+}
+	`, realm)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -152,6 +153,41 @@ func TestAccKeycloakRealm_SmtpServerUpdate(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_SmtpServerTokenAuth(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_1); !ok {
+		t.Skip()
+	}
+
+	realm := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_WithSmtpServerTokenAuth(realm, "secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSmtpTokenAuth("keycloak_realm.realm", "https://login.example.com/token", "smtp-client"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "smtp_server.0.auth.0.auth_type", "token"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "smtp_server.0.auth.0.auth_token_client_secret", "secret"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_WithSmtpServerTokenAuth(realm, "other-secret"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm.realm", "smtp_server.0.auth.0.auth_token_client_secret", "other-secret"),
+			},
+			{
+				Config: testKeycloakRealm_WithSmtpServer(realm, "myhost.com", "My Host", "user"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSmtpTokenAuth("keycloak_realm.realm", "", ""),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "smtp_server.0.auth.0.auth_type", "basic"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_SmtpServerInvalid(t *testing.T) {
 	realm := acctest.RandomWithPrefix("tf-acc")
 
@@ -168,6 +204,10 @@ func TestAccKeycloakRealm_SmtpServerInvalid(t *testing.T) {
 				Config:      testKeycloakRealm_WithSmtpServerWithoutFrom(realm, "myhost.com"),
 				ExpectError: regexp.MustCompile("The argument \"from\" is required, but no definition was found."),
 			},
+			{
+				Config:      testKeycloakRealm_WithSmtpServerWithoutPassword(realm),
+				ExpectError: regexp.MustCompile("the password of the smtp_server auth is required when auth_type is basic"),
+			},
 		},
 	})
 }
@@ -252,6 +292,11 @@ func TestAccKeycloakRealm_InternationalizationValidation(t *testing.T) {
 				Config:      testKeycloakRealm_internationalizationValidation(realm, "en", "de"),
 				ExpectError: regexp.MustCompile("validation error: DefaultLocale should be in the SupportLocales"),
 			},
+			{
+				Config:      testKeycloakRealm_internationalizationValidation(realm, "en", "de"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"de" is not one of \[.*\]`),
+			},
 		},
 	})
 }
@@ -273,6 +318,11 @@ func TestAccKeycloakRealm_Internationalization(t *testing.T) {
 				Config: testKeycloakRealm_internationalizationValidation(realm, "es", "es"),
 				Check:  testAccCheckKeycloakRealmInternationalizationIsEnabled("keycloak_realm.realm", "es"),
 			},
+			{
+				// the supported locales are a set, so their order doesn't matter
+				Config:   testKeycloakRealm_internationalizationLocales(realm, `["fr", "es", "nl"]`, "es"),
+				PlanOnly: true,
+			},
 			{
 				Config: testKeycloakRealm_basic(realm, realm, realmDisplayNameHtml),
 				Check:  testAccCheckKeycloakRealmInternationalizationIsDisabled("keycloak_realm.realm"),
@@ -571,6 +621,101 @@ func TestAccKeycloakRealm_securityDefensesBruteForceDetection(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_securityDefensesBruteForceDetectionPermanentLockout(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_securityDefensesBruteForceDetectionPermanentLockout(realmName, realmDisplayName, 60),
+				Check:  testAccCheckKeycloakRealmSecurityDefensesBruteForceDetection("keycloak_realm.realm", true),
+			},
+			// the wait increment isn't used with permanent lockouts, so changing it doesn't produce a diff
+			{
+				Config:   testKeycloakRealm_securityDefensesBruteForceDetectionPermanentLockout(realmName, realmDisplayName, 120),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockouts(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockouts(realmName, realmDisplayName, 3, "LINEAR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionTemporaryLockouts("keycloak_realm.realm", 3, "LINEAR"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "security_defenses.0.brute_force_detection.0.max_temporary_lockouts", "3"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_securityDefensesBruteForceDetection(realmName, realmDisplayName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionTemporaryLockouts("keycloak_realm.realm", 0, "MULTIPLE"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "security_defenses.0.brute_force_detection.0.brute_force_strategy", "MULTIPLE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockoutsWaitIncrement(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_24); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockoutsWaitIncrement(realmName, realmDisplayName, 60),
+				Check:  testAccCheckKeycloakRealmSecurityDefensesBruteForceDetection("keycloak_realm.realm", true),
+			},
+			// the wait increment is used for the temporary lockouts before the permanent one, so changing it produces a diff
+			{
+				Config:             testKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockoutsWaitIncrement(realmName, realmDisplayName, 120),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockoutsWaitIncrement(realmName, realmDisplayName, 120),
+				Check: func(s *terraform.State) error {
+					realm, err := getRealmFromState(s, "keycloak_realm.realm")
+					if err != nil {
+						return err
+					}
+
+					if realm.WaitIncrementSeconds != 120 {
+						return fmt.Errorf("expected realm %s to have WaitIncrementSeconds set to 120, but was %d", realm.Realm, realm.WaitIncrementSeconds)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_securityDefenses(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
@@ -640,6 +785,7 @@ func TestAccKeycloakRealm_passwordPolicy(t *testing.T) {
 	passwordPolicyStringValid1 := "upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername"
 	passwordPolicyStringValid2 := "upperCase(1) and length(8)"
 	passwordPolicyStringValid3 := "lowerCase(2)"
+	passwordPolicyStringReordered := "notUsername(undefined) and forceExpiredPasswordChange(365) and length(8) and upperCase(1)"
 
 	resource.Test(t, resource.TestCase{
 		ProviderFactories: testAccProviderFactories,
@@ -654,6 +800,10 @@ func TestAccKeycloakRealm_passwordPolicy(t *testing.T) {
 				Config: testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, passwordPolicyStringValid1),
 				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", passwordPolicyStringValid1),
 			},
+			{
+				Config:   testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, passwordPolicyStringReordered),
+				PlanOnly: true,
+			},
 			{
 				Config: testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, passwordPolicyStringValid2),
 				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", passwordPolicyStringValid2),
@@ -721,6 +871,123 @@ func TestAccKeycloakRealm_customAttribute(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_organizationsEnabled(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_organizationsEnabled(realmName, true),
+				Check:  testAccCheckKeycloakRealmOrganizationsEnabled("keycloak_realm.realm", true),
+			},
+			{
+				Config: testKeycloakRealm_organizationsEnabled(realmName, false),
+				Check:  testAccCheckKeycloakRealmOrganizationsEnabled("keycloak_realm.realm", false),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_acrLoaMap(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_acrLoaMap(realmName, `{ silver = 1, gold = 2 }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAcrLoaMap("keycloak_realm.realm", `{"gold":2,"silver":1}`),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "acr_loa_map.gold", "2"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_acrLoaMap(realmName, `{}`),
+				Check:  testAccCheckKeycloakRealmAcrLoaMap("keycloak_realm.realm", `{}`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_darkMode(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_darkMode(realmName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAttribute("keycloak_realm.realm", "darkMode", "false"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "dark_mode", "false"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_darkMode(realmName, true),
+				Check:  testAccCheckKeycloakRealmAttribute("keycloak_realm.realm", "darkMode", "true"),
+			},
+			{
+				Config:      testKeycloakRealm_darkModeAndAttribute(realmName),
+				ExpectError: regexp.MustCompile(`"dark_mode" and attributes "darkMode" can't be set at the same time`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_parRequestUriLifespan(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_parRequestUriLifespan(realmName, "90s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAttribute("keycloak_realm.realm", "parRequestUriLifespan", "90"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "par_request_uri_lifespan", "90s"),
+				),
+			},
+			{
+				Config:   testKeycloakRealm_parRequestUriLifespan(realmName, "1m30s"),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakRealm_parRequestUriLifespan(realmName, "5m"),
+				Check:  testAccCheckKeycloakRealmAttribute("keycloak_realm.realm", "parRequestUriLifespan", "300"),
+			},
+			{
+				Config:      testKeycloakRealm_parRequestUriLifespan(realmName, "5 minutes"),
+				ExpectError: regexp.MustCompile("expected par_request_uri_lifespan to be a duration"),
+			},
+			// removing the argument resets the lifespan to the default of Keycloak
+			{
+				Config: testKeycloakRealm_withoutParRequestUriLifespan(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmAttribute("keycloak_realm.realm", "parRequestUriLifespan", ""),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "par_request_uri_lifespan", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_passwordPolicyInvalid(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
@@ -971,6 +1238,38 @@ func TestAccKeycloakRealm_webauthn(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_webauthnAcceptableAaguids(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	aaguids := []string{"fbfc3007-154e-4ecc-8c0b-6e020557d7bd", "08987058-cadc-4b81-b6e1-30de50dcbe96", "cb69481e-8ff7-4039-93ec-0a2729a154a8"}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_webauthnAcceptableAaguids(realmName, aaguids, []string{"RS256", "ES256"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmExists("keycloak_realm.realm"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "web_authn_policy.0.acceptable_aaguids.#", "3"),
+					resource.TestCheckTypeSetElemAttr("keycloak_realm.realm", "web_authn_policy.0.acceptable_aaguids.*", aaguids[1]),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "web_authn_passwordless_policy.0.acceptable_aaguids.#", "3"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "web_authn_passwordless_policy.0.signature_algorithms.#", "2"),
+				),
+			},
+			// the order of the lists isn't significant, so listing them in another order must not produce a diff
+			{
+				Config:   testKeycloakRealm_webauthnAcceptableAaguids(realmName, []string{aaguids[2], aaguids[0], aaguids[1]}, []string{"ES256", "RS256"}),
+				PlanOnly: true,
+			},
+			{
+				Config:      testKeycloakRealm_webauthnAcceptableAaguids(realmName, []string{"not-an-aaguid"}, []string{"ES256"}),
+				ExpectError: regexp.MustCompile("to be a valid UUID, got not-an-aaguid"),
+			},
+		},
+	})
+}
+
 func testKeycloakRealmLoginInfo(resourceName string, realm *keycloak.Realm) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		realmFromState, err := getRealmFromState(s, resourceName)
@@ -1101,6 +1400,25 @@ func testAccCheckKeycloakRealmSmtp(resourceName, host, from, user string) resour
 	}
 }
 
+func testAccCheckKeycloakRealmSmtpTokenAuth(resourceName, tokenUrl, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.SmtpServer.AuthTokenUrl != tokenUrl {
+			return fmt.Errorf("expected realm %s to have smtp auth token url set to %s, but was %s", realm.Realm, tokenUrl, realm.SmtpServer.AuthTokenUrl)
+		}
+
+		if realm.SmtpServer.AuthTokenClientId != clientId {
+			return fmt.Errorf("expected realm %s to have smtp auth token client id set to %s, but was %s", realm.Realm, clientId, realm.SmtpServer.AuthTokenClientId)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRealmOTP(resourceName, otpType, algorithm string, period int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		realm, err := getRealmFromState(s, resourceName)
@@ -1156,6 +1474,14 @@ func testAccCheckKeycloakRealmInternationalizationIsDisabled(resourceName string
 		if realm.InternationalizationEnabled {
 			return fmt.Errorf("expected realm %s to have internationalization disabled but was enabled", realm.Realm)
 		}
+
+		if realm.DefaultLocale != "" {
+			return fmt.Errorf("expected realm %s to have no defaultLocale, but was %s", realm.Realm, realm.DefaultLocale)
+		}
+
+		if len(realm.SupportLocales) != 0 {
+			return fmt.Errorf("expected realm %s to have no supportedLocales, but was %s", realm.Realm, realm.SupportLocales)
+		}
 		return nil
 	}
 }
@@ -1248,6 +1574,25 @@ func testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionFailureFactor(r
 	}
 }
 
+func testAccCheckKeycloakRealmSecurityDefensesBruteForceDetectionTemporaryLockouts(resourceName string, maxTemporaryLockouts int, bruteForceStrategy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if realm.MaxTemporaryLockouts == nil || *realm.MaxTemporaryLockouts != maxTemporaryLockouts {
+			return fmt.Errorf("expected realm %s to have MaxTemporaryLockouts set to %d, but was %v", realm.Realm, maxTemporaryLockouts, realm.MaxTemporaryLockouts)
+		}
+
+		if realm.BruteForceStrategy != bruteForceStrategy {
+			return fmt.Errorf("expected realm %s to have BruteForceStrategy set to %s, but was %s", realm.Realm, bruteForceStrategy, realm.BruteForceStrategy)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRealmPasswordPolicy(resourceName, passwordPolicy string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		realm, err := getRealmFromState(s, resourceName)
@@ -1255,7 +1600,7 @@ func testAccCheckKeycloakRealmPasswordPolicy(resourceName, passwordPolicy string
 			return err
 		}
 
-		if realm.PasswordPolicy != passwordPolicy {
+		if keycloak.NormalizePasswordPolicy(realm.PasswordPolicy) != keycloak.NormalizePasswordPolicy(passwordPolicy) {
 			return fmt.Errorf("expected realm %s to have passwordPolicy %s, but was %s", realm.Realm, passwordPolicy, realm.PasswordPolicy)
 		}
 
@@ -1293,6 +1638,51 @@ func testAccCheckKeycloakRealmCustomAttribute(resourceName, key, value string) r
 	}
 }
 
+func testAccCheckKeycloakRealmOrganizationsEnabled(resourceName string, organizationsEnabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if actual := realm.OrganizationsEnabled != nil && *realm.OrganizationsEnabled; actual != organizationsEnabled {
+			return fmt.Errorf("expected realm %s to have organizations enabled set to %t but was %t", realm.Realm, organizationsEnabled, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmAcrLoaMap(resourceName, acrLoaMap string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if actual, _ := realm.Attributes["acr.loa.map"].(string); actual != acrLoaMap {
+			return fmt.Errorf("expected realm %s to have acr.loa.map %s but was %s", realm.Realm, acrLoaMap, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmAttribute(resourceName, attribute, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if actual, _ := realm.Attributes[attribute].(string); actual != value {
+			return fmt.Errorf("expected realm %s to have attribute %s with value %s but was %s", realm.Realm, attribute, value, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRealmWithInternalId(resourceName, id string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		realm, err := getRealmFromState(s, resourceName)
@@ -1344,6 +1734,47 @@ resource "keycloak_realm" "realm" {
 	`, realm, realm, host, from, user)
 }
 
+func testKeycloakRealm_WithSmtpServerTokenAuth(realm, clientSecret string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	smtp_server {
+		host = "smtp.example.com"
+		from = "tom@example.com"
+
+		auth {
+			username                 = "tom@example.com"
+			auth_type                = "token"
+			auth_token_url           = "https://login.example.com/token"
+			auth_token_scope         = "https://outlook.office365.com/.default"
+			auth_token_client_id     = "smtp-client"
+			auth_token_client_secret = "%s"
+		}
+	}
+}
+	`, realm, clientSecret)
+}
+
+func testKeycloakRealm_WithSmtpServerWithoutPassword(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	smtp_server {
+		host = "smtp.example.com"
+		from = "tom@example.com"
+
+		auth {
+			username = "tom"
+		}
+	}
+}
+	`, realm)
+}
+
 func testKeycloakRealm_WithOTP(realm, otpType, algorithm string, period int) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1448,6 +1879,20 @@ resource "keycloak_realm" "realm" {
 	`, realm, realm, supportedLocale, defaultLocale)
 }
 
+func testKeycloakRealm_internationalizationLocales(realm, supportedLocales, defaultLocale string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+	internationalization {
+		supported_locales = %s
+		default_locale    = "%s"
+	}
+}
+	`, realm, realm, supportedLocales, defaultLocale)
+}
+
 func testKeycloakRealm_internationalizationValidationWithoutSupportedLocales(realm, defaultLocale string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1625,6 +2070,59 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, maxLoginFailures)
 }
 
+func testKeycloakRealm_securityDefensesBruteForceDetectionPermanentLockout(realm, realmDisplayName string, waitIncrementSeconds int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+	security_defenses {
+		brute_force_detection {
+			permanent_lockout      = true
+			max_login_failures     = 5
+			wait_increment_seconds = %d
+		}
+	}
+}
+	`, realm, realmDisplayName, waitIncrementSeconds)
+}
+
+func testKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockouts(realm, realmDisplayName string, maxTemporaryLockouts int, bruteForceStrategy string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+	security_defenses {
+		brute_force_detection {
+			permanent_lockout      = true
+			max_login_failures     = 5
+			max_temporary_lockouts = %d
+			brute_force_strategy   = "%s"
+		}
+	}
+}
+	`, realm, realmDisplayName, maxTemporaryLockouts, bruteForceStrategy)
+}
+
+func testKeycloakRealm_securityDefensesBruteForceDetectionTemporaryLockoutsWaitIncrement(realm, realmDisplayName string, waitIncrementSeconds int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+	security_defenses {
+		brute_force_detection {
+			permanent_lockout      = true
+			max_login_failures     = 5
+			max_temporary_lockouts = 2
+			wait_increment_seconds = %d
+		}
+	}
+}
+	`, realm, realmDisplayName, waitIncrementSeconds)
+}
+
 func testKeycloakRealm_securityDefenses(realm, realmDisplayName, xFrameOptions string, maxLoginFailures int) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1690,6 +2188,68 @@ resource "keycloak_realm" "realm" {
 	`, realm, key, value)
 }
 
+func testKeycloakRealm_organizationsEnabled(realm string, organizationsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                 = "%s"
+	enabled               = true
+	organizations_enabled = %t
+}
+	`, realm, organizationsEnabled)
+}
+
+func testKeycloakRealm_acrLoaMap(realm, acrLoaMap string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm       = "%s"
+	enabled     = true
+	acr_loa_map = %s
+}
+	`, realm, acrLoaMap)
+}
+
+func testKeycloakRealm_darkMode(realm string, darkMode bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm     = "%s"
+	enabled   = true
+	dark_mode = %t
+}
+	`, realm, darkMode)
+}
+
+func testKeycloakRealm_darkModeAndAttribute(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm     = "%s"
+	enabled   = true
+	dark_mode = true
+	attributes = {
+		darkMode = "false"
+	}
+}
+	`, realm)
+}
+
+func testKeycloakRealm_parRequestUriLifespan(realm, parRequestUriLifespan string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                    = "%s"
+	enabled                  = true
+	par_request_uri_lifespan = "%s"
+}
+	`, realm, parRequestUriLifespan)
+}
+
+func testKeycloakRealm_withoutParRequestUriLifespan(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+}
+	`, realm)
+}
+
 func testKeycloakRealm_webauthn_policy(realm, realmDisplayName, realmDisplayNameHtml, rpName, rpId, attestationConveyancePreference, authenticatorAttachment, requireResidentKey, userVerificationRequirement string, signatureAlgorithms []string, avoidSameAuthenticatorRegister bool) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1736,6 +2296,25 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, realmDisplayNameHtml, rpName, rpId, arrayOfStringsForTerraformResource(signatureAlgorithms), attestationConveyancePreference, authenticatorAttachment, avoidSameAuthenticatorRegister, requireResidentKey, userVerificationRequirement)
 }
 
+func testKeycloakRealm_webauthnAcceptableAaguids(realm string, aaguids, signatureAlgorithms []string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	web_authn_policy {
+		acceptable_aaguids   = %s
+		signature_algorithms = %s
+	}
+
+	web_authn_passwordless_policy {
+		acceptable_aaguids   = %s
+		signature_algorithms = %s
+	}
+}
+	`, realm, arrayOfStringsForTerraformResource(aaguids), arrayOfStringsForTerraformResource(signatureAlgorithms), arrayOfStringsForTerraformResource(aaguids), arrayOfStringsForTerraformResource(signatureAlgorithms))
+}
+
 func testKeycloakRealm_basicInternalId(realm, internalId string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -1746,3 +2325,85 @@ resource "keycloak_realm" "realm" {
 }
 	`, realm, internalId)
 }
+
+func TestAccKeycloakRealm_passwordResetWarnings(t *testing.T) {
+	t.Parallel()
+
+	realm := testAccCreatePasswordResetRealm(t, false)
+	testAccCheckRealmPasswordResetWarnings(t, realm)
+
+	realm.ResetPasswordAllowed = true
+	testAccCheckRealmPasswordResetWarnings(t, realm, "without an SMTP server")
+
+	realm.SmtpServer.Host = "smtp.example.com"
+	testAccCheckRealmPasswordResetWarnings(t, realm)
+}
+
+func TestAccKeycloakRealm_passwordResetWarningsDisabledFlowAndRequiredAction(t *testing.T) {
+	t.Parallel()
+
+	realm := testAccCreatePasswordResetRealm(t, true)
+
+	flow := &keycloak.AuthenticationFlow{
+		RealmId:    realm.Realm,
+		Alias:      "empty-reset-credentials",
+		ProviderId: "basic-flow",
+	}
+	if err := keycloakClient.NewAuthenticationFlow(testCtx, flow); err != nil {
+		t.Fatal(err)
+	}
+	realm.ResetCredentialsFlow = &flow.Alias
+
+	updatePassword, err := keycloakClient.GetRequiredAction(testCtx, realm.Realm, "UPDATE_PASSWORD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updatePassword.Enabled = false
+	if err := keycloakClient.UpdateRequiredAction(testCtx, updatePassword); err != nil {
+		t.Fatal(err)
+	}
+
+	testAccCheckRealmPasswordResetWarnings(t, realm, "has no enabled executions", "UPDATE_PASSWORD required action is disabled")
+}
+
+// realms allowing password resets get an SMTP server, so only the warnings about their flow and required actions remain
+func testAccCreatePasswordResetRealm(t *testing.T, allowPasswordReset bool) *keycloak.Realm {
+	realm := &keycloak.Realm{
+		Realm:   acctest.RandomWithPrefix("tf-acc"),
+		Enabled: true,
+	}
+	if allowPasswordReset {
+		realm.ResetPasswordAllowed = true
+		realm.SmtpServer.Host = "smtp.example.com"
+		realm.SmtpServer.From = "keycloak@example.com"
+	}
+
+	if err := keycloakClient.NewRealm(testCtx, realm); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		keycloakClient.DeleteRealm(testCtx, realm.Realm)
+	})
+
+	return realm
+}
+
+// checks that getRealmPasswordResetWarnings returns exactly one warning containing each of the expected summaries
+func testAccCheckRealmPasswordResetWarnings(t *testing.T, realm *keycloak.Realm, expectedSummaries ...string) {
+	t.Helper()
+
+	diags := getRealmPasswordResetWarnings(testCtx, keycloakClient, realm)
+	if diags.HasError() {
+		t.Fatalf("expected only warnings, got %v", diags)
+	}
+
+	if len(diags) != len(expectedSummaries) {
+		t.Fatalf("expected %d warnings, got %d: %v", len(expectedSummaries), len(diags), diags)
+	}
+
+	for i, expectedSummary := range expectedSummaries {
+		if !strings.Contains(diags[i].Summary, expectedSummary) {
+			t.Fatalf("expected warning %d to contain %q, got %q", i, expectedSummary, diags[i].Summary)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// realmTokenSettingsDurations maps the duration attributes of keycloak_realm_token_settings to the realm fields they manage.
+// durations are represented the same way as on keycloak_realm, as Go duration strings.
+var realmTokenSettingsDurations = map[string]func(realm *keycloak.Realm) *int{
+	"sso_session_idle_timeout":                 func(realm *keycloak.Realm) *int { return &realm.SsoSessionIdleTimeout },
+	"sso_session_max_lifespan":                 func(realm *keycloak.Realm) *int { return &realm.SsoSessionMaxLifespan },
+	"sso_session_idle_timeout_remember_me":     func(realm *keycloak.Realm) *int { return &realm.SsoSessionIdleTimeoutRememberMe },
+	"sso_session_max_lifespan_remember_me":     func(realm *keycloak.Realm) *int { return &realm.SsoSessionMaxLifespanRememberMe },
+	"offline_session_idle_timeout":             func(realm *keycloak.Realm) *int { return &realm.OfflineSessionIdleTimeout },
+	"offline_session_max_lifespan":             func(realm *keycloak.Realm) *int { return &realm.OfflineSessionMaxLifespan },
+	"client_session_idle_timeout":              func(realm *keycloak.Realm) *int { return &realm.ClientSessionIdleTimeout },
+	"client_session_max_lifespan":              func(realm *keycloak.Realm) *int { return &realm.ClientSessionMaxLifespan },
+	"access_token_lifespan":                    func(realm *keycloak.Realm) *int { return &realm.AccessTokenLifespan },
+	"access_token_lifespan_for_implicit_flow":  func(realm *keycloak.Realm) *int { return &realm.AccessTokenLifespanForImplicitFlow },
+	"access_code_lifespan":                     func(realm *keycloak.Realm) *int { return &realm.AccessCodeLifespan },
+	"access_code_lifespan_login":               func(realm *keycloak.Realm) *int { return &realm.AccessCodeLifespanLogin },
+	"access_code_lifespan_user_action":         func(realm *keycloak.Realm) *int { return &realm.AccessCodeLifespanUserAction },
+	"action_token_generated_by_user_lifespan":  func(realm *keycloak.Realm) *int { return &realm.ActionTokenGeneratedByUserLifespan },
+	"action_token_generated_by_admin_lifespan": func(realm *keycloak.Realm) *int { return &realm.ActionTokenGeneratedByAdminLifespan },
+	"oauth2_device_code_lifespan":              func(realm *keycloak.Realm) *int { return &realm.Oauth2DeviceCodeLifespan },
+}
+
+func resourceKeycloakRealmTokenSettings() *schema.Resource {
+	tokenSettingsSchema := map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"default_signature_algorithm": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"revoke_refresh_token": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"refresh_token_max_reuse": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"offline_session_max_lifespan_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"oauth2_device_polling_interval": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	for attribute := range realmTokenSettingsDurations {
+		tokenSettingsSchema[attribute] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressDurationStringDiff,
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmTokenSettingsCreate,
+		ReadContext:   resourceKeycloakRealmTokenSettingsRead,
+		DeleteContext: resourceKeycloakRealmTokenSettingsDelete,
+		UpdateContext: resourceKeycloakRealmTokenSettingsUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmTokenSettingsImport,
+		},
+		Schema: tokenSettingsSchema,
+	}
+}
+
+// setRealmTokenSettings only touches the realm fields managed by this resource. attributes that are neither
+// configured nor in state yet are left as they are on the realm.
+func setRealmTokenSettings(data *schema.ResourceData, realm *keycloak.Realm) error {
+	if v, ok := data.GetOk("default_signature_algorithm"); ok {
+		realm.DefaultSignatureAlgorithm = stringPointer(v.(string))
+	}
+	if v, ok := data.GetOkExists("revoke_refresh_token"); ok {
+		realm.RevokeRefreshToken = boolPointer(v.(bool))
+	}
+	if v, ok := data.GetOkExists("refresh_token_max_reuse"); ok {
+		realm.RefreshTokenMaxReuse = intPointer(v.(int))
+	}
+	if v, ok := data.GetOkExists("offline_session_max_lifespan_enabled"); ok {
+		realm.OfflineSessionMaxLifespanEnabled = boolPointer(v.(bool))
+	}
+	if v, ok := data.GetOk("oauth2_device_polling_interval"); ok {
+		realm.Oauth2DevicePollingInterval = v.(int)
+	}
+
+	for attribute, field := range realmTokenSettingsDurations {
+		if duration := data.Get(attribute).(string); duration != "" {
+			seconds, err := getSecondsFromDurationString(duration)
+			if err != nil {
+				return err
+			}
+			*field(realm) = seconds
+		}
+	}
+
+	return nil
+}
+
+func setRealmTokenSettingsData(data *schema.ResourceData, realm *keycloak.Realm) {
+	data.SetId(realm.Realm)
+
+	data.Set("realm_id", realm.Realm)
+	if realm.DefaultSignatureAlgorithm != nil {
+		data.Set("default_signature_algorithm", *realm.DefaultSignatureAlgorithm)
+	}
+	if realm.RevokeRefreshToken != nil {
+		data.Set("revoke_refresh_token", *realm.RevokeRefreshToken)
+	}
+	if realm.RefreshTokenMaxReuse != nil {
+		data.Set("refresh_token_max_reuse", *realm.RefreshTokenMaxReuse)
+	}
+	if realm.OfflineSessionMaxLifespanEnabled != nil {
+		data.Set("offline_session_max_lifespan_enabled", *realm.OfflineSessionMaxLifespanEnabled)
+	}
+	data.Set("oauth2_device_polling_interval", realm.Oauth2DevicePollingInterval)
+
+	for attribute, field := range realmTokenSettingsDurations {
+		data.Set(attribute, getDurationStringFromSeconds(*field(realm)))
+	}
+}
+
+func resourceKeycloakRealmTokenSettingsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setRealmTokenSettings(data, realm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateRealm(ctx, realm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realm.Realm)
+
+	return resourceKeycloakRealmTokenSettingsRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmTokenSettingsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmTokenSettingsData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmTokenSettingsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setRealmTokenSettings(data, realm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateRealm(ctx, realm)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmTokenSettingsRead(ctx, data, meta)
+}
+
+// the token settings are part of the realm, so there is nothing to delete. the realm keeps its last settings.
+func resourceKeycloakRealmTokenSettingsDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceKeycloakRealmTokenSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	setRealmTokenSettingsData(d, realm)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakRealmTokenSettings_basic(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "token settings", "10m", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmTokenSettings(realmName, "token settings", 600, true),
+					resource.TestCheckResourceAttr("keycloak_realm_token_settings.token_settings", "access_token_lifespan", "10m0s"),
+					resource.TestCheckResourceAttr("keycloak_realm_token_settings.token_settings", "sso_session_idle_timeout", "1h0m0s"),
+				),
+			},
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "token settings", "5m", false),
+				Check:  testAccCheckKeycloakRealmTokenSettings(realmName, "token settings", 300, false),
+			},
+			{
+				ResourceName:      "keycloak_realm_token_settings.token_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName,
+			},
+		},
+	})
+}
+
+// keycloak_realm leaves the token settings it doesn't configure alone, so both resources can manage the same realm
+func TestAccKeycloakRealmTokenSettings_realmUpdate(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "token settings", "10m", true),
+				Check:  testAccCheckKeycloakRealmTokenSettings(realmName, "token settings", 600, true),
+			},
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "updated token settings", "10m", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmTokenSettings(realmName, "updated token settings", 600, true),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "revoke_refresh_token", "true"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "access_token_lifespan", "10m0s"),
+				),
+			},
+			{
+				Config:   testKeycloakRealmTokenSettings_basic(realmName, "updated token settings", "10m", true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// the realm's unrelated settings and the token settings not configured on keycloak_realm_token_settings should survive updates
+func testAccCheckKeycloakRealmTokenSettings(realmName, displayName string, accessTokenLifespan int, revokeRefreshToken bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := keycloakClient.GetRealm(testCtx, realmName)
+		if err != nil {
+			return err
+		}
+
+		if realm.AccessTokenLifespan != accessTokenLifespan {
+			return fmt.Errorf("expected access token lifespan to be %d, got %d", accessTokenLifespan, realm.AccessTokenLifespan)
+		}
+		if realm.RevokeRefreshToken == nil || *realm.RevokeRefreshToken != revokeRefreshToken {
+			return fmt.Errorf("expected revoke refresh token to be %t, got %v", revokeRefreshToken, realm.RevokeRefreshToken)
+		}
+		if realm.SsoSessionIdleTimeout != 3600 {
+			return fmt.Errorf("expected sso session idle timeout to be left at 3600, got %d", realm.SsoSessionIdleTimeout)
+		}
+		if realm.DisplayName != displayName {
+			return fmt.Errorf("expected display name to be left at %q, got %q", displayName, realm.DisplayName)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmTokenSettings_basic(realmName, displayName, accessTokenLifespan string, revokeRefreshToken bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                    = "%s"
+	enabled                  = true
+	display_name             = "%s"
+	sso_session_idle_timeout = "1h"
+}
+
+resource "keycloak_realm_token_settings" "token_settings" {
+	realm_id              = keycloak_realm.realm.id
+	access_token_lifespan = "%s"
+	revoke_refresh_token  = %t
+}
+	`, realmName, displayName, accessTokenLifespan, revokeRefreshToken)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,6 +22,9 @@ const (
 
 const USER_PROFILE_ENABLED string = "userProfileEnabled"
 
+// builtInRealmUserProfileAttributes always exist since Keycloak 23
+var builtInRealmUserProfileAttributes = []string{"username", "email"}
+
 func resourceKeycloakRealmUserProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKeycloakRealmUserProfileCreate,
@@ -191,9 +195,12 @@ func getRealmUserProfileAttributeFromData(m map[string]interface{}) *keycloak.Re
 		attribute.Permissions = &permissions
 	}
 
-	if v, ok := m["enabled_when_scope"]; ok && len(interfaceSliceToStringSlice(v.(*schema.Set).List())) != 0 {
-		attribute.Selector = &keycloak.RealmUserProfileSelector{
-			Scopes: interfaceSliceToStringSlice(v.(*schema.Set).List()),
+	if v, ok := m["enabled_when_scope"]; ok {
+		if scopes := interfaceSliceToStringSlice(v.(*schema.Set).List()); len(scopes) != 0 {
+			sort.Strings(scopes)
+			attribute.Selector = &keycloak.RealmUserProfileSelector{
+				Scopes: scopes,
+			}
 		}
 	}
 
@@ -233,9 +240,11 @@ func getRealmUserProfileAttributeFromData(m map[string]interface{}) *keycloak.Re
 
 	if v, ok := m["required_for_roles"]; ok {
 		required.Roles = interfaceSliceToStringSlice(v.(*schema.Set).List())
+		sort.Strings(required.Roles)
 	}
 	if v, ok := m["required_for_scopes"]; ok {
 		required.Scopes = interfaceSliceToStringSlice(v.(*schema.Set).List())
+		sort.Strings(required.Scopes)
 	}
 
 	if len(required.Roles) != 0 || len(required.Scopes) != 0 {
@@ -321,7 +330,28 @@ func getRealmUserProfileFromData(ctx context.Context, keycloakClient *keycloak.K
 	realmUserProfile.Attributes = getRealmUserProfileAttributesFromData(data.Get("attribute").([]interface{}))
 	realmUserProfile.Groups = getRealmUserProfileGroupsFromData(data.Get("group").(*schema.Set).List())
 
-	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_24)
+	attributeNames := make(map[string]bool, len(realmUserProfile.Attributes))
+	for _, attribute := range realmUserProfile.Attributes {
+		if attributeNames[attribute.Name] {
+			return nil, fmt.Errorf("validation error: attribute %s is defined more than once", attribute.Name)
+		}
+		attributeNames[attribute.Name] = true
+	}
+
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_23)
+	if err != nil {
+		return nil, err
+	}
+
+	if versionOk {
+		for _, name := range builtInRealmUserProfileAttributes {
+			if !attributeNames[name] {
+				return nil, fmt.Errorf("validation error: attribute %s always exists since Keycloak 23 and has to be defined", name)
+			}
+		}
+	}
+
+	versionOk, err = keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_24)
 	if err != nil {
 		return nil, err
 	}
@@ -343,6 +373,9 @@ func getRealmUserProfileAttributeData(attr *keycloak.RealmUserProfileAttribute)
 	attributeData["multi_valued"] = attr.MultiValued
 
 	attributeData["group"] = attr.Group
+
+	// always set the selector and required sets, so that removing them on the Keycloak side is detected as drift
+	attributeData["enabled_when_scope"] = make([]string, 0)
 	if attr.Selector != nil && len(attr.Selector.Scopes) != 0 {
 		attributeData["enabled_when_scope"] = attr.Selector.Scopes
 	}
@@ -350,8 +383,12 @@ func getRealmUserProfileAttributeData(attr *keycloak.RealmUserProfileAttribute)
 	attributeData["required_for_roles"] = make([]string, 0)
 	attributeData["required_for_scopes"] = make([]string, 0)
 	if attr.Required != nil {
-		attributeData["required_for_roles"] = attr.Required.Roles
-		attributeData["required_for_scopes"] = attr.Required.Scopes
+		if len(attr.Required.Roles) != 0 {
+			attributeData["required_for_roles"] = attr.Required.Roles
+		}
+		if len(attr.Required.Scopes) != 0 {
+			attributeData["required_for_scopes"] = attr.Required.Scopes
+		}
 	}
 
 	if attr.Permissions != nil {
@@ -515,10 +552,23 @@ func resourceKeycloakRealmUserProfileDelete(ctx context.Context, data *schema.Re
 	}
 
 	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_23); ok {
-		// since version 23 username and email are mandatory
-		// TODO validate if this overwrite doesn't cause any problems
-		realmUserProfile.Attributes = []*keycloak.RealmUserProfileAttribute{
-			{Name: "username"}, {Name: "email"},
+		// since version 23 username and email are mandatory, so their current definitions are kept, along with their
+		// validations and permissions
+		currentRealmUserProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, name := range builtInRealmUserProfileAttributes {
+			attribute := &keycloak.RealmUserProfileAttribute{Name: name}
+			for _, currentAttribute := range currentRealmUserProfile.Attributes {
+				if currentAttribute.Name == name {
+					attribute = currentAttribute
+					attribute.Group = ""
+					break
+				}
+			}
+			realmUserProfile.Attributes = append(realmUserProfile.Attributes, attribute)
 		}
 	}
 
@@ -549,12 +599,7 @@ func resourceKeycloakRealmUserProfileUpdate(ctx context.Context, data *schema.Re
 		return diag.FromErr(err)
 	}
 
-	err = setRealmUserProfileData(ctx, keycloakClient, data, realmUserProfile)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	return resourceKeycloakRealmUserProfileRead(ctx, data, meta)
 }
 
 func checkUserProfileEnabled(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string) error {
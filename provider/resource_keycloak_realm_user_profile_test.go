@@ -94,6 +94,38 @@ func TestAccKeycloakRealmUserProfile_basicEmpty(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealmUserProfile_builtInAttributes(t *testing.T) {
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_23); !ok {
+		t.Skip()
+	}
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	withoutEmail := &keycloak.RealmUserProfile{
+		Attributes: []*keycloak.RealmUserProfileAttribute{{Name: "username"}, {Name: "attribute1"}},
+	}
+
+	withDuplicate := &keycloak.RealmUserProfile{
+		Attributes: []*keycloak.RealmUserProfileAttribute{{Name: "username"}, {Name: "email"}, {Name: "attribute1"}, {Name: "attribute1"}},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmUserProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmUserProfile_template(realmName, withoutEmail),
+				ExpectError: regexp.MustCompile("attribute email always exists since Keycloak 23 and has to be defined"),
+			},
+			{
+				Config:      testKeycloakRealmUserProfile_template(realmName, withDuplicate),
+				ExpectError: regexp.MustCompile("attribute attribute1 is defined more than once"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealmUserProfile_basicFull(t *testing.T) {
 	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
 
@@ -209,6 +241,71 @@ func TestAccKeycloakRealmUserProfile_group(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealmUserProfile_attributeRequiredAndSelector(t *testing.T) {
+	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	withRequiredForScopes := &keycloak.RealmUserProfile{
+		Attributes: []*keycloak.RealmUserProfileAttribute{
+			{Name: "username"}, {Name: "email"}, // Version >=23 needs these
+			{
+				Name:     "attribute",
+				Selector: &keycloak.RealmUserProfileSelector{Scopes: []string{"offline_access", "roles"}},
+				Required: &keycloak.RealmUserProfileRequired{
+					Roles:  []string{"admin", "user"},
+					Scopes: []string{"offline_access", "roles"},
+				},
+			},
+		},
+	}
+
+	withRequiredForRoles := &keycloak.RealmUserProfile{
+		Attributes: []*keycloak.RealmUserProfileAttribute{
+			{Name: "username"}, {Name: "email"}, // Version >=23 needs these
+			{
+				Name: "attribute",
+				Required: &keycloak.RealmUserProfileRequired{
+					Roles: []string{"user"},
+				},
+			},
+		},
+	}
+
+	withoutRequired := &keycloak.RealmUserProfile{
+		Attributes: []*keycloak.RealmUserProfileAttribute{
+			{Name: "username"}, {Name: "email"}, // Version >=23 needs these
+			{Name: "attribute"},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakRealmUserProfileDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfile_template(realmName, withRequiredForScopes),
+				Check: testAccCheckKeycloakRealmUserProfileStateEqual(
+					"keycloak_realm_user_profile.realm_user_profile", withRequiredForScopes,
+				),
+			},
+			{
+				Config: testKeycloakRealmUserProfile_template(realmName, withRequiredForRoles),
+				Check: testAccCheckKeycloakRealmUserProfileStateEqual(
+					"keycloak_realm_user_profile.realm_user_profile", withRequiredForRoles,
+				),
+			},
+			{
+				Config: testKeycloakRealmUserProfile_template(realmName, withoutRequired),
+				Check: testAccCheckKeycloakRealmUserProfileStateEqual(
+					"keycloak_realm_user_profile.realm_user_profile", withoutRequired,
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealmUserProfile_attributeValidator(t *testing.T) {
 	skipIfVersionIsLessThanOrEqualTo(testCtx, t, keycloakClient, keycloak.Version_14)
 
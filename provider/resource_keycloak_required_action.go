@@ -10,6 +10,13 @@ import (
 	"strings"
 )
 
+// webAuthnRegisterRequiredActionAuthenticators maps the WebAuthn register required actions to the authenticator
+// which lets users log in with the credential they registered.
+var webAuthnRegisterRequiredActionAuthenticators = map[string]string{
+	"webauthn-register":              "webauthn-authenticator",
+	"webauthn-register-passwordless": "webauthn-authenticator-passwordless",
+}
+
 func resourceKeycloakRequiredAction() *schema.Resource {
 
 	return &schema.Resource{
@@ -87,6 +94,79 @@ func setRequiredActionData(data *schema.ResourceData, action *keycloak.RequiredA
 	data.Set("config", action.Config)
 }
 
+// getRequiredActionWebAuthnWarnings warns about an enabled WebAuthn register required action whose authenticator isn't
+// used by the realm's browser flow. Users are prompted to register a security key or passkey they can't log in with.
+func getRequiredActionWebAuthnWarnings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, action *keycloak.RequiredAction) diag.Diagnostics {
+	authenticator, ok := webAuthnRegisterRequiredActionAuthenticators[action.Alias]
+	if !ok || !action.Enabled {
+		return nil
+	}
+
+	realm, err := keycloakClient.GetRealm(ctx, action.RealmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if realm.BrowserFlow == nil || *realm.BrowserFlow == "" {
+		return nil
+	}
+
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, action.RealmId, *realm.BrowserFlow)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, execution := range executions {
+		if execution.ProviderId == authenticator && execution.Requirement != "DISABLED" {
+			return nil
+		}
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("browser flow %s of realm %s doesn't use the %s authenticator", *realm.BrowserFlow, action.RealmId, authenticator),
+			Detail:   fmt.Sprintf("Users are prompted to register credentials by the %s required action, but can't log in with them. Add a %s execution to the browser flow, or bind a flow which has one with browser_flow.", action.Alias, authenticator),
+		},
+	}
+}
+
+// setRequiredActionPriority moves the required action to its configured priority when Keycloak didn't apply it, which older
+// versions don't when updating a required action. Required actions without a configured priority keep the one Keycloak gave them.
+// When the configured priority can't be reached, the required action is moved as close as it can be and a warning is returned.
+func setRequiredActionPriority(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, action *keycloak.RequiredAction) diag.Diagnostics {
+	priority, ok := data.GetOk("priority")
+	if !ok {
+		return nil
+	}
+
+	current, err := keycloakClient.GetRequiredAction(ctx, action.RealmId, action.Alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if current.Priority == priority.(int) {
+		return nil
+	}
+
+	reached, err := keycloakClient.MoveRequiredActionToPriority(ctx, action.RealmId, action.Alias, priority.(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if reached != priority.(int) {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("required action %s of realm %s has priority %d instead of %d", action.Alias, action.RealmId, reached, priority.(int)),
+				Detail:   "Keycloak didn't apply the priority, and only reorders required actions by swapping their priorities with the ones of their neighbours. Use a priority which another required action of the realm already has.",
+			},
+		}
+	}
+
+	return nil
+}
+
 func resourceKeycloakRequiredActionsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -113,9 +193,21 @@ func resourceKeycloakRequiredActionsCreate(ctx context.Context, data *schema.Res
 		return diag.FromErr(err)
 	}
 
+	priorityDiags := setRequiredActionPriority(ctx, keycloakClient, data, action)
+	if priorityDiags.HasError() {
+		return priorityDiags
+	}
+
 	setRequiredActionData(data, action)
 
-	return resourceKeycloakRequiredActionsRead(ctx, data, meta)
+	diags := resourceKeycloakRequiredActionsRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, priorityDiags...)
+
+	return append(diags, getRequiredActionWebAuthnWarnings(ctx, keycloakClient, action)...)
 }
 
 func resourceKeycloakRequiredActionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -144,9 +236,19 @@ func resourceKeycloakRequiredActionsUpdate(ctx context.Context, data *schema.Res
 		return diag.FromErr(err)
 	}
 
-	setRequiredActionData(data, action)
+	priorityDiags := setRequiredActionPriority(ctx, keycloakClient, data, action)
+	if priorityDiags.HasError() {
+		return priorityDiags
+	}
 
-	return nil
+	diags := resourceKeycloakRequiredActionsRead(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, priorityDiags...)
+
+	return append(diags, getRequiredActionWebAuthnWarnings(ctx, keycloakClient, action)...)
 }
 
 func resourceKeycloakRequiredActionsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -133,6 +133,26 @@ func TestAccKeycloakRequiredAction_computedPriority(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRequiredAction_webAuthnPasswordless(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRequiredAction_webAuthnPasswordless(realmName, flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRequiredActionIsEnabledDefault(realmName, "webauthn-register-passwordless"),
+					testAccCheckKeycloakRequiredActionIsEnabledDefault(realmName, "webauthn-register"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "web_authn_passwordless_policy.0.user_verification_requirement", "required"),
+				),
+			},
+		},
+	})
+}
+
 func testKeycloakRequiredAction_basic(realm, requiredActionAlias string, priority int) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -221,6 +241,94 @@ resource "keycloak_required_action" "required_action2" {
 	`, realm, priority1, requiredActionAlias, priorityPlus)
 }
 
+func testKeycloakRequiredAction_webAuthnPasswordless(realm, flowAlias string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	web_authn_policy {
+		relying_party_entity_name = "example"
+	}
+
+	web_authn_passwordless_policy {
+		relying_party_entity_name     = "example"
+		require_resident_key          = "Yes"
+		user_verification_requirement = "required"
+	}
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "cookie" {
+	realm_id          = keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "auth-cookie"
+	requirement       = "ALTERNATIVE"
+}
+
+resource "keycloak_authentication_execution" "passwordless" {
+	realm_id          = keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "webauthn-authenticator-passwordless"
+	requirement       = "ALTERNATIVE"
+
+	depends_on = [keycloak_authentication_execution.cookie]
+}
+
+resource "keycloak_authentication_execution" "webauthn" {
+	realm_id          = keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "webauthn-authenticator"
+	requirement       = "ALTERNATIVE"
+
+	depends_on = [keycloak_authentication_execution.passwordless]
+}
+
+resource "keycloak_authentication_bindings" "bindings" {
+	realm_id     = keycloak_realm.realm.id
+	browser_flow = keycloak_authentication_flow.flow.alias
+
+	depends_on = [keycloak_authentication_execution.webauthn]
+}
+
+resource "keycloak_required_action" "webauthn_register_passwordless" {
+	realm_id       = keycloak_realm.realm.id
+	alias          = "webauthn-register-passwordless"
+	enabled        = true
+	default_action = true
+
+	depends_on = [keycloak_authentication_bindings.bindings]
+}
+
+resource "keycloak_required_action" "webauthn_register" {
+	realm_id       = keycloak_realm.realm.id
+	alias          = "webauthn-register"
+	enabled        = true
+	default_action = true
+
+	depends_on = [keycloak_authentication_bindings.bindings]
+}
+	`, realm, flowAlias)
+}
+
+func testAccCheckKeycloakRequiredActionIsEnabledDefault(realm, requiredActionAlias string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		action, err := keycloakClient.GetRequiredAction(testCtx, realm, requiredActionAlias)
+		if err != nil {
+			return fmt.Errorf("required action not found: %s", requiredActionAlias)
+		}
+
+		if !action.Enabled || !action.DefaultAction {
+			return fmt.Errorf("expected required action %s to be enabled and a default action, got enabled=%t default_action=%t", requiredActionAlias, action.Enabled, action.DefaultAction)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakRequiresActionExistsWithCorrectPriority(realm, requiredActionAlias string, priority int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		action, err := keycloakClient.GetRequiredAction(testCtx, realm, requiredActionAlias)
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// Keycloak stores the attributes of the mapper as a JSON array of key/value pairs, in the order they were given
+type samlAdvancedAttributeToRoleIdentityProviderMapperAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func resourceKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attribute": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "SAML attributes the assertion must have for the role to be granted. All of them must match.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name or friendly name of the SAML attribute.",
+					},
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Value the SAML attribute must have, a regular expression when attribute_values_regex is set.",
+					},
+				},
+			},
+		},
+		"attribute_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the values of the attributes are regular expressions.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role to grant, client roles are referenced as {client_id}.{role_name}.",
+		},
+		"sync_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "INHERIT",
+			ValidateFunc: validation.StringInSlice(keycloakIdentityProviderMapperSyncModes, false),
+			Description:  "Sync mode for the mapper.",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getSamlAdvancedAttributeToRoleIdentityProviderMapperFromData, setSamlAdvancedAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setSamlAdvancedAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getSamlAdvancedAttributeToRoleIdentityProviderMapperFromData, setSamlAdvancedAttributeToRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getSamlAdvancedAttributeToRoleIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rec, _ := getIdentityProviderMapperFromData(data)
+	identityProvider, err := keycloakClient.GetIdentityProvider(ctx, rec.Realm, rec.IdentityProviderAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	if identityProvider.ProviderId != "saml" {
+		return nil, fmt.Errorf(`provider.keycloak: keycloak_saml_advanced_attribute_to_role_identity_provider_mapper: %s: "%s" identity provider is not supported, use keycloak_custom_identity_provider_mapper instead`, data.Get("name").(string), identityProvider.ProviderId)
+	}
+
+	for _, key := range []string{"attributes", "are.attribute.values.regex", "syncMode"} {
+		if _, ok := rec.Config.ExtraConfig[key]; ok {
+			return nil, fmt.Errorf(`provider.keycloak: keycloak_saml_advanced_attribute_to_role_identity_provider_mapper: %s: extra_config "%s" can't be set, use the corresponding argument instead`, data.Get("name").(string), key)
+		}
+	}
+
+	attributes := make([]samlAdvancedAttributeToRoleIdentityProviderMapperAttribute, 0)
+	for _, attribute := range data.Get("attribute").([]interface{}) {
+		attributeMap := attribute.(map[string]interface{})
+		attributes = append(attributes, samlAdvancedAttributeToRoleIdentityProviderMapperAttribute{
+			Key:   attributeMap["name"].(string),
+			Value: attributeMap["value"].(string),
+		})
+	}
+
+	attributesJson, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "saml-advanced-role-idp-mapper"
+	rec.Config.Role = data.Get("role").(string)
+	rec.Config.ExtraConfig["attributes"] = string(attributesJson)
+	rec.Config.ExtraConfig["are.attribute.values.regex"] = strconv.FormatBool(data.Get("attribute_values_regex").(bool))
+	rec.Config.ExtraConfig["syncMode"] = data.Get("sync_mode").(string)
+
+	return rec, nil
+}
+
+func setSamlAdvancedAttributeToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	var attributes []samlAdvancedAttributeToRoleIdentityProviderMapperAttribute
+	if attributesJson, ok := identityProviderMapper.Config.ExtraConfig["attributes"].(string); ok && attributesJson != "" {
+		err := json.Unmarshal([]byte(attributesJson), &attributes)
+		if err != nil {
+			return fmt.Errorf("error parsing the attributes of identity provider mapper %s: %s", identityProviderMapper.Name, err)
+		}
+	}
+
+	var attributeList []interface{}
+	for _, attribute := range attributes {
+		attributeList = append(attributeList, map[string]interface{}{
+			"name":  attribute.Key,
+			"value": attribute.Value,
+		})
+	}
+	data.Set("attribute", attributeList)
+
+	// Keycloak leaves the value out when the checkbox was never ticked in the admin console
+	attributeValuesRegex, _ := strconv.ParseBool(fmt.Sprint(identityProviderMapper.Config.ExtraConfig["are.attribute.values.regex"]))
+	data.Set("attribute_values_regex", attributeValuesRegex)
+
+	if syncMode, ok := identityProviderMapper.Config.ExtraConfig["syncMode"].(string); ok {
+		data.Set("sync_mode", syncMode)
+	}
+
+	return nil
+}
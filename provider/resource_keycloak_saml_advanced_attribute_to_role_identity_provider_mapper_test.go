@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_saml_advanced_attribute_to_role_identity_provider_mapper.saml"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, mapperName, role, "IMPORT", false, `
+	attribute {
+		name  = "memberOf"
+		value = "admins"
+	}
+
+	attribute {
+		name  = "department"
+		value = "engineering"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, "attributes", `[{"key":"memberOf","value":"admins"},{"key":"department","value":"engineering"}]`),
+					testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, "are.attribute.values.regex", "false"),
+					testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, "syncMode", "IMPORT"),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attribute.0.name", "memberOf"),
+					resource.TestCheckResourceAttr(resourceName, "attribute.1.name", "department"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+			{
+				Config: testKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, mapperName, role, "FORCE", true, `
+	attribute {
+		name  = "memberOf"
+		value = "^cn=admins,.*$"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, "attributes", `[{"key":"memberOf","value":"^cn=admins,.*$"}]`),
+					testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, "are.attribute.values.regex", "true"),
+					testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, "syncMode", "FORCE"),
+				),
+			},
+			{
+				Config: testKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, mapperName, role, "INHERIT", true, `
+	attribute {
+		name  = "memberOf"
+		value = "^cn=admins,.*$"
+	}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, "syncMode", "INHERIT"),
+					resource.TestCheckResourceAttr(resourceName, "sync_mode", "INHERIT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper_extraConfigConflict(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, mapperName, role, "INHERIT", false, `
+	attribute {
+		name  = "memberOf"
+		value = "admins"
+	}
+
+	extra_config = {
+		"syncMode" = "FORCE"
+	}
+`),
+				ExpectError: regexp.MustCompile(`extra_config "syncMode" can't be set`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperConfig(resourceName, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, rs.Primary.Attributes["realm"], rs.Primary.Attributes["identity_provider_alias"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if mapper.IdentityProviderMapper != "saml-advanced-role-idp-mapper" {
+			return fmt.Errorf("expected mapper to be a saml-advanced-role-idp-mapper, got %s", mapper.IdentityProviderMapper)
+		}
+
+		if actual := mapper.Config.ExtraConfig[key]; actual != value {
+			return fmt.Errorf("expected mapper config %s to be %s, got %v", key, value, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_saml_advanced_attribute_to_role_identity_provider_mapper" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("saml advanced attribute to role mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlAdvancedAttributeToRoleIdentityProviderMapper_basic(alias, name, role, syncMode string, attributeValuesRegex bool, attributes string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_advanced_attribute_to_role_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	role                    = keycloak_role.role.name
+	sync_mode               = "%s"
+	attribute_values_regex  = %t
+%s
+}
+	`, testAccRealm.Realm, alias, role, name, syncMode, attributeValuesRegex, attributes)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// Keycloak stores the name format of the SAML attribute using the names of its JBossSAMLURIConstants
+var keycloakSamlAttributeToRoleIdentityProviderMapperNameFormats = map[string]string{
+	"Basic":         "ATTRIBUTE_FORMAT_BASIC",
+	"URI Reference": "ATTRIBUTE_FORMAT_URI",
+	"Unspecified":   "ATTRIBUTE_FORMAT_UNSPECIFIED",
+}
+
+func resourceKeycloakSamlAttributeToRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attribute_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Name of the SAML attribute to search for in the assertion.",
+			ExactlyOneOf: []string{"attribute_name", "attribute_friendly_name"},
+		},
+		"attribute_friendly_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Friendly name of the SAML attribute to search for in the assertion.",
+			ExactlyOneOf: []string{"attribute_name", "attribute_friendly_name"},
+		},
+		"attribute_name_format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Unspecified",
+			ValidateFunc: validation.StringInSlice(keys(keycloakSamlAttributeToRoleIdentityProviderMapperNameFormats), false),
+			Description:  "Name format of the SAML attribute, one of Basic, URI Reference or Unspecified.",
+		},
+		"attribute_value": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Value the SAML attribute must have for the role to be granted.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role to grant, client roles are referenced as {client_id}.{role_name}.",
+		},
+		"sync_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "INHERIT",
+			ValidateFunc: validation.StringInSlice(keycloakIdentityProviderMapperSyncModes, false),
+			Description:  "Sync mode for the mapper.",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getSamlAttributeToRoleIdentityProviderMapperFromData, setSamlAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setSamlAttributeToRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getSamlAttributeToRoleIdentityProviderMapperFromData, setSamlAttributeToRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getSamlAttributeToRoleIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rec, _ := getIdentityProviderMapperFromData(data)
+	identityProvider, err := keycloakClient.GetIdentityProvider(ctx, rec.Realm, rec.IdentityProviderAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	if identityProvider.ProviderId != "saml" {
+		return nil, fmt.Errorf(`provider.keycloak: keycloak_saml_attribute_to_role_identity_provider_mapper: %s: "%s" identity provider is not supported, use keycloak_attribute_to_role_identity_provider_mapper instead`, data.Get("name").(string), identityProvider.ProviderId)
+	}
+
+	for _, key := range []string{"attribute.name.format", "syncMode"} {
+		if _, ok := rec.Config.ExtraConfig[key]; ok {
+			return nil, fmt.Errorf(`provider.keycloak: keycloak_saml_attribute_to_role_identity_provider_mapper: %s: extra_config "%s" can't be set, use the corresponding argument instead`, data.Get("name").(string), key)
+		}
+	}
+
+	rec.IdentityProviderMapper = "saml-role-idp-mapper"
+	rec.Config.Attribute = data.Get("attribute_name").(string)
+	rec.Config.AttributeFriendlyName = data.Get("attribute_friendly_name").(string)
+	rec.Config.AttributeValue = data.Get("attribute_value").(string)
+	rec.Config.Role = data.Get("role").(string)
+	rec.Config.ExtraConfig["attribute.name.format"] = keycloakSamlAttributeToRoleIdentityProviderMapperNameFormats[data.Get("attribute_name_format").(string)]
+	rec.Config.ExtraConfig["syncMode"] = data.Get("sync_mode").(string)
+
+	return rec, nil
+}
+
+func setSamlAttributeToRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	data.Set("attribute_name", identityProviderMapper.Config.Attribute)
+	data.Set("attribute_friendly_name", identityProviderMapper.Config.AttributeFriendlyName)
+	data.Set("attribute_value", identityProviderMapper.Config.AttributeValue)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	// mappers created before Keycloak supported name formats don't have one, which Keycloak treats as unspecified
+	data.Set("attribute_name_format", "Unspecified")
+	if nameFormat, ok := identityProviderMapper.Config.ExtraConfig["attribute.name.format"].(string); ok {
+		for format, keycloakFormat := range keycloakSamlAttributeToRoleIdentityProviderMapperNameFormats {
+			if keycloakFormat == nameFormat {
+				data.Set("attribute_name_format", format)
+			}
+		}
+	}
+
+	if syncMode, ok := identityProviderMapper.Config.ExtraConfig["syncMode"].(string); ok {
+		data.Set("sync_mode", syncMode)
+	}
+
+	return nil
+}
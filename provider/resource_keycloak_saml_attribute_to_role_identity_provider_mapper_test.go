@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakSamlAttributeToRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlAttributeToRoleIdentityProviderMapper_attributeName(alias, mapperName, role, "urn:oid:1.3.6.1.4.1.5923.1.1.1.7", "URI Reference"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperConfig("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "attribute.name.format", "ATTRIBUTE_FORMAT_URI"),
+					testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperConfig("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "syncMode", "FORCE"),
+				),
+			},
+			{
+				Config: testKeycloakSamlAttributeToRoleIdentityProviderMapper_attributeName(alias, mapperName, role, "entitlement", "Basic"),
+				Check:  testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperConfig("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "attribute.name.format", "ATTRIBUTE_FORMAT_BASIC"),
+			},
+			{
+				ResourceName:        "keycloak_saml_attribute_to_role_identity_provider_mapper.saml",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/" + alias + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlAttributeToRoleIdentityProviderMapper_friendlyName(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlAttributeToRoleIdentityProviderMapper_friendlyName(alias, mapperName, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "attribute_friendly_name", "eduPersonEntitlement"),
+					resource.TestCheckResourceAttr("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "attribute_name_format", "Unspecified"),
+					resource.TestCheckResourceAttr("keycloak_saml_attribute_to_role_identity_provider_mapper.saml", "sync_mode", "INHERIT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlAttributeToRoleIdentityProviderMapper_invalidNameFormat(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+	role := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlAttributeToRoleIdentityProviderMapper_attributeName(alias, mapperName, role, "entitlement", "ATTRIBUTE_FORMAT_URI"),
+				ExpectError: regexp.MustCompile(`expected attribute_name_format to be one of`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperConfig(resourceName, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, rs.Primary.Attributes["realm"], rs.Primary.Attributes["identity_provider_alias"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if mapper.IdentityProviderMapper != "saml-role-idp-mapper" {
+			return fmt.Errorf("expected mapper to be a saml-role-idp-mapper, got %s", mapper.IdentityProviderMapper)
+		}
+
+		if actual := mapper.Config.ExtraConfig[key]; actual != value {
+			return fmt.Errorf("expected mapper config %s to be %s, got %v", key, value, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSamlAttributeToRoleIdentityProviderMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_saml_attribute_to_role_identity_provider_mapper" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("saml attribute to role mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlAttributeToRoleIdentityProviderMapper_attributeName(alias, name, role, attributeName, nameFormat string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_attribute_to_role_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	role                    = keycloak_role.role.name
+	attribute_name          = "%s"
+	attribute_name_format   = "%s"
+	attribute_value         = "admin"
+	sync_mode               = "FORCE"
+}
+	`, testAccRealm.Realm, alias, role, name, attributeName, nameFormat)
+}
+
+func testKeycloakSamlAttributeToRoleIdentityProviderMapper_friendlyName(alias, name, role string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_attribute_to_role_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	role                    = keycloak_role.role.name
+	attribute_friendly_name = "eduPersonEntitlement"
+	attribute_value         = "admin"
+}
+	`, testAccRealm.Realm, alias, role, name)
+}
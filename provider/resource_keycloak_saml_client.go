@@ -489,18 +489,24 @@ func resourceKeycloakSamlClientDelete(ctx context.Context, data *schema.Resource
 func resourceKeycloakSamlClientImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
-	parts := strings.Split(d.Id(), "/")
+	// client ids may contain slashes, ex. when they are URLs
+	parts := strings.SplitN(d.Id(), "/", 2)
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{samlClientId}}")
 	}
 
-	_, err := keycloakClient.GetSamlClient(ctx, parts[0], parts[1])
+	id, err := getClientUuid(ctx, keycloakClient, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = keycloakClient.GetSamlClient(ctx, parts[0], id)
 	if err != nil {
 		return nil, err
 	}
 
 	d.Set("realm_id", parts[0])
-	d.SetId(parts[1])
+	d.SetId(id)
 
 	diagnostics := resourceKeycloakSamlClientRead(ctx, d, meta)
 	if diagnostics.HasError() {
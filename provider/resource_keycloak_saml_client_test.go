@@ -32,6 +32,35 @@ func TestAccKeycloakSamlClient_basic(t *testing.T) {
 				ImportStateVerify:   true,
 				ImportStateIdPrefix: testAccRealm.Realm + "/",
 			},
+			{
+				ResourceName:      "keycloak_saml_client.saml_client",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "/" + clientId,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlClient_importUrlClientId(t *testing.T) {
+	t.Parallel()
+	clientId := "https://" + acctest.RandomWithPrefix("tf-acc") + ".example.com/saml/metadata"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlClient_basic(clientId),
+				Check:  testAccCheckKeycloakSamlClientExistsWithCorrectProtocol("keycloak_saml_client.saml_client"),
+			},
+			{
+				ResourceName:      "keycloak_saml_client.saml_client",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "/" + clientId,
+			},
 		},
 	})
 }
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakSamlUsernameIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUsernameIdentityProviderMapperDestroy("keycloak_saml_username_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlUsernameIdentityProviderMapper_basic(alias, mapperName, "${ALIAS}.${NAMEID}", "LOCAL", "IMPORT"),
+				Check:  testAccCheckKeycloakUsernameIdentityProviderMapper("keycloak_saml_username_identity_provider_mapper.saml", "saml-username-idp-mapper", "${ALIAS}.${NAMEID}", "LOCAL", "IMPORT"),
+			},
+			{
+				Config: testKeycloakSamlUsernameIdentityProviderMapper_basic(alias, mapperName, "${ATTRIBUTE.email}", "BROKER_ID", "FORCE"),
+				Check:  testAccCheckKeycloakUsernameIdentityProviderMapper("keycloak_saml_username_identity_provider_mapper.saml", "saml-username-idp-mapper", "${ATTRIBUTE.email}", "BROKER_ID", "FORCE"),
+			},
+		},
+	})
+}
+
+func testKeycloakSamlUsernameIdentityProviderMapper_basic(alias, name, template, target, syncMode string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_saml_username_identity_provider_mapper" "saml" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	template                = "%s"
+	target                  = "%s"
+	sync_mode               = "%s"
+}
+	`, testAccRealm.Realm, alias, name, strings.ReplaceAll(template, "${", "$${"), target, syncMode)
+}
@@ -9,11 +9,17 @@ import (
 	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
 const MULTIVALUE_ATTRIBUTE_SEPARATOR = "##"
 
+const (
+	USER_ATTRIBUTES_MODE_EXCLUSIVE = "exclusive"
+	USER_ATTRIBUTES_MODE_ADDITIVE  = "additive"
+)
+
 func resourceKeycloakUser() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKeycloakUserCreate,
@@ -65,6 +71,13 @@ func resourceKeycloakUser() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"attributes_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      USER_ATTRIBUTES_MODE_EXCLUSIVE,
+				ValidateFunc: validation.StringInSlice([]string{USER_ATTRIBUTES_MODE_EXCLUSIVE, USER_ATTRIBUTES_MODE_ADDITIVE}, false),
+				Description:  "With exclusive, the attributes of the user are replaced by the configured ones. With additive, only the configured attributes are managed, and attributes set by other systems are kept.",
+			},
 			"required_actions": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -121,10 +134,29 @@ func resourceKeycloakUser() *schema.Resource {
 				Default:  false,
 				ForceNew: true,
 			},
+			"service_account_client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the client this user is the service account of. Service account users can't be managed by this resource.",
+			},
+			"federation_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the user federation provider this user has been imported from. Only the configured attributes of federated users are tracked.",
+			},
 		},
 	}
 }
 
+// service account users belong to their client, and managing them here would fight with the client's service account resources
+func validateUserIsNotServiceAccount(user *keycloak.User) error {
+	if user.ServiceAccountClientId != "" {
+		return fmt.Errorf("user %s is the service account of client %s and can't be managed by keycloak_user, use the keycloak_openid_client_service_account_user data source and the keycloak_openid_client_service_account_* resources instead", user.Username, user.ServiceAccountClientId)
+	}
+
+	return nil
+}
+
 func onlyDiffOnCreate(_, _, _ string, d *schema.ResourceData) bool {
 	return d.Id() != ""
 }
@@ -204,16 +236,77 @@ func mapFromUserToData(data *schema.ResourceData, user *keycloak.User) {
 	data.Set("attributes", attributes)
 	data.Set("federated_identity", federatedIdentities)
 	data.Set("required_actions", user.RequiredActions)
+	data.Set("service_account_client_id", user.ServiceAccountClientId)
+	data.Set("federation_link", user.FederationLink)
+}
+
+// hasManagedAttributes tells whether only the configured attributes of the user are managed, either because the user is
+// federated or because the attributes mode is additive
+func hasManagedAttributes(data *schema.ResourceData, federationLink string) bool {
+	return federationLink != "" || data.Get("attributes_mode").(string) == USER_ATTRIBUTES_MODE_ADDITIVE
+}
+
+// getUserWithManagedAttributes ignores the attributes which aren't set in the configuration, for federated users and in
+// additive mode. User federation providers such as LDAP add many attributes, ex. LDAP_ID or modifyTimestamp, which would
+// otherwise drift. Attributes configured without a value are removed, so they're kept empty as long as they don't exist.
+func getUserWithManagedAttributes(data *schema.ResourceData, user *keycloak.User) *keycloak.User {
+	if !hasManagedAttributes(data, user.FederationLink) {
+		return user
+	}
+
+	managedAttributes := data.Get("attributes").(map[string]interface{})
+
+	managedUser := *user
+	managedUser.Attributes = map[string][]string{}
+	for key, value := range user.Attributes {
+		if _, ok := managedAttributes[key]; ok {
+			managedUser.Attributes[key] = value
+		}
+	}
+
+	for key, value := range managedAttributes {
+		if _, ok := managedUser.Attributes[key]; !ok && value.(string) == "" {
+			managedUser.Attributes[key] = []string{}
+		}
+	}
+
+	return &managedUser
+}
+
+// getPreviousUserAttributeKeys returns the keys of the attributes which were configured before the current change
+func getPreviousUserAttributeKeys(data *schema.ResourceData) []string {
+	oldAttributes, _ := data.GetChange("attributes")
+
+	var keys []string
+	for key := range oldAttributes.(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	return keys
 }
 
 func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	user := mapFromDataToUser(data)
+	attributes := user.Attributes
+
+	if hasManagedAttributes(data, "") {
+		user.Attributes = keycloak.MergeUserAttributes(nil, nil, attributes)
+	}
+
+	err := keycloakClient.ValidateUserFederatedIdentities(ctx, user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if !data.Get("import").(bool) {
 		err := keycloakClient.NewUser(ctx, user)
 		if err != nil {
+			// the user exists once linking its federated identities fails, keep it in state so that it gets replaced
+			if user.Id != "" {
+				data.SetId(user.Id)
+			}
 			return diag.FromErr(err)
 		}
 
@@ -236,17 +329,23 @@ func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData,
 		if existingUser == nil {
 			return diag.FromErr(fmt.Errorf("no user found for username %s", username))
 		}
+		if err = validateUserIsNotServiceAccount(existingUser); err != nil {
+			return diag.FromErr(err)
+		}
 
 		if err = mergo.Merge(user, existingUser); err != nil {
 			return diag.FromErr(err)
 		}
+		if hasManagedAttributes(data, "") {
+			user.Attributes = keycloak.MergeUserAttributes(existingUser.Attributes, nil, attributes)
+		}
 		err = keycloakClient.UpdateUser(ctx, user)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	mapFromUserToData(data, user)
+	mapFromUserToData(data, getUserWithManagedAttributes(data, user))
 
 	return resourceKeycloakUserRead(ctx, data, meta)
 }
@@ -262,11 +361,14 @@ func resourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData, me
 		return handleNotFoundError(ctx, err, data)
 	}
 
-	mapFromUserToData(data, user)
+	mapFromUserToData(data, getUserWithManagedAttributes(data, user))
 
 	if _, ok := data.GetOk("import"); !ok {
 		data.Set("import", false)
 	}
+	if _, ok := data.GetOk("attributes_mode"); !ok {
+		data.Set("attributes_mode", USER_ATTRIBUTES_MODE_EXCLUSIVE)
+	}
 
 	return nil
 }
@@ -275,27 +377,64 @@ func resourceKeycloakUserUpdate(ctx context.Context, data *schema.ResourceData,
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	user := mapFromDataToUser(data)
+	user.ServiceAccountClientId = data.Get("service_account_client_id").(string)
+
+	err := validateUserIsNotServiceAccount(user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.ValidateUserFederatedIdentities(ctx, user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err := keycloakClient.UpdateUser(ctx, user)
+	// the current attributes are read right before the update, so that attributes set in the meantime by other systems are kept
+	if hasManagedAttributes(data, data.Get("federation_link").(string)) {
+		currentUser, err := keycloakClient.GetUser(ctx, user.RealmId, user.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		user.FederationLink = currentUser.FederationLink
+		user.Attributes = keycloak.MergeUserAttributes(currentUser.Attributes, getPreviousUserAttributeKeys(data), user.Attributes)
+	}
+
+	err = keycloakClient.UpdateUser(ctx, user)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	mapFromUserToData(data, user)
+	mapFromUserToData(data, getUserWithManagedAttributes(data, user))
 
 	return nil
 }
 
 func resourceKeycloakUserDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if data.Get("import").(bool) {
-		return nil
-	}
-
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
 	id := data.Id()
 
+	// imported users are kept, only the attributes managed in additive mode are removed from them
+	if data.Get("import").(bool) {
+		if data.Get("attributes_mode").(string) != USER_ATTRIBUTES_MODE_ADDITIVE {
+			return nil
+		}
+
+		var keys []string
+		for key := range data.Get("attributes").(map[string]interface{}) {
+			keys = append(keys, key)
+		}
+
+		err := keycloakClient.RemoveUserAttributes(ctx, realmId, id, keys)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+
 	return diag.FromErr(keycloakClient.DeleteUser(ctx, realmId, id))
 }
 
@@ -307,13 +446,19 @@ func resourceKeycloakUserImport(ctx context.Context, d *schema.ResourceData, met
 		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{userId}}")
 	}
 
-	_, err := keycloakClient.GetUser(ctx, parts[0], parts[1])
+	user, err := keycloakClient.GetUser(ctx, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateUserIsNotServiceAccount(user)
 	if err != nil {
 		return nil, err
 	}
 
 	d.Set("realm_id", parts[0])
 	d.Set("import", false)
+	d.Set("attributes_mode", USER_ATTRIBUTES_MODE_EXCLUSIVE)
 	d.SetId(parts[1])
 
 	diagnostics := resourceKeycloakUserRead(ctx, d, meta)
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak_user_consent_revocation doesn't manage any persistent object. Creating it revokes a user's consent for
+// a client, and changing any argument (including triggers) revokes it again, which forces the user to re-consent.
+func resourceKeycloakUserConsentRevocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserConsentRevocationCreate,
+		ReadContext:   resourceKeycloakUserConsentRevocationRead,
+		DeleteContext: resourceKeycloakUserConsentRevocationDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The client_id (not the unique ID) of the client whose consent should be revoked.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that cause the consent to be revoked again when changed.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakUserConsentRevocationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	err := keycloakClient.RevokeUserConsent(ctx, realmId, userId, clientId)
+	// keycloak returns a 404 when the user never consented to this client, which is the state we want anyway
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s/%s", realmId, userId, clientId))
+
+	return resourceKeycloakUserConsentRevocationRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserConsentRevocationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// the user granting consent again is expected, so only the user disappearing is treated as drift
+	_, err := keycloakClient.GetUser(ctx, realmId, userId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserConsentRevocationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// a revoked consent can't be restored, so there is nothing to do here
+	return nil
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakUserConsentRevocation_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserConsentRevocation_basic(username, clientId, "v1"),
+				Check:  testAccCheckKeycloakUserHasNoConsentForClient("keycloak_user_consent_revocation.revocation"),
+			},
+			{
+				Config: testKeycloakUserConsentRevocation_basic(username, clientId, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserHasNoConsentForClient("keycloak_user_consent_revocation.revocation"),
+					resource.TestCheckResourceAttr("keycloak_user_consent_revocation.revocation", "triggers.scopes", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserHasNoConsentForClient(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+		clientId := rs.Primary.Attributes["client_id"]
+
+		consents, err := keycloakClient.GetUserConsents(testCtx, realmId, userId)
+		if err != nil {
+			return err
+		}
+
+		for _, consent := range consents {
+			if consent.ClientId == clientId {
+				return fmt.Errorf("expected user %s to have no consent for client %s", userId, clientId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserConsentRevocation_basic(username, clientId, trigger string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	username = "%s"
+	realm_id = data.keycloak_realm.realm.id
+	enabled  = true
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id        = "%s"
+	realm_id         = data.keycloak_realm.realm.id
+	access_type      = "PUBLIC"
+	consent_required = true
+}
+
+resource "keycloak_user_consent_revocation" "revocation" {
+	realm_id  = data.keycloak_realm.realm.id
+	user_id   = keycloak_user.user.id
+	client_id = keycloak_openid_client.client.client_id
+
+	triggers = {
+		scopes = "%s"
+	}
+}
+	`, testAccRealm.Realm, username, clientId, trigger)
+}
@@ -103,33 +103,27 @@ func resourceKeycloakUserRolesReconcile(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	if data.HasChange("role_ids") && !data.IsNewResource() {
-		o, n := data.GetChange("role_ids")
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-		remove := interfaceSliceToStringSlice(os.Difference(ns).List())
-
-		tfRolesToRemove, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, remove)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		if err = removeRolesFromUser(ctx, keycloakClient, tfRolesToRemove.clientRoles, tfRolesToRemove.realmRoles, user); err != nil {
-			return diag.FromErr(err)
-		}
+	// get the list of currently assigned roles. Due to default realm and client roles
+	// (e.g. roles of the account client) this is probably not empty upon resource creation
+	roleMappings, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	existingRoles := intoRoleMapping(roleMappings)
 
-	tfRoles, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
+	tfRoles, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds, existingRoles)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	// get the list of currently assigned roles. Due to default realm and client roles
-	// (e.g. roles of the account client) this is probably not empty upon resource creation
-	roleMappings, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
-
 	// sort into roles we need to add and roles we need to remove
-	updates := calculateRoleMappingUpdates(tfRoles, intoRoleMapping(roleMappings))
+	updates := calculateRoleMappingUpdates(tfRoles, existingRoles)
+
+	// if not exhaustive, only remove the roles which were removed from role_ids
+	if !exhaustive {
+		o, n := data.GetChange("role_ids")
+		updates.onlyRemove(interfaceSliceToStringSlice(o.(*schema.Set).Difference(n.(*schema.Set)).List()))
+	}
 
 	// add roles
 	err = addRolesToUser(ctx, keycloakClient, updates.clientRolesToAdd, updates.realmRolesToAdd, user)
@@ -137,12 +131,10 @@ func resourceKeycloakUserRolesReconcile(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	// remove roles if exhaustive (authoritative)
-	if exhaustive {
-		err = removeRolesFromUser(ctx, keycloakClient, updates.clientRolesToRemove, updates.realmRolesToRemove, user)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	// remove roles
+	err = removeRolesFromUser(ctx, keycloakClient, updates.clientRolesToRemove, updates.realmRolesToRemove, user)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	data.SetId(userRolesId(realmId, userId))
@@ -196,13 +188,24 @@ func resourceKeycloakUserRolesDelete(ctx context.Context, data *schema.ResourceD
 	userId := data.Get("user_id").(string)
 
 	user, err := keycloakClient.GetUser(ctx, realmId, userId)
+	if err != nil {
+		// the roles are removed along with the user
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
 
-	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
-	rolesToRemove, err := getExtendedRoleMapping(ctx, keycloakClient, realmId, roleIds)
+		return diag.FromErr(err)
+	}
+
+	roleMappings, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// only roles which are still assigned are removed, roles which were deleted meanwhile are skipped
+	roleIds := interfaceSliceToStringSlice(data.Get("role_ids").(*schema.Set).List())
+	rolesToRemove := filterRoleMapping(intoRoleMapping(roleMappings), roleIds)
+
 	err = removeRolesFromUser(ctx, keycloakClient, rolesToRemove.clientRoles, rolesToRemove.realmRoles, user)
 	if err != nil {
 		return diag.FromErr(err)
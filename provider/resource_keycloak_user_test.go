@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -303,6 +304,155 @@ func TestAccKeycloakUser_federatedLink(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_federatedIdentities(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_federatedIdentities(realmName, `
+  federated_identity {
+    identity_provider = keycloak_oidc_identity_provider.first.alias
+    user_id           = "first-id"
+    user_name         = "first-name"
+  }
+  federated_identity {
+    identity_provider = keycloak_oidc_identity_provider.second.alias
+    user_id           = "second-id"
+    user_name         = "second-name"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserFederatedIdentities(resourceName, map[string]string{"first": "first-id", "second": "second-id"}),
+					resource.TestCheckResourceAttr(resourceName, "federated_identity.#", "2"),
+				),
+			},
+			{
+				Config: testKeycloakUser_federatedIdentities(realmName, `
+  federated_identity {
+    identity_provider = keycloak_oidc_identity_provider.second.alias
+    user_id           = "other-second-id"
+    user_name         = "other-second-name"
+  }`),
+				Check: testAccCheckKeycloakUserFederatedIdentities(resourceName, map[string]string{"second": "other-second-id"}),
+			},
+			{
+				Config: testKeycloakUser_federatedIdentities(realmName, `
+  federated_identity {
+    identity_provider = keycloak_oidc_identity_provider.first.alias
+    user_id           = "first-id"
+    user_name         = "first-name"
+  }
+  federated_identity {
+    identity_provider = keycloak_oidc_identity_provider.first.alias
+    user_id           = "other-first-id"
+    user_name         = "other-first-name"
+  }`),
+				ExpectError: regexp.MustCompile("validation error: user .+ can only be linked once to identity provider first"),
+			},
+			{
+				Config: testKeycloakUser_federatedIdentities(realmName, `
+  federated_identity {
+    identity_provider = "missing"
+    user_id           = "missing-id"
+    user_name         = "missing-name"
+  }`),
+				ExpectError: regexp.MustCompile("validation error: identity provider missing of the federated identity missing-id doesn't exist"),
+			},
+			{
+				Config:      testKeycloakUser_federatedIdentitiesConflict(realmName),
+				ExpectError: regexp.MustCompile("federated identity other-second-id of identity provider second can't be linked to user .+, it is already linked to user"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUser_ldapFederatedAttributes(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_ldapFederatedAttributes(realmName, username, "engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "federation_link"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.department", "engineering"),
+				),
+			},
+			{
+				Config: testKeycloakUser_ldapFederatedAttributes(realmName, username, "sales"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.department", "sales"),
+					testAccCheckKeycloakUserHasAttribute(resourceName, "LDAP_ID"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUser_additiveAttributes(t *testing.T) {
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_user.user"
+	user := &keycloak.User{}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_additiveAttributes(username, "red##blue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserFetch(resourceName, user),
+					testAccCheckKeycloakUserAttributeValues(resourceName, "team", []string{"red", "blue"}),
+					resource.TestCheckResourceAttr(resourceName, "attributes.team", "red##blue"),
+				),
+			},
+			{
+				PreConfig: func() {
+					currentUser, err := keycloakClient.GetUser(testCtx, user.RealmId, user.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					currentUser.Attributes["scim_id"] = []string{"1234", "5678"}
+					err = keycloakClient.UpdateUser(testCtx, currentUser)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakUser_additiveAttributes(username, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserAttributeValues(resourceName, "team", []string{"green"}),
+					testAccCheckKeycloakUserAttributeValues(resourceName, "scim_id", []string{"1234", "5678"}),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+				),
+			},
+			{
+				Config: testKeycloakUser_additiveAttributes(username, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserAttributeValues(resourceName, "team", nil),
+					testAccCheckKeycloakUserAttributeValues(resourceName, "scim_id", []string{"1234", "5678"}),
+					resource.TestCheckResourceAttr(resourceName, "attributes.team", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakUser_import(t *testing.T) {
 	t.Parallel()
 
@@ -316,8 +466,15 @@ func TestAccKeycloakUser_import(t *testing.T) {
 				ExpectError: regexp.MustCompile("no user found for username non-existing-username"),
 			},
 			{
-				Config: testKeycloakUser_import("master", "service-account-terraform"),
-				Check:  testAccCheckKeycloakUserExistsWithUsername("keycloak_user.user", "service-account-terraform"),
+				Config:      testKeycloakUser_import("master", "service-account-terraform"),
+				ExpectError: regexp.MustCompile("user service-account-terraform is the service account of client .+ and can't be managed by keycloak_user"),
+			},
+			{
+				Config: testKeycloakUser_import("master", "keycloak"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserExistsWithUsername("keycloak_user.user", "keycloak"),
+					resource.TestCheckResourceAttr("keycloak_user.user", "service_account_client_id", ""),
+				),
 			},
 		},
 	})
@@ -383,6 +540,62 @@ func testAccCheckKeycloakUserHasFederationLinkWithSourceUserName(resourceName, s
 	}
 }
 
+func testAccCheckKeycloakUserFederatedIdentities(resourceName string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(testCtx, user.RealmId, user.Id)
+		if err != nil {
+			return err
+		}
+
+		if len(federatedIdentities) != len(expected) {
+			return fmt.Errorf("expected user %s to have %d federated identities, got %d", user.Username, len(expected), len(federatedIdentities))
+		}
+
+		for _, federatedIdentity := range federatedIdentities {
+			if expected[federatedIdentity.IdentityProvider] != federatedIdentity.UserId {
+				return fmt.Errorf("expected user %s to be linked to %s of identity provider %s, got %s", user.Username, expected[federatedIdentity.IdentityProvider], federatedIdentity.IdentityProvider, federatedIdentity.UserId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserHasAttribute(resourceName, attribute string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := user.Attributes[attribute]; !ok {
+			return fmt.Errorf("expected user %s to have attribute %s, got %v", user.Username, attribute, user.Attributes)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserAttributeValues(resourceName, attribute string, values []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !reflect.DeepEqual(user.Attributes[attribute], values) {
+			return fmt.Errorf("expected attribute %s of user %s to be %v, got %v", attribute, user.Username, values, user.Attributes[attribute])
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakUserExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getUserFromState(s, resourceName)
@@ -553,6 +766,28 @@ resource "keycloak_user" "user" {
 	`, testAccRealm.Realm, userProfile, username, attributeName, attributeValue, dependsOn)
 }
 
+func testKeycloakUser_additiveAttributes(username, team string) string {
+	userProfile, dependsOn := userProfileIfKeycloakHasSupport("data.keycloak_realm.realm.id")
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+%s
+
+resource "keycloak_user" "user" {
+	realm_id        = data.keycloak_realm.realm.id
+	username        = "%s"
+	attributes_mode = "additive"
+	attributes = {
+		"team" = "%s"
+	}
+
+    %s
+}
+	`, testAccRealm.Realm, userProfile, username, team, dependsOn)
+}
+
 func testKeycloakUser_initialPassword(username string, password string, clientId string) string {
 	userProfile, dependsOn := userProfileIfKeycloakHasSupport("data.keycloak_realm.realm.id")
 	return fmt.Sprintf(`
@@ -668,6 +903,113 @@ resource "keycloak_user" "destination_user" {
 	`, userProfile, sourceRealmUserName, dependsOn, destinationRealmId, dependsOn)
 }
 
+func testKeycloakUser_federatedIdentitiesRealm(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+  realm   = "%s"
+  enabled = true
+}
+
+resource "keycloak_oidc_identity_provider" "first" {
+  realm             = keycloak_realm.realm.id
+  alias             = "first"
+  authorization_url = "https://first.example.com/auth"
+  token_url         = "https://first.example.com/token"
+  client_id         = "first"
+  client_secret     = "secret"
+}
+
+resource "keycloak_oidc_identity_provider" "second" {
+  realm             = keycloak_realm.realm.id
+  alias             = "second"
+  authorization_url = "https://second.example.com/auth"
+  token_url         = "https://second.example.com/token"
+  client_id         = "second"
+  client_secret     = "secret"
+}
+	`, realm)
+}
+
+func testKeycloakUser_federatedIdentities(realm, federatedIdentities string) string {
+	return testKeycloakUser_federatedIdentitiesRealm(realm) + fmt.Sprintf(`
+resource "keycloak_user" "user" {
+  realm_id = keycloak_realm.realm.id
+  username = "migrated-user"
+  %s
+}
+	`, federatedIdentities)
+}
+
+func testKeycloakUser_federatedIdentitiesConflict(realm string) string {
+	return testKeycloakUser_federatedIdentities(realm, `
+  federated_identity {
+    identity_provider = keycloak_oidc_identity_provider.second.alias
+    user_id           = "other-second-id"
+    user_name         = "other-second-name"
+  }`) + `
+resource "keycloak_user" "conflicting_user" {
+  realm_id = keycloak_realm.realm.id
+  username = "conflicting-user"
+
+  federated_identity {
+    identity_provider = keycloak_oidc_identity_provider.second.alias
+    user_id           = "other-second-id"
+    user_name         = "other-second-name"
+  }
+
+  depends_on = [keycloak_user.user]
+}
+	`
+}
+
+func testKeycloakUser_ldapFederatedAttributes(realm, username, department string) string {
+	userProfile, _ := userProfileIfKeycloakHasSupport("keycloak_realm.realm.id")
+	dependsOn := "depends_on = [keycloak_ldap_user_federation.openldap]"
+	if userProfile != "" {
+		dependsOn = "depends_on = [keycloak_ldap_user_federation.openldap, keycloak_realm_user_profile.realm_user_profile]"
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+%s
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name     = "openldap"
+	realm_id = keycloak_realm.realm.id
+
+	enabled   = true
+	edit_mode = "WRITABLE"
+
+	username_ldap_attribute = "uid"
+	rdn_ldap_attribute      = "uid"
+	uuid_ldap_attribute     = "entryUUID"
+	user_object_classes     = [
+		"inetOrgPerson",
+		"organizationalPerson"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "ou=users,dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "adminpassword"
+}
+
+resource "keycloak_user" "user" {
+	realm_id   = keycloak_realm.realm.id
+	username   = "%s"
+	first_name = "First"
+	last_name  = "Last"
+	attributes = {
+		department = "%s"
+	}
+
+	%s
+}
+	`, realm, userProfile, username, department, dependsOn)
+}
+
 func testKeycloakUser_import(realmId, username string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var (
+	keycloakUsernameIdentityProviderMapperTargets    = []string{"LOCAL", "BROKER_ID", "BROKER_USERNAME"}
+	keycloakIdentityProviderMapperSyncModes          = []string{"IMPORT", "LEGACY", "FORCE", "INHERIT"}
+	keycloakUsernameIdentityProviderMapperConfigKeys = []string{"target", "syncMode"}
+)
+
+// resourceKeycloakUsernameIdentityProviderMapper builds a username template importer mapper for a fixed
+// mapper type, so the mapper type doesn't depend on looking up the identity provider first.
+// target and syncMode aren't typed fields on keycloak.IdentityProviderMapperConfig, as other mappers already
+// manage them through extra_config, so they're stored in the mapper's extra config here.
+func resourceKeycloakUsernameIdentityProviderMapper(identityProviderMapper string) *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"template": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Template used to format the username, for example ${ALIAS}.${CLAIM.preferred_username}.",
+		},
+		"target": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "LOCAL",
+			ValidateFunc: validation.StringInSlice(keycloakUsernameIdentityProviderMapperTargets, false),
+			Description:  "Destination field for the mapper.",
+		},
+		"sync_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "INHERIT",
+			ValidateFunc: validation.StringInSlice(keycloakIdentityProviderMapperSyncModes, false),
+			Description:  "Sync mode for the mapper.",
+		},
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.Schema["extra_config"].ValidateDiagFunc = validateIdentityProviderMapperExtraConfigKeys(keycloakUsernameIdentityProviderMapperConfigKeys)
+
+	getter := getUsernameIdentityProviderMapperFromData(identityProviderMapper)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getter, setUsernameIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setUsernameIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getter, setUsernameIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getUsernameIdentityProviderMapperFromData(identityProviderMapper string) identityProviderMapperDataGetterFunc {
+	return func(_ context.Context, data *schema.ResourceData, _ interface{}) (*keycloak.IdentityProviderMapper, error) {
+		rec, _ := getIdentityProviderMapperFromData(data)
+
+		rec.IdentityProviderMapper = identityProviderMapper
+		rec.Config.Template = data.Get("template").(string)
+		rec.Config.ExtraConfig["target"] = data.Get("target").(string)
+		rec.Config.ExtraConfig["syncMode"] = data.Get("sync_mode").(string)
+
+		return rec, nil
+	}
+}
+
+func setUsernameIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	data.Set("template", identityProviderMapper.Config.Template)
+
+	if target, ok := identityProviderMapper.Config.ExtraConfig["target"].(string); ok && target != "" {
+		data.Set("target", target)
+	}
+	if syncMode, ok := identityProviderMapper.Config.ExtraConfig["syncMode"].(string); ok && syncMode != "" {
+		data.Set("sync_mode", syncMode)
+	}
+
+	return nil
+}
@@ -30,11 +30,24 @@ func intoRoleMapping(keycloakRoleMapping *keycloak.RoleMapping) *roleMapping {
 
 // given a list of roleIds, query keycloak for role details to find out if a role is a client role or a
 // realm role (which is required to POST the role assignment to the correct API endpoint)
-func getExtendedRoleMapping(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, roleIds []string) (*roleMapping, error) {
+// roles which are already assigned are taken from existingRoles instead, so only new roles are queried
+func getExtendedRoleMapping(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, roleIds []string, existingRoles *roleMapping) (*roleMapping, error) {
 	clientRoles := make(map[string][]*keycloak.Role)
 	var realmRoles []*keycloak.Role
 
 	for _, roleId := range roleIds {
+		if existingRoles != nil {
+			if role := findRole(existingRoles.realmRoles, roleId); role != nil {
+				realmRoles = append(realmRoles, role)
+				continue
+			}
+
+			if clientId, ok := findClientRole(existingRoles.clientRoles, roleId); ok {
+				clientRoles[clientId] = append(clientRoles[clientId], findRole(existingRoles.clientRoles[clientId], roleId))
+				continue
+			}
+		}
+
 		role, err := keycloakClient.GetRole(ctx, realmId, roleId)
 		if err != nil {
 			// if the role doesn't exist anymore, skip it
@@ -60,6 +73,21 @@ func getExtendedRoleMapping(ctx context.Context, keycloakClient *keycloak.Keyclo
 	return &mapping, nil
 }
 
+// filterRoleMapping returns the roles of the mapping whose id is one of roleIds
+func filterRoleMapping(mapping *roleMapping, roleIds []string) *roleMapping {
+	clientRoles := make(map[string][]*keycloak.Role)
+	for clientId, roles := range mapping.clientRoles {
+		if filtered := filterRoles(roles, roleIds); len(filtered) != 0 {
+			clientRoles[clientId] = filtered
+		}
+	}
+
+	return &roleMapping{
+		clientRoles: clientRoles,
+		realmRoles:  filterRoles(mapping.realmRoles, roleIds),
+	}
+}
+
 type terraformRoleMappingUpdates struct {
 	realmRolesToRemove  []*keycloak.Role
 	realmRolesToAdd     []*keycloak.Role
@@ -104,6 +132,18 @@ func calculateRoleMappingUpdates(requestedRoles *roleMapping, existingRoles *rol
 	return &updates
 }
 
+// only keep the removals of roles which were removed from the configuration, when the assigned roles aren't managed exhaustively
+// other roles might be assigned elsewhere. All removals are sent together, so each container only needs one request.
+func (updates *terraformRoleMappingUpdates) onlyRemove(roleIds []string) {
+	rolesToRemove := filterRoleMapping(&roleMapping{
+		clientRoles: updates.clientRolesToRemove,
+		realmRoles:  updates.realmRolesToRemove,
+	}, roleIds)
+
+	updates.clientRolesToRemove = rolesToRemove.clientRoles
+	updates.realmRolesToRemove = rolesToRemove.realmRoles
+}
+
 // check if given role exists in a list of roles
 func roleExists(roles []*keycloak.Role, role *keycloak.Role) bool {
 	for _, r := range roles {
@@ -127,3 +167,36 @@ func minusRoles(a, b []*keycloak.Role) []*keycloak.Role {
 
 	return aWithoutB
 }
+
+func findRole(roles []*keycloak.Role, roleId string) *keycloak.Role {
+	for _, role := range roles {
+		if role.Id == roleId {
+			return role
+		}
+	}
+
+	return nil
+}
+
+// find the client a role belongs to in a map of client roles
+func findClientRole(clientRoles map[string][]*keycloak.Role, roleId string) (string, bool) {
+	for clientId, roles := range clientRoles {
+		if findRole(roles, roleId) != nil {
+			return clientId, true
+		}
+	}
+
+	return "", false
+}
+
+func filterRoles(roles []*keycloak.Role, roleIds []string) []*keycloak.Role {
+	var filtered []*keycloak.Role
+
+	for _, role := range roles {
+		if stringSliceContains(roleIds, role.Id) {
+			filtered = append(filtered, role)
+		}
+	}
+
+	return filtered
+}
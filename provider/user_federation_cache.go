@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// userFederationCacheSchema is the cache block shared by the ldap and custom user federation providers.
+func userFederationCacheSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Settings regarding cache policy for this realm.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "DEFAULT",
+					ValidateFunc: validation.StringInSlice(keycloakUserFederationCachePolicies, false),
+				},
+				"max_lifespan": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: suppressDurationStringDiff,
+					Description:      "Max lifespan of cache entry (duration string).",
+				},
+				"eviction_day": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      "-1",
+					ValidateFunc: validation.All(validation.IntAtLeast(0), validation.IntAtMost(6)),
+					Description:  "Day of the week the entry will become invalid on.",
+				},
+				"eviction_hour": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      "-1",
+					ValidateFunc: validation.All(validation.IntAtLeast(0), validation.IntAtMost(23)),
+					Description:  "Hour of day the entry will become invalid on.",
+				},
+				"eviction_minute": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      "-1",
+					ValidateFunc: validation.All(validation.IntAtLeast(0), validation.IntAtMost(59)),
+					Description:  "Minute of day the entry will become invalid on.",
+				},
+			},
+		},
+	}
+}
+
+func getUserFederationCacheFromData(data *schema.ResourceData) (*keycloak.UserFederationCache, bool) {
+	cache, ok := data.GetOk("cache")
+	if !ok {
+		return nil, false
+	}
+
+	cacheData := cache.([]interface{})[0].(map[string]interface{})
+
+	evictionDay := cacheData["eviction_day"].(int)
+	evictionHour := cacheData["eviction_hour"].(int)
+	evictionMinute := cacheData["eviction_minute"].(int)
+
+	return &keycloak.UserFederationCache{
+		CachePolicy:    cacheData["policy"].(string),
+		MaxLifespan:    cacheData["max_lifespan"].(string),
+		EvictionDay:    &evictionDay,
+		EvictionHour:   &evictionHour,
+		EvictionMinute: &evictionMinute,
+	}, true
+}
+
+func setUserFederationCacheData(data *schema.ResourceData, cache *keycloak.UserFederationCache) {
+	cachePolicySettings := make(map[string]interface{})
+
+	if cache.MaxLifespan != "" {
+		cachePolicySettings["max_lifespan"] = cache.MaxLifespan
+	}
+
+	if cache.EvictionDay != nil {
+		cachePolicySettings["eviction_day"] = *cache.EvictionDay
+	}
+	if cache.EvictionHour != nil {
+		cachePolicySettings["eviction_hour"] = *cache.EvictionHour
+	}
+	if cache.EvictionMinute != nil {
+		cachePolicySettings["eviction_minute"] = *cache.EvictionMinute
+	}
+
+	cachePolicySettings["policy"] = cache.CachePolicy
+
+	data.Set("cache", []interface{}{cachePolicySettings})
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"time"
@@ -47,6 +48,38 @@ func getSecondsFromDurationString(s string) (int, error) {
 	return int(duration.Seconds()), nil
 }
 
+// Validates that a string can be converted to a number of seconds by getSecondsFromDurationString
+func validateDurationString(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a duration, ex. 1m or 90s: %s", k, err)}
+	}
+
+	return nil, nil
+}
+
+// Validates that a string is a duration greater than zero
+func validatePositiveDurationString(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a duration, ex. 1m or 90s: %s", k, err)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("expected %s to be a positive duration, got %s", k, v)}
+	}
+
+	return nil, nil
+}
+
 // Converts number of seconds from Keycloak API to a duration string used by the provider
 // Ex: 3600 => "1h0m0s"
 func getDurationStringFromSeconds(seconds int) string {
@@ -113,6 +146,29 @@ func stringPointer(s string) *string {
 	return &s
 }
 
+func boolPointer(b bool) *bool {
+	return &b
+}
+
 func intPointer(i int) *int {
 	return &i
 }
+
+// getClientUuid allows imports to reference a client by either its internal ID or its human-readable client ID.
+// The internal ID is tried first, since a client ID could coincidentally look like another client's internal ID.
+func getClientUuid(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, id string) (string, error) {
+	client, err := keycloakClient.GetGenericClient(ctx, realmId, id)
+	if err == nil {
+		return client.Id, nil
+	}
+	if !keycloak.ErrorIs404(err) {
+		return "", err
+	}
+
+	client, err = keycloakClient.GetGenericClientByClientId(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	return client.Id, nil
+}